@@ -16,16 +16,33 @@ import (
 	"github.com/go-redis/redis/v7"
 	"github.com/rs/cors"
 	"github.com/sirupsen/logrus"
+	"github.com/uw-labs/flaggio/internal/exposure"
 	mongo_repo "github.com/uw-labs/flaggio/internal/repository/mongodb"
 	redis_repo "github.com/uw-labs/flaggio/internal/repository/redis"
 	"github.com/uw-labs/flaggio/internal/server/admin"
+	"github.com/uw-labs/flaggio/internal/service"
+	"github.com/victorkt/clientip"
 )
 
 func startAdmin(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) error {
 	logger.Debug("starting admin server ...")
 
-	// connect to mongo
-	db, err := newMongoDatabase(ctx, cfg.databaseURI, logger, wg)
+	archivedResponse, err := archivedFlagResponse()
+	if err != nil {
+		return err
+	}
+	trustedProxies, err := admin.ParseTrustedProxies(cfg.adminTrustedProxies.Value())
+	if err != nil {
+		return err
+	}
+	if len(trustedProxies) == 0 {
+		logger.Warn("no trusted proxies configured, protected flags can't be changed")
+	}
+
+	// connect to mongo, which stays connected until the webhook deliveries
+	// have been recorded
+	var mongoDependents sync.WaitGroup
+	db, err := newMongoDatabase(ctx, cfg.databaseURI, &mongoDependents, logger, wg)
 	if err != nil {
 		return err
 	}
@@ -48,6 +65,10 @@ func startAdmin(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) e
 	if err != nil {
 		return err
 	}
+	groupRepo, err := mongo_repo.NewExclusionGroupRepository(ctx, db)
+	if err != nil {
+		return err
+	}
 	evalRepo, err := mongo_repo.NewEvaluationRepository(ctx, db)
 	if err != nil {
 		return err
@@ -56,25 +77,54 @@ func startAdmin(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) e
 	if err != nil {
 		return err
 	}
+	experimentRepo, err := mongo_repo.NewExperimentRepository(ctx, db)
+	if err != nil {
+		return err
+	}
+	deliveryRepo, err := mongo_repo.NewWebhookDeliveryRepository(ctx, db)
+	if err != nil {
+		return err
+	}
+	webhookRepo := mongo_repo.NewWebhookRepository(db)
+	usageRepo := mongo_repo.NewFlagUsageRepository(db)
 	variantRepo := mongo_repo.NewVariantRepository(flagRepo.(*mongo_repo.FlagRepository))
+	metricRepo := mongo_repo.NewMetricRepository(flagRepo.(*mongo_repo.FlagRepository))
+	changeRequestRepo := mongo_repo.NewChangeRequestRepository(flagRepo.(*mongo_repo.FlagRepository))
 	ruleRepo := mongo_repo.NewRuleRepository(
 		flagRepo.(*mongo_repo.FlagRepository), segmentRepo.(*mongo_repo.SegmentRepository))
 	if redisClient != nil {
 		flagRepo = redis_repo.NewFlagRepository(redisClient, flagRepo)
 		segmentRepo = redis_repo.NewSegmentRepository(redisClient, segmentRepo)
+		groupRepo = redis_repo.NewExclusionGroupRepository(redisClient, groupRepo, flagRepo)
 		variantRepo = redis_repo.NewVariantRepository(redisClient, variantRepo, flagRepo)
+		metricRepo = redis_repo.NewMetricRepository(redisClient, metricRepo, flagRepo)
+		changeRequestRepo = redis_repo.NewChangeRequestRepository(redisClient, changeRequestRepo, flagRepo)
 		ruleRepo = redis_repo.NewRuleRepository(redisClient, ruleRepo, flagRepo, segmentRepo)
 		evalRepo = redis_repo.NewEvaluationRepository(redisClient, evalRepo)
 	}
 
+	// evaluations through the admin API are never exposed, so no
+	// exposure emitter is needed
+	flagService := service.NewFlagService(flagRepo, segmentRepo, groupRepo, evalRepo, userRepo, exposure.NopEmitter{}, archivedResponse)
+
 	// setup graphql resolver
 	resolver := &admin.Resolver{
-		FlagRepo:       flagRepo,
-		VariantRepo:    variantRepo,
-		RuleRepo:       ruleRepo,
-		SegmentRepo:    segmentRepo,
-		EvaluationRepo: evalRepo,
-		UserRepo:       userRepo,
+		FlagRepo:          flagRepo,
+		VariantRepo:       variantRepo,
+		MetricRepo:        metricRepo,
+		RuleRepo:          ruleRepo,
+		ChangeRequestRepo: changeRequestRepo,
+		SegmentRepo:       segmentRepo,
+		GroupRepo:         groupRepo,
+		EvaluationRepo:    evalRepo,
+		UserRepo:          userRepo,
+		ExperimentRepo:    experimentRepo,
+		UsageRepo:         usageRepo,
+		WebhookRepo:       webhookRepo,
+		DeliveryRepo:      deliveryRepo,
+		FlagService:       flagService,
+		Notifier:          newWebhookDispatcher(ctx, webhookRepo, deliveryRepo, logger, &mongoDependents),
+		MaxSimulatedUsers: cfg.simulationMaxUsers,
 	}
 
 	// setup graphql server
@@ -95,6 +145,8 @@ func startAdmin(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) e
 			NoColor: cfg.logFormatter != logFormatterText,
 		}),
 		tracingMiddleware("flaggio-admin", logger),
+		admin.ActorMiddleware(cfg.adminUserHeader, trustedProxies),
+		clientip.Middleware,
 		cors.New(cors.Options{
 			AllowedOrigins:   cfg.corsAllowedOrigins.Value(),
 			AllowedHeaders:   cfg.corsAllowedHeaders.Value(),
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"sync"
 
@@ -17,50 +18,15 @@ import (
 	"github.com/victorkt/clientip"
 )
 
-func startAPI(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) error {
+func startAPI(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	logger *logrus.Entry,
+	flagService service.Flag,
+	experimentService service.Experiment,
+) error {
 	logger.Debug("starting api server ...")
 
-	// connect to mongo
-	db, err := newMongoDatabase(ctx, cfg.databaseURI, logger, wg)
-	if err != nil {
-		return err
-	}
-
-	var redisClient *redis.Client
-	if cfg.isCachingEnabled() {
-		// connect to redis
-		redisClient, err = newRedisClient(ctx, cfg.redisURI, logger, wg)
-		if err != nil {
-			return err
-		}
-	}
-
-	// setup repositories
-	flagRepo, err := mongo_repo.NewFlagRepository(ctx, db)
-	if err != nil {
-		return err
-	}
-	segmentRepo, err := mongo_repo.NewSegmentRepository(ctx, db)
-	if err != nil {
-		return err
-	}
-	evalRepo, err := mongo_repo.NewEvaluationRepository(ctx, db)
-	if err != nil {
-		return err
-	}
-	userRepo, err := mongo_repo.NewUserRepository(ctx, db)
-	if err != nil {
-		return err
-	}
-	if redisClient != nil {
-		flagRepo = redis_repo.NewFlagRepository(redisClient, flagRepo)
-		segmentRepo = redis_repo.NewSegmentRepository(redisClient, segmentRepo)
-		evalRepo = redis_repo.NewEvaluationRepository(redisClient, evalRepo)
-	}
-
-	// setup services
-	flagService := service.NewFlagService(flagRepo, segmentRepo, evalRepo, userRepo)
-
 	// setup router
 	router := chi.NewRouter()
 	router.Use(
@@ -87,6 +53,7 @@ func startAPI(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) err
 	apiSrv := api.NewServer(
 		router,
 		flagService,
+		experimentService,
 		logger,
 	)
 
@@ -101,3 +68,83 @@ func startAPI(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) err
 
 	return srv.ListenAndServe()
 }
+
+// newServices connects to the databases and returns the services used
+// to evaluate flags and track experiments. The services are shared by the
+// API and gRPC servers, so they are only created once.
+func newServices(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) (service.Flag, service.Experiment, error) {
+	archivedResponse, err := archivedFlagResponse()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// connect to mongo, which stays connected until the exposure stream
+	// has flushed its pending exposures
+	var mongoDependents sync.WaitGroup
+	db, err := newMongoDatabase(ctx, cfg.databaseURI, &mongoDependents, logger, wg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var redisClient *redis.Client
+	if cfg.isCachingEnabled() {
+		// connect to redis
+		redisClient, err = newRedisClient(ctx, cfg.redisURI, logger, wg)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// setup repositories
+	flagRepo, err := mongo_repo.NewFlagRepository(ctx, db)
+	if err != nil {
+		return nil, nil, err
+	}
+	segmentRepo, err := mongo_repo.NewSegmentRepository(ctx, db)
+	if err != nil {
+		return nil, nil, err
+	}
+	groupRepo, err := mongo_repo.NewExclusionGroupRepository(ctx, db)
+	if err != nil {
+		return nil, nil, err
+	}
+	evalRepo, err := mongo_repo.NewEvaluationRepository(ctx, db)
+	if err != nil {
+		return nil, nil, err
+	}
+	userRepo, err := mongo_repo.NewUserRepository(ctx, db)
+	if err != nil {
+		return nil, nil, err
+	}
+	experimentRepo, err := mongo_repo.NewExperimentRepository(ctx, db)
+	if err != nil {
+		return nil, nil, err
+	}
+	usageRepo := mongo_repo.NewFlagUsageRepository(db)
+	if redisClient != nil {
+		flagRepo = redis_repo.NewFlagRepository(redisClient, flagRepo)
+		segmentRepo = redis_repo.NewSegmentRepository(redisClient, segmentRepo)
+		groupRepo = redis_repo.NewExclusionGroupRepository(redisClient, groupRepo, flagRepo)
+		evalRepo = redis_repo.NewEvaluationRepository(redisClient, evalRepo)
+	}
+
+	exposures, err := newExposureEmitter(ctx, experimentRepo, usageRepo, logger, &mongoDependents)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	flagService := service.NewFlagService(flagRepo, segmentRepo, groupRepo, evalRepo, userRepo, exposures, archivedResponse)
+	experimentService := service.NewExperimentService(flagRepo, experimentRepo)
+	return flagService, experimentService, nil
+}
+
+// archivedFlagResponse returns how archived flags are evaluated, as configured.
+func archivedFlagResponse() (service.ArchivedFlagResponse, error) {
+	archivedResponse := service.ArchivedFlagResponse(cfg.archivedFlagResponse)
+	switch archivedResponse {
+	case service.ArchivedFlagResponseError, service.ArchivedFlagResponseOff:
+		return archivedResponse, nil
+	default:
+		return "", fmt.Errorf("invalid archived flag response: %s", cfg.archivedFlagResponse)
+	}
+}
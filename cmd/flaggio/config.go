@@ -1,17 +1,72 @@
 package main
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/urfave/cli/v2"
+	"github.com/uw-labs/flaggio/internal/exposure"
+	"github.com/uw-labs/flaggio/internal/service"
 )
 
 type config struct {
 	databaseURI, redisURI                  string
 	apiAddr, adminAddr, uiBuildPath        string
+	grpcAddr                               string
+	grpcWatchInterval                      time.Duration
 	logFormatter, logLevel                 string
 	corsAllowedOrigins, corsAllowedHeaders cli.StringSlice
 	corsDebug, noAPI, noAdmin, noAdminUI   bool
 	playgroundEnabled                      bool
 	jaegerAgentHost                        string
+	exposureFile, exposureWebhookURL       string
+	exposureKafkaURL, exposureKafkaTopic   string
+	exposureBufferSize, exposureBatchSize  int
+	exposureFlushInterval                  time.Duration
+	webhookMaxAttempts                     int
+	webhookBackoff, webhookTimeout         time.Duration
+	adminUserHeader                        string
+	adminTrustedProxies                    cli.StringSlice
+	archivedFlagResponse                   string
+	simulationMaxUsers                     int
+}
+
+// requireDatabase returns an error if no database is configured. The database
+// is not required by the commands that work offline.
+func (c *config) requireDatabase() error {
+	if c.databaseURI == "" {
+		return errors.New(`required flag "database-uri" not set`)
+	}
+	return nil
+}
+
+// validate returns an error if any of the options has an invalid value.
+func (c *config) validate() error {
+	if c.grpcWatchInterval <= 0 {
+		return fmt.Errorf("invalid grpc watch interval: %s, must be positive", c.grpcWatchInterval)
+	}
+	if c.simulationMaxUsers <= 0 {
+		return fmt.Errorf("invalid simulation max users: %d, must be positive", c.simulationMaxUsers)
+	}
+	if c.webhookMaxAttempts <= 0 {
+		return fmt.Errorf("invalid webhook max attempts: %d, must be positive", c.webhookMaxAttempts)
+	}
+	if c.webhookBackoff <= 0 {
+		return fmt.Errorf("invalid webhook backoff: %s, must be positive", c.webhookBackoff)
+	}
+	if c.webhookTimeout <= 0 {
+		return fmt.Errorf("invalid webhook timeout: %s, must be positive", c.webhookTimeout)
+	}
+	return c.exposureStreamConfig().Validate()
+}
+
+func (c *config) exposureStreamConfig() exposure.StreamConfig {
+	return exposure.StreamConfig{
+		BufferSize:    c.exposureBufferSize,
+		BatchSize:     c.exposureBatchSize,
+		FlushInterval: c.exposureFlushInterval,
+	}
 }
 
 func (c *config) isCachingEnabled() bool {
@@ -22,6 +77,10 @@ func (c *config) isTracingEnabled() bool {
 	return c.jaegerAgentHost != ""
 }
 
+func (c *config) isGRPCEnabled() bool {
+	return c.grpcAddr != ""
+}
+
 var cfg = config{}
 
 var flags = []cli.Flag{
@@ -30,7 +89,6 @@ var flags = []cli.Flag{
 		Usage:       "Database URI",
 		EnvVars:     []string{"DATABASE_URI"},
 		Destination: &cfg.databaseURI,
-		Required:    true,
 	},
 	&cli.StringFlag{
 		Name:        "redis-uri",
@@ -101,6 +159,46 @@ var flags = []cli.Flag{
 		Value:       ":8081",
 		Destination: &cfg.adminAddr,
 	},
+	&cli.StringFlag{
+		Name:        "grpc-addr",
+		Usage:       "Sets the bind address for the gRPC API. The gRPC API is disabled if not set",
+		EnvVars:     []string{"GRPC_ADDR"},
+		Destination: &cfg.grpcAddr,
+	},
+	&cli.DurationFlag{
+		Name:        "grpc-watch-interval",
+		Usage:       "Sets how often the gRPC watch streams check for evaluation changes",
+		EnvVars:     []string{"GRPC_WATCH_INTERVAL"},
+		Value:       10 * time.Second,
+		Destination: &cfg.grpcWatchInterval,
+	},
+	&cli.StringFlag{
+		Name:        "archived-flag-response",
+		Usage:       "Sets how archived flags are evaluated. Valid values are: error, off",
+		EnvVars:     []string{"ARCHIVED_FLAG_RESPONSE"},
+		Value:       string(service.ArchivedFlagResponseError),
+		Destination: &cfg.archivedFlagResponse,
+	},
+	&cli.StringFlag{
+		Name:        "admin-user-header",
+		Usage:       "Sets the request header that identifies the user making changes through the admin API",
+		EnvVars:     []string{"ADMIN_USER_HEADER"},
+		Value:       "X-Forwarded-User",
+		Destination: &cfg.adminUserHeader,
+	},
+	&cli.StringSliceFlag{
+		Name:        "admin-trusted-proxies",
+		Usage:       "Sets the IPs or networks of the proxies trusted to set the admin user header, separated by comma",
+		EnvVars:     []string{"ADMIN_TRUSTED_PROXIES"},
+		Destination: &cfg.adminTrustedProxies,
+	},
+	&cli.IntFlag{
+		Name:        "simulation-max-users",
+		Usage:       "Sets how many users are evaluated at most by flag simulations",
+		EnvVars:     []string{"SIMULATION_MAX_USERS"},
+		Value:       10000,
+		Destination: &cfg.simulationMaxUsers,
+	},
 	&cli.StringFlag{
 		Name:        "log-formatter",
 		Usage:       "Sets the log formatter for the application. Valid values are: text, json",
@@ -121,4 +219,71 @@ var flags = []cli.Flag{
 		EnvVars:     []string{"JAEGER_AGENT_HOST"},
 		Destination: &cfg.jaegerAgentHost,
 	},
+	&cli.StringFlag{
+		Name:        "exposure-file",
+		Usage:       "Appends exposure events to this file as newline-delimited JSON",
+		EnvVars:     []string{"EXPOSURE_FILE"},
+		Destination: &cfg.exposureFile,
+	},
+	&cli.StringFlag{
+		Name:        "exposure-webhook-url",
+		Usage:       "Posts batches of exposure events to this URL",
+		EnvVars:     []string{"EXPOSURE_WEBHOOK_URL"},
+		Destination: &cfg.exposureWebhookURL,
+	},
+	&cli.StringFlag{
+		Name:        "exposure-kafka-url",
+		Usage:       "Produces exposure events to Kafka through the REST proxy on this URL",
+		EnvVars:     []string{"EXPOSURE_KAFKA_URL"},
+		Destination: &cfg.exposureKafkaURL,
+	},
+	&cli.StringFlag{
+		Name:        "exposure-kafka-topic",
+		Usage:       "Sets the Kafka topic for exposure events",
+		EnvVars:     []string{"EXPOSURE_KAFKA_TOPIC"},
+		Value:       "flaggio.exposures",
+		Destination: &cfg.exposureKafkaTopic,
+	},
+	&cli.IntFlag{
+		Name:        "exposure-buffer-size",
+		Usage:       "Sets how many exposure events can wait to be sent. Events are dropped when the buffer is full",
+		EnvVars:     []string{"EXPOSURE_BUFFER_SIZE"},
+		Value:       10000,
+		Destination: &cfg.exposureBufferSize,
+	},
+	&cli.IntFlag{
+		Name:        "exposure-batch-size",
+		Usage:       "Sets the maximum amount of exposure events sent at once",
+		EnvVars:     []string{"EXPOSURE_BATCH_SIZE"},
+		Value:       100,
+		Destination: &cfg.exposureBatchSize,
+	},
+	&cli.DurationFlag{
+		Name:        "exposure-flush-interval",
+		Usage:       "Sets the maximum time exposure events wait to be sent",
+		EnvVars:     []string{"EXPOSURE_FLUSH_INTERVAL"},
+		Value:       5 * time.Second,
+		Destination: &cfg.exposureFlushInterval,
+	},
+	&cli.IntFlag{
+		Name:        "webhook-max-attempts",
+		Usage:       "Sets the maximum amount of times a webhook delivery is attempted",
+		EnvVars:     []string{"WEBHOOK_MAX_ATTEMPTS"},
+		Value:       5,
+		Destination: &cfg.webhookMaxAttempts,
+	},
+	&cli.DurationFlag{
+		Name:        "webhook-backoff",
+		Usage:       "Sets the time to wait before retrying a failed webhook delivery. It doubles on every retry",
+		EnvVars:     []string{"WEBHOOK_BACKOFF"},
+		Value:       time.Second,
+		Destination: &cfg.webhookBackoff,
+	},
+	&cli.DurationFlag{
+		Name:        "webhook-timeout",
+		Usage:       "Sets the timeout of a webhook delivery attempt",
+		EnvVars:     []string{"WEBHOOK_TIMEOUT"},
+		Value:       10 * time.Second,
+		Destination: &cfg.webhookTimeout,
+	},
 }
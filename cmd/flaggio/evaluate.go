@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/urfave/cli/v2"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/service"
+)
+
+const (
+	evaluateFormatNDJSON = "ndjson"
+	evaluateFormatCSV    = "csv"
+)
+
+var evaluateCommand = &cli.Command{
+	Name:  "evaluate",
+	Usage: "Evaluates the flags from a config file for each user in a file, without a database",
+	Description: "Users matching a rule that splits them between variants get a random variant, like on the " +
+		"evaluation API, so the output of flags with splits can change between runs",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "flags",
+			Usage:    "JSON file with the flags and segments to evaluate",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "users",
+			Usage:    "Newline delimited JSON file with the ID and context of each user. The ID is required",
+			Required: true,
+		},
+		&cli.StringSliceFlag{
+			Name:  "flag",
+			Usage: "Key of a flag to evaluate. All flags are evaluated if not set",
+		},
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "Output format, one of: ndjson, csv",
+			Value: evaluateFormatNDJSON,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		flagsFile, err := os.Open(c.String("flags"))
+		if err != nil {
+			return err
+		}
+		defer flagsFile.Close()
+		usersFile, err := os.Open(c.String("users"))
+		if err != nil {
+			return err
+		}
+		defer usersFile.Close()
+
+		w := bufio.NewWriter(os.Stdout)
+		if err := evaluateUsers(flagsFile, usersFile, c.StringSlice("flag"), c.String("format"), w); err != nil {
+			return err
+		}
+		return w.Flush()
+	},
+}
+
+// evaluation is a row of the output of the evaluate command.
+type evaluation struct {
+	User    string      `json:"user"`
+	Flag    string      `json:"flag"`
+	Variant string      `json:"variant"`
+	Value   interface{} `json:"value"`
+	Error   string      `json:"error,omitempty"`
+}
+
+// evaluateUsers evaluates the flags with the given keys, or all flags if no keys are
+// given, for each of the users, and writes a row for each user and flag in the format.
+func evaluateUsers(config, users io.Reader, keys []string, format string, w io.Writer) error {
+	write, flush, err := newEvaluationWriter(format, w)
+	if err != nil {
+		return err
+	}
+	flgCfg, err := flaggio.ReadConfig(config)
+	if err != nil {
+		return fmt.Errorf("failed to read flags: %w", err)
+	}
+
+	filter := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		filter[key] = true
+	}
+	iders := flgCfg.Identifiers()
+	var flgs []*flaggio.Flag
+	var plans []*flaggio.Plan
+	for _, flg := range flgCfg.Flags {
+		// archived flags are never evaluated
+		if flg.Archived || (len(filter) > 0 && !filter[flg.Key]) {
+			continue
+		}
+		flgs = append(flgs, flg)
+		plans = append(plans, flaggio.CompilePlan(flg, iders))
+	}
+
+	dec := json.NewDecoder(users)
+	for line := 1; ; line++ {
+		var req service.EvaluationRequest
+		if err := dec.Decode(&req); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to read user %d: %w", line, err)
+		}
+		if req.UserID == "" {
+			return fmt.Errorf("failed to read user %d: userId is required", line)
+		}
+		if req.UserContext == nil {
+			req.UserContext = flaggio.UserContext{}
+		}
+		req.UserContext["$userId"] = req.UserID
+
+		for idx, flg := range flgs {
+			evltn := evaluation{User: req.UserID, Flag: flg.Key}
+			res, err := plans[idx].Evaluate(req.UserContext)
+			if err != nil {
+				evltn.Error = err.Error()
+			} else {
+				evltn.Value = res.Answer
+				if res.Variant != nil {
+					evltn.Variant = res.Variant.ID
+				}
+			}
+			if err := write(evltn); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+// newEvaluationWriter returns functions to write the evaluations in the format,
+// and to flush the evaluations written so far.
+func newEvaluationWriter(format string, w io.Writer) (func(evaluation) error, func() error, error) {
+	switch format {
+	case evaluateFormatNDJSON:
+		enc := json.NewEncoder(w)
+		return func(evltn evaluation) error {
+			return enc.Encode(evltn)
+		}, func() error { return nil }, nil
+	case evaluateFormatCSV:
+		csvw := csv.NewWriter(w)
+		if err := csvw.Write([]string{"user", "flag", "variant", "value", "error"}); err != nil {
+			return nil, nil, err
+		}
+		return func(evltn evaluation) error {
+				value, err := csvValue(evltn.Value)
+				if err != nil {
+					return err
+				}
+				return csvw.Write([]string{evltn.User, evltn.Flag, evltn.Variant, value, evltn.Error})
+			}, func() error {
+				csvw.Flush()
+				return csvw.Error()
+			}, nil
+	default:
+		return nil, nil, fmt.Errorf("invalid format: %s", format)
+	}
+}
+
+// csvValue formats a variant value for a CSV cell. Strings are written as
+// they are, other values as JSON.
+func csvValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		b, err := json.Marshal(v)
+		return string(b), err
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const evaluateTestConfig = `
+{
+	"flags": [{
+		"id": "f1",
+		"key": "seats",
+		"enabled": true,
+		"variants": [{"id": "v1", "value": 10}, {"id": "v2", "value": 2.5}],
+		"defaultVariantWhenOn": {"id": "v1"},
+		"defaultVariantWhenOff": {"id": "v2"},
+		"rules": [{
+			"id": "r1",
+			"constraints": [{"operation": "IS_IN_SEGMENT", "values": ["s1"]}],
+			"distributions": [{"id": "d1", "variant": {"id": "v2"}, "percentage": 100}]
+		}]
+	}, {
+		"id": "f2",
+		"key": "theme",
+		"enabled": false,
+		"variants": [{"id": "v3", "value": "dark"}, {"id": "v4", "value": "light, with \"quotes\""}],
+		"defaultVariantWhenOn": {"id": "v3"},
+		"defaultVariantWhenOff": {"id": "v4"}
+	}, {
+		"id": "f3",
+		"key": "old",
+		"enabled": true,
+		"archived": true,
+		"variants": [{"id": "v5", "value": true}],
+		"defaultVariantWhenOn": {"id": "v5"},
+		"defaultVariantWhenOff": {"id": "v5"}
+	}],
+	"segments": [{
+		"id": "s1",
+		"rules": [{"constraints": [{"property": "plan", "operation": "ONE_OF", "values": ["pro"]}]}]
+	}]
+}`
+
+const evaluateTestUsers = `{"userId": "john", "context": {"plan": "pro"}}
+{"userId": "jane", "context": {"plan": "free"}}
+{"userId": "joe"}
+`
+
+func TestEvaluateUsers(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		users          string
+		keys           []string
+		format         string
+		expectedResult string
+		expectedError  string
+	}{
+		{
+			name:   "writes all flags as ndjson",
+			users:  evaluateTestUsers,
+			format: evaluateFormatNDJSON,
+			expectedResult: `{"user":"john","flag":"seats","variant":"v2","value":2.5}
+{"user":"john","flag":"theme","variant":"v4","value":"light, with \"quotes\""}
+{"user":"jane","flag":"seats","variant":"v1","value":10}
+{"user":"jane","flag":"theme","variant":"v4","value":"light, with \"quotes\""}
+{"user":"joe","flag":"seats","variant":"v1","value":10}
+{"user":"joe","flag":"theme","variant":"v4","value":"light, with \"quotes\""}
+`,
+		},
+		{
+			name:   "writes all flags as csv",
+			users:  evaluateTestUsers,
+			format: evaluateFormatCSV,
+			expectedResult: `user,flag,variant,value,error
+john,seats,v2,2.5,
+john,theme,v4,"light, with ""quotes""",
+jane,seats,v1,10,
+jane,theme,v4,"light, with ""quotes""",
+joe,seats,v1,10,
+joe,theme,v4,"light, with ""quotes""",
+`,
+		},
+		{
+			name:   "writes only the filtered flags",
+			users:  evaluateTestUsers,
+			keys:   []string{"seats", "old", "unknown"},
+			format: evaluateFormatNDJSON,
+			expectedResult: `{"user":"john","flag":"seats","variant":"v2","value":2.5}
+{"user":"jane","flag":"seats","variant":"v1","value":10}
+{"user":"joe","flag":"seats","variant":"v1","value":10}
+`,
+		},
+		{
+			name:           "writes only the csv header without users",
+			users:          "",
+			keys:           []string{"theme"},
+			format:         evaluateFormatCSV,
+			expectedResult: "user,flag,variant,value,error\n",
+		},
+		{
+			name:          "fails on an invalid format",
+			users:         evaluateTestUsers,
+			format:        "xml",
+			expectedError: "invalid format: xml",
+		},
+		{
+			name:          "fails on an invalid user",
+			users:         `{"userId": "john"}` + "\n" + `{"userId": 1}`,
+			format:        evaluateFormatNDJSON,
+			expectedError: "failed to read user 2: json: cannot unmarshal number into Go struct field EvaluationRequest.userId of type string",
+		},
+		{
+			name:          "fails on a user without an id",
+			users:         `{"userId": "john"}` + "\n" + `{"context": {"plan": "pro"}}`,
+			format:        evaluateFormatNDJSON,
+			expectedError: "failed to read user 2: userId is required",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			err := evaluateUsers(strings.NewReader(evaluateTestConfig), strings.NewReader(tt.users), tt.keys, tt.format, &buf)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, buf.String())
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/uw-labs/flaggio/internal/server/rpc"
+	"github.com/uw-labs/flaggio/internal/service"
+	"google.golang.org/grpc"
+)
+
+func startGRPC(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry, flagService service.Flag) error {
+	logger.Debug("starting grpc server ...")
+
+	// setup gRPC server
+	grpcSrv := grpc.NewServer()
+	rpc.NewServer(flagService, cfg.grpcWatchInterval, logger).Register(grpcSrv)
+
+	lis, err := net.Listen("tcp", cfg.grpcAddr)
+	if err != nil {
+		return err
+	}
+
+	logger.WithFields(logrus.Fields{
+		"caching":   cfg.isCachingEnabled(),
+		"tracing":   cfg.isTracingEnabled(),
+		"listening": cfg.grpcAddr,
+	}).Info("grpc server started")
+
+	wg.Add(1)
+	go gracefulGRPCShutdown(ctx, grpcSrv, logger, wg)
+
+	return grpcSrv.Serve(lis)
+}
@@ -9,8 +9,12 @@ import (
 
 	"github.com/go-redis/redis/v7"
 	"github.com/sirupsen/logrus"
+	"github.com/uw-labs/flaggio/internal/exposure"
+	"github.com/uw-labs/flaggio/internal/repository"
+	"github.com/uw-labs/flaggio/internal/webhook"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
 )
 
 func newHTTPServer(ctx context.Context, addr string, handler http.Handler, logger *logrus.Entry, wg *sync.WaitGroup) *http.Server {
@@ -58,18 +62,72 @@ func newRedisClient(ctx context.Context, uri string, logger *logrus.Entry, wg *s
 	return redisClient, nil
 }
 
-func newMongoDatabase(ctx context.Context, uri string, logger *logrus.Entry, wg *sync.WaitGroup) (*mongo.Database, error) {
+func newExposureEmitter(ctx context.Context, recorder exposure.Recorder, usageRecorder exposure.UsageRecorder, logger *logrus.Entry, wg *sync.WaitGroup) (exposure.Emitter, error) {
+	// exposures are always recorded for experiments and stale flag detection
+	sinks := []exposure.Sink{exposure.NewRecorderSink(recorder), exposure.NewUsageSink(usageRecorder)}
+	client := &http.Client{Timeout: 10 * time.Second}
+	if cfg.exposureFile != "" {
+		fileSink, err := exposure.NewFileSink(cfg.exposureFile)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, fileSink)
+	}
+	if cfg.exposureWebhookURL != "" {
+		sinks = append(sinks, exposure.NewWebhookSink(cfg.exposureWebhookURL, client))
+	}
+	if cfg.exposureKafkaURL != "" {
+		sinks = append(sinks, exposure.NewKafkaSink(cfg.exposureKafkaURL, cfg.exposureKafkaTopic, client))
+	}
+
+	stream, err := exposure.NewStream(cfg.exposureStreamConfig(), logger, sinks...)
+	if err != nil {
+		return nil, err
+	}
+
+	wg.Add(1)
+	go gracefulExposureClose(ctx, stream, logger, wg)
+
+	return stream, nil
+}
+
+func newWebhookDispatcher(ctx context.Context, webhooksRepo repository.Webhook, deliveriesRepo repository.WebhookDelivery, logger *logrus.Entry, wg *sync.WaitGroup) *webhook.Dispatcher {
+	dispatcher := webhook.NewDispatcher(
+		webhooksRepo,
+		deliveriesRepo,
+		&http.Client{Timeout: cfg.webhookTimeout},
+		webhook.DispatcherConfig{
+			MaxAttempts: cfg.webhookMaxAttempts,
+			Backoff:     cfg.webhookBackoff,
+		},
+		logger,
+	)
+
+	wg.Add(1)
+	go gracefulWebhookClose(ctx, dispatcher, logger, wg)
+
+	return dispatcher
+}
+
+// newMongoDatabase connects to mongo. The connection is only closed after the
+// dependents are done, so background writers like the exposure stream can
+// flush before the client disconnects.
+func newMongoDatabase(ctx context.Context, uri string, dependents *sync.WaitGroup, logger *logrus.Entry, wg *sync.WaitGroup) (*mongo.Database, error) {
 	mongoClient, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
 	if err != nil {
 		return nil, err
 	}
 	wg.Add(1)
-	go gracefulMongoDisconnect(ctx, mongoClient, logger, wg)
+	go gracefulMongoDisconnect(ctx, mongoClient, dependents, logger, wg)
 	return mongoClient.Database("flaggio"), nil // TODO: make configurable
 }
 
-func gracefulMongoDisconnect(ctx context.Context, client *mongo.Client, logger *logrus.Entry, wg *sync.WaitGroup) { // nolint:interfacer // want mongo.Client for consistency
+func gracefulMongoDisconnect(ctx context.Context, client *mongo.Client, dependents *sync.WaitGroup, logger *logrus.Entry, wg *sync.WaitGroup) { // nolint:interfacer // want mongo.Client for consistency
 	<-ctx.Done()
+	if dependents != nil {
+		logger.Debug("waiting for mongo dependents to close")
+		dependents.Wait()
+	}
 	logger.Debug("disconnecting from mongo")
 	if err := client.Disconnect(ctx); err != nil {
 		logger.WithError(err).Error("failed to disconnect from mongo")
@@ -99,3 +157,40 @@ func gracefulServerShutdown(ctx context.Context, srv *http.Server, logger *logru
 	}
 	wg.Done()
 }
+
+func gracefulGRPCShutdown(ctx context.Context, srv *grpc.Server, logger *logrus.Entry, wg *sync.WaitGroup) {
+	<-ctx.Done()
+	logger.Debug("shutting down grpc server")
+
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+
+	// watch streams only end when the clients disconnect, so force
+	// the server to stop if it takes too long
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		logger.Error("could not gracefully shutdown the grpc server")
+		srv.Stop()
+	}
+	wg.Done()
+}
+
+func gracefulExposureClose(ctx context.Context, stream *exposure.Stream, logger *logrus.Entry, wg *sync.WaitGroup) {
+	<-ctx.Done()
+	logger.Debug("closing exposure stream")
+	if err := stream.Close(); err != nil {
+		logger.WithError(err).Error("failed to close exposure stream")
+	}
+	wg.Done()
+}
+
+func gracefulWebhookClose(ctx context.Context, dispatcher *webhook.Dispatcher, logger *logrus.Entry, wg *sync.WaitGroup) {
+	<-ctx.Done()
+	logger.Debug("waiting for webhook deliveries")
+	dispatcher.Close()
+	wg.Done()
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/lint"
+	mongo_repo "github.com/uw-labs/flaggio/internal/repository/mongodb"
+)
+
+var lintCommand = &cli.Command{
+	Name:  "lint",
+	Usage: "Checks the configuration of the flags for problems",
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  "flag",
+			Usage: "Key of a flag to check. All flags are checked if not set",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		if err := cfg.requireDatabase(); err != nil {
+			return err
+		}
+		var wg sync.WaitGroup
+		ctx, cancel := context.WithCancel(c.Context)
+		// disconnect from the database before exiting
+		defer wg.Wait()
+		defer cancel()
+
+		logger := logrus.New()
+		logger.SetLevel(logrus.WarnLevel)
+		errCount, err := lintFlags(ctx, &wg, logger.WithField("app", "lint"), c.StringSlice("flag"), os.Stdout)
+		if err != nil {
+			return err
+		}
+		if errCount > 0 {
+			return cli.Exit(fmt.Sprintf("found %d errors", errCount), 1)
+		}
+		return nil
+	},
+}
+
+// lintFlags writes the issues found in the flags with the given keys, or in
+// all flags if no keys are given, and returns how many of them are errors.
+func lintFlags(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry, keys []string, w io.Writer) (int, error) {
+	// connect to mongo
+	db, err := newMongoDatabase(ctx, cfg.databaseURI, nil, logger, wg)
+	if err != nil {
+		return 0, err
+	}
+
+	// setup repositories
+	flagRepo, err := mongo_repo.NewFlagRepository(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+	segmentRepo, err := mongo_repo.NewSegmentRepository(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+
+	flgs, err := flagRepo.FindAll(ctx, nil, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	sgmnts, err := segmentRepo.FindAll(ctx, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	filter := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		filter[key] = true
+	}
+	var errCount int
+	for _, flg := range flgs.Flags {
+		if len(filter) > 0 && !filter[flg.Key] {
+			continue
+		}
+		for _, issue := range lint.Flag(flg, sgmnts) {
+			if issue.Severity == flaggio.LintSeverityError {
+				errCount++
+			}
+			location := flg.Key
+			if issue.RuleID != nil {
+				location += " rule " + *issue.RuleID
+			}
+			if _, err := fmt.Fprintf(w, "%s: %s %s: %s\n", location, issue.Severity, issue.Code, issue.Message); err != nil {
+				return errCount, err
+			}
+		}
+	}
+	return errCount, nil
+}
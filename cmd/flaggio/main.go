@@ -12,6 +12,7 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
+	"github.com/uw-labs/flaggio/internal/service"
 )
 
 var (
@@ -38,7 +39,14 @@ func main() { // nolint:gocyclo // dependencies
 		Description: ApplicationDescription,
 		Version:     ApplicationVersion,
 		Flags:       flags,
+		Commands:    []*cli.Command{lintCommand, evaluateCommand},
 		Action: func(_ *cli.Context) error {
+			if err := cfg.requireDatabase(); err != nil {
+				return err
+			}
+			if err := cfg.validate(); err != nil {
+				return err
+			}
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
@@ -76,10 +84,30 @@ func main() { // nolint:gocyclo // dependencies
 
 			errs := make(chan error, 1)
 			var wg sync.WaitGroup
+			var flagService service.Flag
+			var experimentService service.Experiment
+			if !cfg.noAPI || cfg.isGRPCEnabled() {
+				// setup the services shared by the API and gRPC servers
+				flagService, experimentService, err = newServices(ctx, &wg, logger.WithField("app", "services"))
+				if err != nil {
+					cancel()
+					wg.Wait()
+					return err
+				}
+			}
 			if !cfg.noAPI {
 				// start API server
 				go func() {
-					err := startAPI(ctx, &wg, logger.WithField("app", "api"))
+					err := startAPI(ctx, &wg, logger.WithField("app", "api"), flagService, experimentService)
+					if err != nil {
+						errs <- err
+					}
+				}()
+			}
+			if cfg.isGRPCEnabled() {
+				// start gRPC server
+				go func() {
+					err := startGRPC(ctx, &wg, logger.WithField("app", "grpc"), flagService)
 					if err != nil {
 						errs <- err
 					}
@@ -9,6 +9,7 @@ require (
 	github.com/go-chi/render v1.0.1
 	github.com/go-redis/redis/v7 v7.4.0
 	github.com/golang/mock v1.4.4
+	github.com/golang/protobuf v1.4.2
 	github.com/opentracing/opentracing-go v1.2.0
 	github.com/prometheus/client_golang v1.5.1 // indirect
 	github.com/rs/cors v1.7.0
@@ -22,4 +23,6 @@ require (
 	github.com/vmihailenco/msgpack/v4 v4.3.12
 	go.mongodb.org/mongo-driver v1.7.3
 	go.uber.org/atomic v1.6.0 // indirect
+	google.golang.org/grpc v1.34.0
+	google.golang.org/protobuf v1.25.0
 )
@@ -48,6 +48,9 @@ var (
 	ErrNoVariantToDistribute = Err{
 		msg:        "no variants to distribute, please check the rule configuration",
 		statusCode: http.StatusUnprocessableEntity, appCode: "NoVariantToDistribute"}
+	ErrFlagArchived = Err{
+		msg:        "flag is archived",
+		statusCode: http.StatusGone, appCode: "FlagArchived"}
 	ErrNotFound = Err{
 		msg:        "not found",
 		statusCode: http.StatusNotFound, appCode: "NotFound"}
@@ -0,0 +1,49 @@
+package exposure
+
+//go:generate mockgen -destination=./mocks/emitter_mock.go -package=exposure_mock github.com/uw-labs/flaggio/internal/exposure Emitter
+
+import (
+	"time"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+// Event is emitted every time a flag value is returned to a user.
+type Event struct {
+	FlagKey     string         `json:"flagKey"`
+	FlagVersion int            `json:"flagVersion"`
+	VariantID   string         `json:"variantId,omitempty"`
+	UserID      string         `json:"userId"`
+	Reason      flaggio.Reason `json:"reason,omitempty"`
+	RuleName    string         `json:"ruleName,omitempty"`
+	Experiment  bool           `json:"experiment,omitempty"`
+	Timestamp   time.Time      `json:"timestamp"`
+}
+
+// NewEvent returns an exposure event for the user evaluation of the flag.
+func NewEvent(userID string, flg *flaggio.Flag, eval *flaggio.Evaluation) Event {
+	return Event{
+		FlagKey:     eval.FlagKey,
+		FlagVersion: eval.FlagVersion,
+		VariantID:   eval.VariantID,
+		UserID:      userID,
+		Reason:      eval.Reason,
+		RuleName:    eval.RuleName,
+		Experiment:  flg.Experiment,
+		Timestamp:   time.Now(),
+	}
+}
+
+// Emitter emits exposure events.
+type Emitter interface {
+	// Emit emits an exposure event. It must not block.
+	Emit(evt Event)
+}
+
+var _ Emitter = NopEmitter{}
+
+// NopEmitter is an Emitter that drops all events.
+type NopEmitter struct{}
+
+// Emit drops the event.
+func (NopEmitter) Emit(Event) {}
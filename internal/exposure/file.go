@@ -0,0 +1,44 @@
+package exposure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+)
+
+var _ Sink = (*FileSink)(nil)
+
+// NewFileSink returns a sink that appends the events to a file as
+// newline-delimited JSON. The file is created if it doesn't exist.
+func NewFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) // nolint:gosec // path comes from the configuration
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{file: f}, nil
+}
+
+// FileSink writes events to a newline-delimited JSON file.
+type FileSink struct {
+	file *os.File
+}
+
+// Send appends the events to the file, one per line. The whole batch is
+// written at once, so batches from different streams don't interleave.
+func (s *FileSink) Send(_ context.Context, events []Event) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, evt := range events {
+		if err := enc.Encode(evt); err != nil {
+			return err
+		}
+	}
+	_, err := s.file.Write(buf.Bytes())
+	return err
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
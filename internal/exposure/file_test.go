@@ -0,0 +1,45 @@
+package exposure_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uw-labs/flaggio/internal/exposure"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+func TestFileSink(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "exposures")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "exposures.ndjson")
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	sink, err := exposure.NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Send(context.Background(), []exposure.Event{
+		{FlagKey: "a", FlagVersion: 2, VariantID: "1", UserID: "u1", Reason: flaggio.ReasonSplit, Timestamp: ts},
+	}))
+	require.NoError(t, sink.Close())
+
+	// events are appended to existing files
+	sink, err = exposure.NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Send(context.Background(), []exposure.Event{
+		{FlagKey: "b", FlagVersion: 1, UserID: "u2", Timestamp: ts},
+	}))
+	require.NoError(t, sink.Close())
+
+	b, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, `{"flagKey":"a","flagVersion":2,"variantId":"1","userId":"u1","reason":"SPLIT","timestamp":"2020-01-02T03:04:05Z"}
+{"flagKey":"b","flagVersion":1,"userId":"u2","timestamp":"2020-01-02T03:04:05Z"}
+`, string(b))
+}
@@ -0,0 +1,59 @@
+package exposure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var _ Sink = (*KafkaSink)(nil)
+
+// kafkaContentType is the content type used by the Kafka REST Proxy API
+// for records with JSON keys and values.
+const kafkaContentType = "application/vnd.kafka.json.v2+json"
+
+// NewKafkaSink returns a sink that produces the events to a Kafka topic
+// through a Kafka REST Proxy compatible API, like the ones provided by
+// Confluent or Redpanda. The events are keyed by user ID, so all events
+// from the same user go to the same partition.
+func NewKafkaSink(proxyURL, topic string, client *http.Client) *KafkaSink {
+	return &KafkaSink{
+		url:    strings.TrimSuffix(proxyURL, "/") + "/topics/" + url.PathEscape(topic),
+		client: client,
+	}
+}
+
+// KafkaSink produces events to a Kafka topic.
+type KafkaSink struct {
+	url    string
+	client *http.Client
+}
+
+type kafkaRecord struct {
+	Key   string `json:"key"`
+	Value Event  `json:"value"`
+}
+
+type kafkaPayload struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+// Send produces the events to the topic.
+func (s *KafkaSink) Send(ctx context.Context, events []Event) error {
+	payload := kafkaPayload{Records: make([]kafkaRecord, len(events))}
+	for idx, evt := range events {
+		payload.Records[idx] = kafkaRecord{Key: evt.UserID, Value: evt}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return postJSON(ctx, s.client, s.url, kafkaContentType, body)
+}
+
+// Close is a no-op.
+func (s *KafkaSink) Close() error {
+	return nil
+}
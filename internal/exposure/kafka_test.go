@@ -0,0 +1,41 @@
+package exposure_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/exposure"
+)
+
+func TestKafkaSink(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// stub of the kafka rest proxy produce endpoint
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/topics/flaggio.exposures", r.URL.Path)
+		assert.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+		assert.JSONEq(t, `{"records": [
+			{"key":"u1","value":{"flagKey":"a","flagVersion":1,"userId":"u1","timestamp":"2020-01-02T03:04:05Z"}},
+			{"key":"u2","value":{"flagKey":"a","flagVersion":1,"userId":"u2","timestamp":"2020-01-02T03:04:05Z"}}
+		]}`, string(body))
+		w.Header().Set("Content-Type", "application/vnd.kafka.v2+json")
+		_, _ = w.Write([]byte(`{"offsets":[{"partition":0,"offset":0},{"partition":1,"offset":0}]}`))
+	}))
+	defer srv.Close()
+
+	sink := exposure.NewKafkaSink(srv.URL+"/", "flaggio.exposures", srv.Client())
+	err := sink.Send(context.Background(), []exposure.Event{
+		{FlagKey: "a", FlagVersion: 1, UserID: "u1", Timestamp: ts},
+		{FlagKey: "a", FlagVersion: 1, UserID: "u2", Timestamp: ts},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, sink.Close())
+}
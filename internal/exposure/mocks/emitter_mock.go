@@ -0,0 +1,46 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/uw-labs/flaggio/internal/exposure (interfaces: Emitter)
+
+// Package exposure_mock is a generated GoMock package.
+package exposure_mock
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	exposure "github.com/uw-labs/flaggio/internal/exposure"
+	reflect "reflect"
+)
+
+// MockEmitter is a mock of Emitter interface
+type MockEmitter struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmitterMockRecorder
+}
+
+// MockEmitterMockRecorder is the mock recorder for MockEmitter
+type MockEmitterMockRecorder struct {
+	mock *MockEmitter
+}
+
+// NewMockEmitter creates a new mock instance
+func NewMockEmitter(ctrl *gomock.Controller) *MockEmitter {
+	mock := &MockEmitter{ctrl: ctrl}
+	mock.recorder = &MockEmitterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEmitter) EXPECT() *MockEmitterMockRecorder {
+	return m.recorder
+}
+
+// Emit mocks base method
+func (m *MockEmitter) Emit(arg0 exposure.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Emit", arg0)
+}
+
+// Emit indicates an expected call of Emit
+func (mr *MockEmitterMockRecorder) Emit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Emit", reflect.TypeOf((*MockEmitter)(nil).Emit), arg0)
+}
@@ -0,0 +1,50 @@
+package exposure
+
+import (
+	"context"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+var _ Sink = (*RecorderSink)(nil)
+
+// Recorder stores exposure events.
+type Recorder interface {
+	CreateExposures(ctx context.Context, events []Event) error
+}
+
+// NewRecorderSink returns a sink that stores the events using the recorder.
+func NewRecorderSink(recorder Recorder) *RecorderSink {
+	return &RecorderSink{recorder: recorder}
+}
+
+// RecorderSink stores events, so they can be aggregated by experiments.
+type RecorderSink struct {
+	recorder Recorder
+}
+
+// Send stores the events of flags that are experiments, when the variant was
+// served by a rule. Other events are dropped, since users who were served the
+// disabled or default variant aren't part of the experiment.
+func (s *RecorderSink) Send(ctx context.Context, events []Event) error {
+	var experimentEvents []Event
+	for _, evt := range events {
+		if evt.Experiment && servedByRule(evt.Reason) {
+			experimentEvents = append(experimentEvents, evt)
+		}
+	}
+	if len(experimentEvents) == 0 {
+		return nil
+	}
+	return s.recorder.CreateExposures(ctx, experimentEvents)
+}
+
+// servedByRule returns whether the reason is a rule match.
+func servedByRule(reason flaggio.Reason) bool {
+	return reason == flaggio.ReasonTargetingMatch || reason == flaggio.ReasonSplit
+}
+
+// Close is a no-op.
+func (s *RecorderSink) Close() error {
+	return nil
+}
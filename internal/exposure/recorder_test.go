@@ -0,0 +1,72 @@
+package exposure_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uw-labs/flaggio/internal/exposure"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+type recorderFunc func(ctx context.Context, events []exposure.Event) error
+
+func (f recorderFunc) CreateExposures(ctx context.Context, events []exposure.Event) error {
+	return f(ctx, events)
+}
+
+func TestRecorderSink(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		events           []exposure.Event
+		expectedRecorded [][]exposure.Event
+	}{
+		{
+			name: "records only the events of experiments",
+			events: []exposure.Event{
+				{FlagKey: "a", VariantID: "1", UserID: "u1", Reason: flaggio.ReasonSplit, Experiment: true},
+				{FlagKey: "b", VariantID: "1", UserID: "u1", Reason: flaggio.ReasonSplit},
+				{FlagKey: "a", VariantID: "2", UserID: "u2", Reason: flaggio.ReasonTargetingMatch, Experiment: true},
+			},
+			expectedRecorded: [][]exposure.Event{{
+				{FlagKey: "a", VariantID: "1", UserID: "u1", Reason: flaggio.ReasonSplit, Experiment: true},
+				{FlagKey: "a", VariantID: "2", UserID: "u2", Reason: flaggio.ReasonTargetingMatch, Experiment: true},
+			}},
+		},
+		{
+			name: "doesn't record the events not served by a rule",
+			events: []exposure.Event{
+				{FlagKey: "a", VariantID: "1", UserID: "u1", Reason: flaggio.ReasonDisabled, Experiment: true},
+				{FlagKey: "a", VariantID: "1", UserID: "u2", Reason: flaggio.ReasonDefault, Experiment: true},
+				{FlagKey: "a", UserID: "u3", Reason: flaggio.ReasonExcluded, Experiment: true},
+				{FlagKey: "a", VariantID: "2", UserID: "u4", Reason: flaggio.ReasonSplit, Experiment: true},
+			},
+			expectedRecorded: [][]exposure.Event{{
+				{FlagKey: "a", VariantID: "2", UserID: "u4", Reason: flaggio.ReasonSplit, Experiment: true},
+			}},
+		},
+		{
+			name: "doesn't record batches without experiments",
+			events: []exposure.Event{
+				{FlagKey: "b", VariantID: "1", UserID: "u1"},
+				{FlagKey: "c", VariantID: "2", UserID: "u2"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var recorded [][]exposure.Event
+			sink := exposure.NewRecorderSink(recorderFunc(func(_ context.Context, events []exposure.Event) error {
+				recorded = append(recorded, events)
+				return nil
+			}))
+			require.NoError(t, sink.Send(context.Background(), tt.events))
+			assert.Equal(t, tt.expectedRecorded, recorded)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package exposure
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var _ Emitter = (*Stream)(nil)
+
+// Sink receives batches of exposure events.
+type Sink interface {
+	// Send sends a batch of events. The events slice must not be retained.
+	Send(ctx context.Context, events []Event) error
+	// Close releases any resources held by the sink.
+	Close() error
+}
+
+// StreamConfig configures how events are buffered by a Stream.
+type StreamConfig struct {
+	// BufferSize is how many events can be waiting to be sent. Events
+	// emitted when the buffer is full are dropped.
+	BufferSize int
+	// BatchSize is the maximum amount of events sent to the sinks at once.
+	BatchSize int
+	// FlushInterval is the maximum time an event waits to be sent.
+	FlushInterval time.Duration
+}
+
+// Validate returns an error if any of the values of the config is invalid.
+func (c StreamConfig) Validate() error {
+	switch {
+	case c.BufferSize < 0:
+		return fmt.Errorf("invalid exposure buffer size: %d, can't be negative", c.BufferSize)
+	case c.BatchSize <= 0:
+		return fmt.Errorf("invalid exposure batch size: %d, must be positive", c.BatchSize)
+	case c.FlushInterval <= 0:
+		return fmt.Errorf("invalid exposure flush interval: %s, must be positive", c.FlushInterval)
+	}
+	return nil
+}
+
+// NewStream returns a Stream that buffers events and sends them to all sinks
+// asynchronously, in batches. An error is returned if the config is invalid.
+func NewStream(config StreamConfig, logger *logrus.Entry, sinks ...Sink) (*Stream, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	s := &Stream{
+		config: config,
+		sinks:  sinks,
+		events: make(chan Event, config.BufferSize),
+		done:   make(chan struct{}),
+		logger: logger,
+	}
+	go s.run()
+	return s, nil
+}
+
+// Stream is a buffered, asynchronous Emitter.
+type Stream struct {
+	config StreamConfig
+	sinks  []Sink
+	events chan Event
+	done   chan struct{}
+	logger *logrus.Entry
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// Emit adds the event to the buffer. It never blocks: if the buffer is
+// full or the stream is closed, the event is dropped.
+func (s *Stream) Emit(evt Event) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.events <- evt:
+	default:
+		s.logger.WithField("flag_key", evt.FlagKey).Warn("exposure buffer is full, dropping event")
+	}
+}
+
+// Close sends all the buffered events and closes the sinks.
+func (s *Stream) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.events)
+	s.mu.Unlock()
+
+	<-s.done
+	var firstErr error
+	for _, sink := range s.sinks {
+		if err := sink.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (s *Stream) run() {
+	defer close(s.done)
+	ticker := time.NewTicker(s.config.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Event, 0, s.config.BatchSize)
+	for {
+		select {
+		case evt, ok := <-s.events:
+			if !ok {
+				s.flush(batch)
+				return
+			}
+			batch = append(batch, evt)
+			if len(batch) < s.config.BatchSize {
+				continue
+			}
+		case <-ticker.C:
+		}
+		s.flush(batch)
+		batch = batch[:0]
+	}
+}
+
+func (s *Stream) flush(batch []Event) {
+	if len(batch) == 0 {
+		return
+	}
+	for _, sink := range s.sinks {
+		if err := sink.Send(context.Background(), batch); err != nil {
+			s.logger.WithError(err).WithField("events", len(batch)).
+				Error("failed to send exposure events")
+		}
+	}
+}
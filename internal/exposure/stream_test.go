@@ -0,0 +1,139 @@
+package exposure_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/exposure"
+)
+
+type memorySink struct {
+	mu      sync.Mutex
+	batches [][]exposure.Event
+	closed  bool
+}
+
+func (s *memorySink) Send(_ context.Context, events []exposure.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.batches = append(s.batches, append([]exposure.Event(nil), events...))
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func (s *memorySink) batchSizes() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sizes := make([]int, len(s.batches))
+	for idx, batch := range s.batches {
+		sizes[idx] = len(batch)
+	}
+	return sizes
+}
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		config        exposure.StreamConfig
+		events        int
+		expectedSizes []int
+	}{
+		{
+			name:          "sends full batches and flushes remaining on close",
+			config:        exposure.StreamConfig{BufferSize: 10, BatchSize: 2, FlushInterval: time.Hour},
+			events:        5,
+			expectedSizes: []int{2, 2, 1},
+		},
+		{
+			name:          "doesn't send empty batches",
+			config:        exposure.StreamConfig{BufferSize: 10, BatchSize: 2, FlushInterval: time.Hour},
+			events:        0,
+			expectedSizes: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			sink1, sink2 := &memorySink{}, &memorySink{}
+			stream, err := exposure.NewStream(tt.config, logrus.NewEntry(logrus.New()), sink1, sink2)
+			assert.NoError(t, err)
+			for i := 0; i < tt.events; i++ {
+				stream.Emit(exposure.Event{FlagKey: "a"})
+			}
+			assert.NoError(t, stream.Close())
+
+			assert.Equal(t, tt.expectedSizes, sink1.batchSizes())
+			assert.Equal(t, tt.expectedSizes, sink2.batchSizes())
+			assert.True(t, sink1.closed)
+			assert.True(t, sink2.closed)
+
+			// events emitted after closing are dropped
+			stream.Emit(exposure.Event{FlagKey: "a"})
+			assert.Equal(t, tt.expectedSizes, sink1.batchSizes())
+		})
+	}
+}
+
+func TestStream_FlushInterval(t *testing.T) {
+	t.Parallel()
+	sink := &memorySink{}
+	stream, err := exposure.NewStream(exposure.StreamConfig{
+		BufferSize: 10, BatchSize: 100, FlushInterval: 10 * time.Millisecond,
+	}, logrus.NewEntry(logrus.New()), sink)
+	assert.NoError(t, err)
+	defer stream.Close()
+
+	stream.Emit(exposure.Event{FlagKey: "a"})
+	assert.Eventually(t, func() bool {
+		return len(sink.batchSizes()) == 1
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestNewStreamErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		config      exposure.StreamConfig
+		expectedErr string
+	}{
+		{
+			name:        "negative buffer size",
+			config:      exposure.StreamConfig{BufferSize: -1, BatchSize: 1, FlushInterval: time.Second},
+			expectedErr: "invalid exposure buffer size: -1, can't be negative",
+		},
+		{
+			name:        "zero batch size",
+			config:      exposure.StreamConfig{BufferSize: 10, FlushInterval: time.Second},
+			expectedErr: "invalid exposure batch size: 0, must be positive",
+		},
+		{
+			name:        "zero flush interval",
+			config:      exposure.StreamConfig{BufferSize: 10, BatchSize: 1},
+			expectedErr: "invalid exposure flush interval: 0s, must be positive",
+		},
+		{
+			name:        "negative flush interval",
+			config:      exposure.StreamConfig{BufferSize: 10, BatchSize: 1, FlushInterval: -time.Second},
+			expectedErr: "invalid exposure flush interval: -1s, must be positive",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := exposure.NewStream(tt.config, logrus.NewEntry(logrus.New()))
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
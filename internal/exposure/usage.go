@@ -0,0 +1,71 @@
+package exposure
+
+import (
+	"context"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+var _ Sink = (*UsageSink)(nil)
+
+// UsageRecorder stores flag usage.
+type UsageRecorder interface {
+	RecordUsage(ctx context.Context, usages []*flaggio.FlagUsage) error
+}
+
+// NewUsageSink returns a sink that aggregates the events per flag and
+// stores them as flag usage.
+func NewUsageSink(recorder UsageRecorder) *UsageSink {
+	return &UsageSink{recorder: recorder}
+}
+
+// UsageSink stores the usage of each flag, so stale flags can be found.
+type UsageSink struct {
+	recorder UsageRecorder
+}
+
+// Send aggregates the events per flag and stores their usage.
+func (s *UsageSink) Send(ctx context.Context, events []Event) error {
+	usagesMap := map[string]*flaggio.FlagUsage{}
+	var usages []*flaggio.FlagUsage
+	for _, evt := range events {
+		usg, ok := usagesMap[evt.FlagKey]
+		if !ok {
+			usg = &flaggio.FlagUsage{
+				FlagKey:          evt.FlagKey,
+				FirstEvaluatedAt: evt.Timestamp,
+				LastEvaluatedAt:  evt.Timestamp,
+			}
+			usagesMap[evt.FlagKey] = usg
+			usages = append(usages, usg)
+		}
+		usg.Evaluations++
+		if evt.Timestamp.Before(usg.FirstEvaluatedAt) {
+			usg.FirstEvaluatedAt = evt.Timestamp
+		}
+		if evt.Timestamp.After(usg.LastEvaluatedAt) {
+			usg.LastEvaluatedAt = evt.Timestamp
+		}
+		if evt.VariantID != "" {
+			addVariantUsage(usg, evt)
+		}
+	}
+	return s.recorder.RecordUsage(ctx, usages)
+}
+
+// Close is a no-op.
+func (s *UsageSink) Close() error {
+	return nil
+}
+
+func addVariantUsage(usg *flaggio.FlagUsage, evt Event) {
+	for _, vrntUsg := range usg.Variants {
+		if vrntUsg.VariantID == evt.VariantID {
+			if evt.Timestamp.After(vrntUsg.LastServedAt) {
+				vrntUsg.LastServedAt = evt.Timestamp
+			}
+			return
+		}
+	}
+	usg.Variants = append(usg.Variants, &flaggio.VariantUsage{VariantID: evt.VariantID, LastServedAt: evt.Timestamp})
+}
@@ -0,0 +1,54 @@
+package exposure_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uw-labs/flaggio/internal/exposure"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+type usageRecorderFunc func(ctx context.Context, usages []*flaggio.FlagUsage) error
+
+func (f usageRecorderFunc) RecordUsage(ctx context.Context, usages []*flaggio.FlagUsage) error {
+	return f(ctx, usages)
+}
+
+func TestUsageSink(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var recorded []*flaggio.FlagUsage
+	sink := exposure.NewUsageSink(usageRecorderFunc(func(_ context.Context, usages []*flaggio.FlagUsage) error {
+		recorded = usages
+		return nil
+	}))
+	require.NoError(t, sink.Send(context.Background(), []exposure.Event{
+		{FlagKey: "a", VariantID: "1", UserID: "u1", Timestamp: ts.Add(time.Minute)},
+		{FlagKey: "b", UserID: "u1", Timestamp: ts},
+		{FlagKey: "a", VariantID: "2", UserID: "u2", Timestamp: ts},
+		{FlagKey: "a", VariantID: "1", UserID: "u3", Timestamp: ts.Add(2 * time.Minute)},
+	}))
+
+	assert.Equal(t, []*flaggio.FlagUsage{
+		{
+			FlagKey:          "a",
+			Evaluations:      3,
+			FirstEvaluatedAt: ts,
+			LastEvaluatedAt:  ts.Add(2 * time.Minute),
+			Variants: []*flaggio.VariantUsage{
+				{VariantID: "1", LastServedAt: ts.Add(2 * time.Minute)},
+				{VariantID: "2", LastServedAt: ts},
+			},
+		},
+		{
+			FlagKey:          "b",
+			Evaluations:      1,
+			FirstEvaluatedAt: ts,
+			LastEvaluatedAt:  ts,
+		},
+	}, recorded)
+}
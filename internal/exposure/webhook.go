@@ -0,0 +1,64 @@
+package exposure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+var _ Sink = (*WebhookSink)(nil)
+
+// NewWebhookSink returns a sink that posts the batches of events to a URL.
+func NewWebhookSink(url string, client *http.Client) *WebhookSink {
+	return &WebhookSink{url: url, client: client}
+}
+
+// WebhookSink posts batches of events as JSON to a URL, with the format:
+//
+//	{"events": [...]}
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+type webhookPayload struct {
+	Events []Event `json:"events"`
+}
+
+// Send posts the events to the webhook URL. Any response status other
+// than 2xx is considered an error.
+func (s *WebhookSink) Send(ctx context.Context, events []Event) error {
+	body, err := json.Marshal(webhookPayload{Events: events})
+	if err != nil {
+		return err
+	}
+	return postJSON(ctx, s.client, s.url, "application/json", body)
+}
+
+// Close is a no-op.
+func (s *WebhookSink) Close() error {
+	return nil
+}
+
+func postJSON(ctx context.Context, client *http.Client, url, contentType string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status from %s: %s", url, res.Status)
+	}
+	return nil
+}
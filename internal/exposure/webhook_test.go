@@ -0,0 +1,54 @@
+package exposure_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/exposure"
+)
+
+func TestWebhookSink(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name          string
+		status        int
+		expectedError bool
+	}{
+		{name: "posts the events", status: http.StatusOK},
+		{name: "returns error on non 2xx responses", status: http.StatusInternalServerError, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/hook", r.URL.Path)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.JSONEq(t, `{"events": [
+					{"flagKey":"a","flagVersion":1,"variantId":"1","userId":"u1","timestamp":"2020-01-02T03:04:05Z"}
+				]}`, string(body))
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			sink := exposure.NewWebhookSink(srv.URL+"/hook", srv.Client())
+			err := sink.Send(context.Background(), []exposure.Event{
+				{FlagKey: "a", FlagVersion: 1, VariantID: "1", UserID: "u1", Timestamp: ts},
+			})
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -18,15 +18,30 @@ type EvaluationResults struct {
 	Total       int           `json:"total"`
 }
 
+type FlagFilter struct {
+	Keys          []string `json:"keys"`
+	Tags          []string `json:"tags"`
+	Owner         *string  `json:"owner"`
+	Enabled       *bool    `json:"enabled"`
+	Archived      *bool    `json:"archived"`
+	ClientExposed *bool    `json:"clientExposed"`
+}
+
+type FlagProposal struct {
+	Flag  *UpdateFlag    `json:"flag"`
+	Rules []*NewFlagRule `json:"rules"`
+}
+
 type FlagResults struct {
 	Flags []*Flag `json:"flags"`
 	Total int     `json:"total"`
 }
 
 type NewConstraint struct {
-	Property  string        `json:"property"`
-	Operation Operation     `json:"operation"`
-	Values    []interface{} `json:"values"`
+	Property    *string          `json:"property"`
+	Operation   Operation        `json:"operation"`
+	Values      []interface{}    `json:"values"`
+	Constraints []*NewConstraint `json:"constraints"`
 }
 
 type NewDistribution struct {
@@ -34,51 +49,118 @@ type NewDistribution struct {
 	Percentage int    `json:"percentage"`
 }
 
+type NewExclusionGroup struct {
+	Name        string               `json:"name"`
+	Description *string              `json:"description"`
+	Allocations []*NewSlotAllocation `json:"allocations"`
+}
+
 type NewFlag struct {
-	Key         string  `json:"key"`
-	Name        string  `json:"name"`
-	Description *string `json:"description"`
+	Key           string     `json:"key"`
+	Name          string     `json:"name"`
+	Description   *string    `json:"description"`
+	Kind          *FlagKind  `json:"kind"`
+	Tags          []string   `json:"tags"`
+	OwnerTeam     *string    `json:"ownerTeam"`
+	Maintainer    *string    `json:"maintainer"`
+	Links         []string   `json:"links"`
+	ExpiresAt     *time.Time `json:"expiresAt"`
+	ClientExposed *bool      `json:"clientExposed"`
 }
 
 type NewFlagRule struct {
+	Name          *string            `json:"name"`
+	Description   *string            `json:"description"`
+	Enabled       *bool              `json:"enabled"`
 	Constraints   []*NewConstraint   `json:"constraints"`
 	Distributions []*NewDistribution `json:"distributions"`
 }
 
+type NewMetric struct {
+	Key         string  `json:"key"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
 type NewSegment struct {
 	Name        string  `json:"name"`
 	Description *string `json:"description"`
 }
 
 type NewSegmentRule struct {
+	Name        *string          `json:"name"`
+	Description *string          `json:"description"`
+	Enabled     *bool            `json:"enabled"`
 	Constraints []*NewConstraint `json:"constraints"`
 }
 
+type NewSlotAllocation struct {
+	FlagID string `json:"flagId"`
+	Start  int    `json:"start"`
+	End    int    `json:"end"`
+}
+
 type NewVariant struct {
 	Description *string     `json:"description"`
 	Value       interface{} `json:"value"`
 }
 
+type NewWebhook struct {
+	URL         string       `json:"url"`
+	Secret      string       `json:"secret"`
+	EntityTypes []EntityType `json:"entityTypes"`
+	FlagKeys    []string     `json:"flagKeys"`
+	Enabled     *bool        `json:"enabled"`
+}
+
+type UpdateExclusionGroup struct {
+	Name        *string              `json:"name"`
+	Description *string              `json:"description"`
+	Allocations []*NewSlotAllocation `json:"allocations"`
+}
+
 type UpdateFlag struct {
-	Key                   *string `json:"key"`
-	Name                  *string `json:"name"`
-	Description           *string `json:"description"`
-	Enabled               *bool   `json:"enabled"`
-	DefaultVariantWhenOn  *string `json:"defaultVariantWhenOn"`
-	DefaultVariantWhenOff *string `json:"defaultVariantWhenOff"`
+	Key                   *string    `json:"key"`
+	Name                  *string    `json:"name"`
+	Description           *string    `json:"description"`
+	Kind                  *FlagKind  `json:"kind"`
+	Tags                  []string   `json:"tags"`
+	OwnerTeam             *string    `json:"ownerTeam"`
+	Maintainer            *string    `json:"maintainer"`
+	Links                 []string   `json:"links"`
+	ExpiresAt             *time.Time `json:"expiresAt"`
+	ClientExposed         *bool      `json:"clientExposed"`
+	Enabled               *bool      `json:"enabled"`
+	DefaultVariantWhenOn  *string    `json:"defaultVariantWhenOn"`
+	DefaultVariantWhenOff *string    `json:"defaultVariantWhenOff"`
+	Experiment            *bool      `json:"experiment"`
+	Protected             *bool      `json:"protected"`
+	RequiredApprovals     *int       `json:"requiredApprovals"`
 }
 
 type UpdateFlagRule struct {
+	Name          *string            `json:"name"`
+	Description   *string            `json:"description"`
+	Enabled       *bool              `json:"enabled"`
 	Constraints   []*NewConstraint   `json:"constraints"`
 	Distributions []*NewDistribution `json:"distributions"`
 }
 
+type UpdateMetric struct {
+	Key         *string `json:"key"`
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+}
+
 type UpdateSegment struct {
 	Name        *string `json:"name"`
 	Description *string `json:"description"`
 }
 
 type UpdateSegmentRule struct {
+	Name        *string          `json:"name"`
+	Description *string          `json:"description"`
+	Enabled     *bool            `json:"enabled"`
 	Constraints []*NewConstraint `json:"constraints"`
 }
 
@@ -87,6 +169,14 @@ type UpdateVariant struct {
 	Value       interface{} `json:"value"`
 }
 
+type UpdateWebhook struct {
+	URL         *string      `json:"url"`
+	Secret      *string      `json:"secret"`
+	EntityTypes []EntityType `json:"entityTypes"`
+	FlagKeys    []string     `json:"flagKeys"`
+	Enabled     *bool        `json:"enabled"`
+}
+
 type User struct {
 	ID          string                 `json:"id"`
 	Context     map[string]interface{} `json:"context"`
@@ -99,6 +189,279 @@ type UserResults struct {
 	Total int     `json:"total"`
 }
 
+type WebhookDeliveryResults struct {
+	Deliveries []*WebhookDelivery `json:"deliveries"`
+	Total      int                `json:"total"`
+}
+
+type ChangeAction string
+
+const (
+	ChangeActionCreated ChangeAction = "CREATED"
+	ChangeActionUpdated ChangeAction = "UPDATED"
+	ChangeActionDeleted ChangeAction = "DELETED"
+)
+
+var AllChangeAction = []ChangeAction{
+	ChangeActionCreated,
+	ChangeActionUpdated,
+	ChangeActionDeleted,
+}
+
+func (e ChangeAction) IsValid() bool {
+	switch e {
+	case ChangeActionCreated, ChangeActionUpdated, ChangeActionDeleted:
+		return true
+	}
+	return false
+}
+
+func (e ChangeAction) String() string {
+	return string(e)
+}
+
+func (e *ChangeAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChangeAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChangeAction", str)
+	}
+	return nil
+}
+
+func (e ChangeAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ChangeRequestStatus string
+
+const (
+	ChangeRequestStatusPending  ChangeRequestStatus = "PENDING"
+	ChangeRequestStatusApplied  ChangeRequestStatus = "APPLIED"
+	ChangeRequestStatusRejected ChangeRequestStatus = "REJECTED"
+)
+
+var AllChangeRequestStatus = []ChangeRequestStatus{
+	ChangeRequestStatusPending,
+	ChangeRequestStatusApplied,
+	ChangeRequestStatusRejected,
+}
+
+func (e ChangeRequestStatus) IsValid() bool {
+	switch e {
+	case ChangeRequestStatusPending, ChangeRequestStatusApplied, ChangeRequestStatusRejected:
+		return true
+	}
+	return false
+}
+
+func (e ChangeRequestStatus) String() string {
+	return string(e)
+}
+
+func (e *ChangeRequestStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChangeRequestStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChangeRequestStatus", str)
+	}
+	return nil
+}
+
+func (e ChangeRequestStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type EntityType string
+
+const (
+	EntityTypeFlag    EntityType = "FLAG"
+	EntityTypeVariant EntityType = "VARIANT"
+	EntityTypeRule    EntityType = "RULE"
+	EntityTypeSegment EntityType = "SEGMENT"
+)
+
+var AllEntityType = []EntityType{
+	EntityTypeFlag,
+	EntityTypeVariant,
+	EntityTypeRule,
+	EntityTypeSegment,
+}
+
+func (e EntityType) IsValid() bool {
+	switch e {
+	case EntityTypeFlag, EntityTypeVariant, EntityTypeRule, EntityTypeSegment:
+		return true
+	}
+	return false
+}
+
+func (e EntityType) String() string {
+	return string(e)
+}
+
+func (e *EntityType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EntityType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EntityType", str)
+	}
+	return nil
+}
+
+func (e EntityType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type FlagKind string
+
+const (
+	FlagKindTemporary FlagKind = "TEMPORARY"
+	FlagKindPermanent FlagKind = "PERMANENT"
+)
+
+var AllFlagKind = []FlagKind{
+	FlagKindTemporary,
+	FlagKindPermanent,
+}
+
+func (e FlagKind) IsValid() bool {
+	switch e {
+	case FlagKindTemporary, FlagKindPermanent:
+		return true
+	}
+	return false
+}
+
+func (e FlagKind) String() string {
+	return string(e)
+}
+
+func (e *FlagKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FlagKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FlagKind", str)
+	}
+	return nil
+}
+
+func (e FlagKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type LintCode string
+
+const (
+	LintCodeDistributionSum       LintCode = "DISTRIBUTION_SUM"
+	LintCodeMissingDefaultVariant LintCode = "MISSING_DEFAULT_VARIANT"
+	LintCodeUnknownVariant        LintCode = "UNKNOWN_VARIANT"
+	LintCodeUnknownSegment        LintCode = "UNKNOWN_SEGMENT"
+	LintCodeInvalidRegex          LintCode = "INVALID_REGEX"
+	LintCodeInvalidCidr           LintCode = "INVALID_CIDR"
+	LintCodeInvalidValueType      LintCode = "INVALID_VALUE_TYPE"
+	LintCodeUnreachableRule       LintCode = "UNREACHABLE_RULE"
+	LintCodeDuplicateConstraint   LintCode = "DUPLICATE_CONSTRAINT"
+)
+
+var AllLintCode = []LintCode{
+	LintCodeDistributionSum,
+	LintCodeMissingDefaultVariant,
+	LintCodeUnknownVariant,
+	LintCodeUnknownSegment,
+	LintCodeInvalidRegex,
+	LintCodeInvalidCidr,
+	LintCodeInvalidValueType,
+	LintCodeUnreachableRule,
+	LintCodeDuplicateConstraint,
+}
+
+func (e LintCode) IsValid() bool {
+	switch e {
+	case LintCodeDistributionSum, LintCodeMissingDefaultVariant, LintCodeUnknownVariant, LintCodeUnknownSegment, LintCodeInvalidRegex, LintCodeInvalidCidr, LintCodeInvalidValueType, LintCodeUnreachableRule, LintCodeDuplicateConstraint:
+		return true
+	}
+	return false
+}
+
+func (e LintCode) String() string {
+	return string(e)
+}
+
+func (e *LintCode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LintCode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LintCode", str)
+	}
+	return nil
+}
+
+func (e LintCode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type LintSeverity string
+
+const (
+	LintSeverityError   LintSeverity = "ERROR"
+	LintSeverityWarning LintSeverity = "WARNING"
+)
+
+var AllLintSeverity = []LintSeverity{
+	LintSeverityError,
+	LintSeverityWarning,
+}
+
+func (e LintSeverity) IsValid() bool {
+	switch e {
+	case LintSeverityError, LintSeverityWarning:
+		return true
+	}
+	return false
+}
+
+func (e LintSeverity) String() string {
+	return string(e)
+}
+
+func (e *LintSeverity) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LintSeverity(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LintSeverity", str)
+	}
+	return nil
+}
+
+func (e LintSeverity) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Operation string
 
 const (
@@ -121,6 +484,9 @@ const (
 	OperationIsInSegment      Operation = "IS_IN_SEGMENT"
 	OperationIsntInSegment    Operation = "ISNT_IN_SEGMENT"
 	OperationIsInNetwork      Operation = "IS_IN_NETWORK"
+	OperationAll              Operation = "ALL"
+	OperationAny              Operation = "ANY"
+	OperationNone             Operation = "NONE"
 )
 
 var AllOperation = []Operation{
@@ -143,11 +509,14 @@ var AllOperation = []Operation{
 	OperationIsInSegment,
 	OperationIsntInSegment,
 	OperationIsInNetwork,
+	OperationAll,
+	OperationAny,
+	OperationNone,
 }
 
 func (e Operation) IsValid() bool {
 	switch e {
-	case OperationOneOf, OperationNotOneOf, OperationGreater, OperationGreaterOrEqual, OperationLower, OperationLowerOrEqual, OperationExists, OperationDoesntExist, OperationContains, OperationDoesntContain, OperationStartsWith, OperationDoesntStartWith, OperationEndsWith, OperationDoesntEndWith, OperationMatchesRegex, OperationDoesntMatchRegex, OperationIsInSegment, OperationIsntInSegment, OperationIsInNetwork:
+	case OperationOneOf, OperationNotOneOf, OperationGreater, OperationGreaterOrEqual, OperationLower, OperationLowerOrEqual, OperationExists, OperationDoesntExist, OperationContains, OperationDoesntContain, OperationStartsWith, OperationDoesntStartWith, OperationEndsWith, OperationDoesntEndWith, OperationMatchesRegex, OperationDoesntMatchRegex, OperationIsInSegment, OperationIsntInSegment, OperationIsInNetwork, OperationAll, OperationAny, OperationNone:
 		return true
 	}
 	return false
@@ -173,3 +542,46 @@ func (e *Operation) UnmarshalGQL(v interface{}) error {
 func (e Operation) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type StaleReason string
+
+const (
+	StaleReasonNotEvaluated  StaleReason = "NOT_EVALUATED"
+	StaleReasonSingleVariant StaleReason = "SINGLE_VARIANT"
+	StaleReasonExpired       StaleReason = "EXPIRED"
+)
+
+var AllStaleReason = []StaleReason{
+	StaleReasonNotEvaluated,
+	StaleReasonSingleVariant,
+	StaleReasonExpired,
+}
+
+func (e StaleReason) IsValid() bool {
+	switch e {
+	case StaleReasonNotEvaluated, StaleReasonSingleVariant, StaleReasonExpired:
+		return true
+	}
+	return false
+}
+
+func (e StaleReason) String() string {
+	return string(e)
+}
+
+func (e *StaleReason) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StaleReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StaleReason", str)
+	}
+	return nil
+}
+
+func (e StaleReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -6,15 +6,20 @@ import (
 )
 
 const (
-	namespace         = "flaggio"
+	namespace = "flaggio"
+	// cacheVersion is increased when cached models change in a way that
+	// older entries would be read wrongly, so they are ignored instead.
+	// v2 replaced the enabled field of rules with a disabled field.
+	cacheVersion      = "v2"
 	flagNamespace     = "flag"
 	segmentNamespace  = "segment"
 	evaluateNamespace = "eval"
+	groupNamespace    = "exclusiongroup"
 )
 
 func cacheKey(model string, parts ...string) string {
 	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf("%s:%s", namespace, model))
+	sb.WriteString(fmt.Sprintf("%s:%s:%s", namespace, cacheVersion, model))
 	for _, part := range parts {
 		sb.WriteString(fmt.Sprintf(":%s", part))
 	}
@@ -29,6 +34,10 @@ func SegmentCacheKey(parts ...string) string {
 	return cacheKey(segmentNamespace, parts...)
 }
 
+func ExclusionGroupCacheKey(parts ...string) string {
+	return cacheKey(groupNamespace, parts...)
+}
+
 func EvalCacheKey(parts ...string) string {
 	return cacheKey(evaluateNamespace, parts...)
 }
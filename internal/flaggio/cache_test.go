@@ -16,12 +16,12 @@ func TestFlagCacheKey(t *testing.T) {
 		{
 			name:        "uses no parts",
 			parts:       []string{},
-			expectedKey: "flaggio:flag",
+			expectedKey: "flaggio:v2:flag",
 		},
 		{
 			name:        "uses all parts",
 			parts:       []string{"key", "my.flag.key"},
-			expectedKey: "flaggio:flag:key:my.flag.key",
+			expectedKey: "flaggio:v2:flag:key:my.flag.key",
 		},
 	}
 
@@ -43,12 +43,12 @@ func TestSegmentCacheKey(t *testing.T) {
 		{
 			name:        "uses no parts",
 			parts:       []string{},
-			expectedKey: "flaggio:segment",
+			expectedKey: "flaggio:v2:segment",
 		},
 		{
 			name:        "uses all parts",
 			parts:       []string{"key", "123"},
-			expectedKey: "flaggio:segment:key:123",
+			expectedKey: "flaggio:v2:segment:key:123",
 		},
 	}
 
@@ -70,12 +70,12 @@ func TestEvalCacheKey(t *testing.T) {
 		{
 			name:        "uses no parts",
 			parts:       []string{},
-			expectedKey: "flaggio:eval",
+			expectedKey: "flaggio:v2:eval",
 		},
 		{
 			name:        "uses all parts",
 			parts:       []string{"123", "456"},
-			expectedKey: "flaggio:eval:123:456",
+			expectedKey: "flaggio:v2:eval:123:456",
 		},
 	}
 
@@ -0,0 +1,256 @@
+package flaggio
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/uw-labs/flaggio/internal/errors"
+)
+
+var _ Identifier = (*ChangeRequest)(nil)
+
+// ChangeRequest is a change to a protected flag that needs to be approved
+// before being applied. Only the proposed input matching the entity type
+// is set.
+type ChangeRequest struct {
+	ID                string
+	EntityType        EntityType
+	EntityID          string
+	FlagVersion       int
+	Diff              []*FieldChange
+	Status            ChangeRequestStatus
+	Author            string
+	RequiredApprovals int
+	Approvals         []*Approval
+	RejectedBy        *string
+	CreatedAt         time.Time
+	UpdatedAt         *time.Time
+	FlagInput         *UpdateFlag
+	VariantInput      *UpdateVariant
+	FlagRuleInput     *UpdateFlagRule
+}
+
+// FieldChange is a field that is changed by a change request.
+type FieldChange struct {
+	Field    string
+	OldValue interface{}
+	NewValue interface{}
+}
+
+// Approval is given by a user to a change request.
+type Approval struct {
+	User      string
+	CreatedAt time.Time
+}
+
+// GetID returns the change request ID.
+func (cr *ChangeRequest) GetID() string {
+	return cr.ID
+}
+
+// CheckReviewer returns an error if the user can't approve or reject the
+// change request: it must be pending, and users can't review their own
+// requests or approve them twice.
+func (cr *ChangeRequest) CheckReviewer(user string) error {
+	if cr.Status != ChangeRequestStatusPending {
+		return errors.BadRequest("change request is not pending")
+	}
+	if cr.Author == user {
+		return errors.BadRequest("change request must be reviewed by another user")
+	}
+	for _, apprvl := range cr.Approvals {
+		if apprvl.User == user {
+			return errors.BadRequest("change request was already approved by this user")
+		}
+	}
+	return nil
+}
+
+// IsFinalApproval returns true if one more approval applies the change request.
+func (cr *ChangeRequest) IsFinalApproval() bool {
+	return len(cr.Approvals)+1 >= cr.RequiredApprovals
+}
+
+// DiffFlag returns the fields of the flag that are changed by the input.
+func DiffFlag(flg *Flag, input UpdateFlag) []*FieldChange {
+	var diff diffBuilder
+	if input.Key != nil {
+		diff.add("key", flg.Key, *input.Key)
+	}
+	if input.Name != nil {
+		diff.add("name", flg.Name, *input.Name)
+	}
+	if input.Description != nil {
+		diff.add("description", stringValue(flg.Description), *input.Description)
+	}
+	if input.Kind != nil {
+		diff.add("kind", string(flg.Kind), string(*input.Kind))
+	}
+	if input.Tags != nil {
+		diff.add("tags", stringsValue(flg.Tags), stringsValue(input.Tags))
+	}
+	if input.OwnerTeam != nil {
+		diff.add("ownerTeam", stringValue(flg.OwnerTeam), *input.OwnerTeam)
+	}
+	if input.Maintainer != nil {
+		diff.add("maintainer", stringValue(flg.Maintainer), *input.Maintainer)
+	}
+	if input.Links != nil {
+		diff.add("links", stringsValue(flg.Links), stringsValue(input.Links))
+	}
+	if input.ExpiresAt != nil {
+		diff.add("expiresAt", timeValue(flg.ExpiresAt), *input.ExpiresAt)
+	}
+	if input.ClientExposed != nil {
+		diff.add("clientExposed", flg.ClientExposed, *input.ClientExposed)
+	}
+	if input.Enabled != nil {
+		diff.add("enabled", flg.Enabled, *input.Enabled)
+	}
+	if input.DefaultVariantWhenOn != nil {
+		diff.add("defaultVariantWhenOn", variantID(flg.DefaultVariantWhenOn), *input.DefaultVariantWhenOn)
+	}
+	if input.DefaultVariantWhenOff != nil {
+		diff.add("defaultVariantWhenOff", variantID(flg.DefaultVariantWhenOff), *input.DefaultVariantWhenOff)
+	}
+	if input.Experiment != nil {
+		diff.add("experiment", flg.Experiment, *input.Experiment)
+	}
+	if input.Protected != nil {
+		diff.add("protected", flg.Protected, *input.Protected)
+	}
+	if input.RequiredApprovals != nil {
+		diff.add("requiredApprovals", flg.RequiredApprovals, *input.RequiredApprovals)
+	}
+	return diff.changes
+}
+
+// DiffVariant returns the fields of the variant that are changed by the input.
+func DiffVariant(vrnt *Variant, input UpdateVariant) []*FieldChange {
+	var diff diffBuilder
+	if input.Description != nil {
+		diff.add("description", stringValue(vrnt.Description), *input.Description)
+	}
+	if input.Value != nil {
+		diff.add("value", vrnt.Value, input.Value)
+	}
+	return diff.changes
+}
+
+// DiffFlagRule returns the fields of the flag rule that are changed by the input.
+func DiffFlagRule(rl *FlagRule, input UpdateFlagRule) []*FieldChange {
+	oldConstraints := constraintsDiffValue(rl.Constraints)
+	newConstraints := newConstraintsDiffValue(input.Constraints)
+	oldDistributions := make([]interface{}, len(rl.Distributions))
+	for idx, dstrbtn := range rl.Distributions {
+		oldDistributions[idx] = distributionDiffValue(variantID(dstrbtn.Variant), dstrbtn.Percentage)
+	}
+	newDistributions := make([]interface{}, len(input.Distributions))
+	for idx, dstrbtn := range input.Distributions {
+		newDistributions[idx] = distributionDiffValue(dstrbtn.VariantID, dstrbtn.Percentage)
+	}
+
+	var diff diffBuilder
+	if input.Name != nil {
+		diff.add("name", stringValue(rl.Name), *input.Name)
+	}
+	if input.Description != nil {
+		diff.add("description", stringValue(rl.Description), *input.Description)
+	}
+	if input.Enabled != nil {
+		diff.add("enabled", rl.Enabled(), *input.Enabled)
+	}
+	diff.add("constraints", oldConstraints, newConstraints)
+	diff.add("distributions", oldDistributions, newDistributions)
+	return diff.changes
+}
+
+type diffBuilder struct {
+	changes []*FieldChange
+}
+
+func (d *diffBuilder) add(field string, oldValue, newValue interface{}) {
+	if reflect.DeepEqual(oldValue, newValue) {
+		return
+	}
+	d.changes = append(d.changes, &FieldChange{Field: field, OldValue: oldValue, NewValue: newValue})
+}
+
+func constraintsDiffValue(cnstrnts []*Constraint) []interface{} {
+	values := make([]interface{}, len(cnstrnts))
+	for idx, cnstrnt := range cnstrnts {
+		if cnstrnt.Operation.IsGroup() {
+			values[idx] = constraintGroupDiffValue(cnstrnt.Operation, constraintsDiffValue(cnstrnt.Constraints))
+			continue
+		}
+		values[idx] = constraintDiffValue(cnstrnt.Property, cnstrnt.Operation, cnstrnt.Values)
+	}
+	return values
+}
+
+func newConstraintsDiffValue(cnstrnts []*NewConstraint) []interface{} {
+	values := make([]interface{}, len(cnstrnts))
+	for idx, cnstrnt := range cnstrnts {
+		if cnstrnt.Operation.IsGroup() {
+			values[idx] = constraintGroupDiffValue(cnstrnt.Operation, newConstraintsDiffValue(cnstrnt.Constraints))
+			continue
+		}
+		var property string
+		if cnstrnt.Property != nil {
+			property = *cnstrnt.Property
+		}
+		values[idx] = constraintDiffValue(property, cnstrnt.Operation, cnstrnt.Values)
+	}
+	return values
+}
+
+func constraintDiffValue(property string, operation Operation, values []interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"property":  property,
+		"operation": string(operation),
+		"values":    values,
+	}
+}
+
+func constraintGroupDiffValue(operation Operation, constraints []interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"operation":   string(operation),
+		"constraints": constraints,
+	}
+}
+
+func distributionDiffValue(variantID interface{}, percentage int) map[string]interface{} {
+	return map[string]interface{}{
+		"variantId":  variantID,
+		"percentage": percentage,
+	}
+}
+
+func stringValue(s *string) interface{} {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
+func timeValue(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return *t
+}
+
+func stringsValue(list []string) []interface{} {
+	values := make([]interface{}, len(list))
+	for idx, s := range list {
+		values[idx] = s
+	}
+	return values
+}
+
+func variantID(vrnt *Variant) interface{} {
+	if vrnt == nil {
+		return nil
+	}
+	return vrnt.ID
+}
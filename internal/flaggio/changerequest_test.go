@@ -0,0 +1,172 @@
+package flaggio_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+func TestChangeRequest_CheckReviewer(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		cr            flaggio.ChangeRequest
+		user          string
+		expectedError string
+	}{
+		{
+			name:          "fails when the change request isn't pending",
+			cr:            flaggio.ChangeRequest{Status: flaggio.ChangeRequestStatusApplied, Author: "john"},
+			user:          "jane",
+			expectedError: "bad request: change request is not pending",
+		},
+		{
+			name:          "fails when the user is the author",
+			cr:            flaggio.ChangeRequest{Status: flaggio.ChangeRequestStatusPending, Author: "john"},
+			user:          "john",
+			expectedError: "bad request: change request must be reviewed by another user",
+		},
+		{
+			name: "fails when the user already approved",
+			cr: flaggio.ChangeRequest{
+				Status:    flaggio.ChangeRequestStatusPending,
+				Author:    "john",
+				Approvals: []*flaggio.Approval{{User: "jane"}},
+			},
+			user:          "jane",
+			expectedError: "bad request: change request was already approved by this user",
+		},
+		{
+			name: "succeeds for other users",
+			cr: flaggio.ChangeRequest{
+				Status:    flaggio.ChangeRequestStatusPending,
+				Author:    "john",
+				Approvals: []*flaggio.Approval{{User: "jane"}},
+			},
+			user: "mary",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.cr.CheckReviewer(tt.user)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestChangeRequest_IsFinalApproval(t *testing.T) {
+	t.Parallel()
+	cr := flaggio.ChangeRequest{RequiredApprovals: 2}
+	assert.False(t, cr.IsFinalApproval())
+	cr.Approvals = []*flaggio.Approval{{User: "jane"}}
+	assert.True(t, cr.IsFinalApproval())
+}
+
+func TestDiffFlag(t *testing.T) {
+	t.Parallel()
+	vrnt1 := &flaggio.Variant{ID: "1"}
+	flg := &flaggio.Flag{
+		Key:                  "a",
+		Name:                 "A",
+		Enabled:              false,
+		DefaultVariantWhenOn: vrnt1,
+	}
+	key, name, desc := "a", "B", "desc"
+	enabled := true
+	whenOn, whenOff := "1", "2"
+	diff := flaggio.DiffFlag(flg, flaggio.UpdateFlag{
+		Key:                   &key,
+		Name:                  &name,
+		Description:           &desc,
+		Enabled:               &enabled,
+		DefaultVariantWhenOn:  &whenOn,
+		DefaultVariantWhenOff: &whenOff,
+		Tags:                  []string{"checkout"},
+	})
+	assert.Equal(t, []*flaggio.FieldChange{
+		{Field: "name", OldValue: "A", NewValue: "B"},
+		{Field: "description", OldValue: nil, NewValue: "desc"},
+		{Field: "tags", OldValue: []interface{}{}, NewValue: []interface{}{"checkout"}},
+		{Field: "enabled", OldValue: false, NewValue: true},
+		{Field: "defaultVariantWhenOff", OldValue: nil, NewValue: "2"},
+	}, diff)
+}
+
+func TestDiffVariant(t *testing.T) {
+	t.Parallel()
+	vrnt := &flaggio.Variant{ID: "1", Value: "abc"}
+	assert.Empty(t, flaggio.DiffVariant(vrnt, flaggio.UpdateVariant{Value: "abc"}))
+	assert.Equal(t, []*flaggio.FieldChange{
+		{Field: "value", OldValue: "abc", NewValue: "cde"},
+	}, flaggio.DiffVariant(vrnt, flaggio.UpdateVariant{Value: "cde"}))
+}
+
+func TestDiffFlagRule(t *testing.T) {
+	t.Parallel()
+	rl := &flaggio.FlagRule{
+		Rule: flaggio.Rule{Disabled: true, Constraints: []*flaggio.Constraint{
+			{Property: "name", Operation: flaggio.OperationOneOf, Values: []interface{}{"john"}},
+		}},
+		Distributions: []*flaggio.Distribution{{Variant: &flaggio.Variant{ID: "1"}, Percentage: 100}},
+	}
+	enabled, property := true, "name"
+	diff := flaggio.DiffFlagRule(rl, flaggio.UpdateFlagRule{
+		Enabled: &enabled,
+		Constraints: []*flaggio.NewConstraint{
+			{Property: &property, Operation: flaggio.OperationOneOf, Values: []interface{}{"john"}},
+		},
+		Distributions: []*flaggio.NewDistribution{{VariantID: "2", Percentage: 100}},
+	})
+	assert.Equal(t, []*flaggio.FieldChange{
+		{Field: "enabled", OldValue: false, NewValue: true},
+		{
+			Field:    "distributions",
+			OldValue: []interface{}{map[string]interface{}{"variantId": "1", "percentage": 100}},
+			NewValue: []interface{}{map[string]interface{}{"variantId": "2", "percentage": 100}},
+		},
+	}, diff)
+}
+
+func TestDiffFlagRule_ConstraintGroups(t *testing.T) {
+	t.Parallel()
+	rl := &flaggio.FlagRule{
+		Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{
+			{Operation: flaggio.OperationAny, Constraints: []*flaggio.Constraint{
+				{Property: "plan", Operation: flaggio.OperationOneOf, Values: []interface{}{"pro"}},
+			}},
+		}},
+	}
+	property := "beta"
+	diff := flaggio.DiffFlagRule(rl, flaggio.UpdateFlagRule{
+		Constraints: []*flaggio.NewConstraint{
+			{Operation: flaggio.OperationAny, Constraints: []*flaggio.NewConstraint{
+				{Property: &property, Operation: flaggio.OperationOneOf, Values: []interface{}{true}},
+			}},
+		},
+	})
+	assert.Equal(t, []*flaggio.FieldChange{
+		{
+			Field: "constraints",
+			OldValue: []interface{}{map[string]interface{}{
+				"operation": "ANY",
+				"constraints": []interface{}{
+					map[string]interface{}{"property": "plan", "operation": "ONE_OF", "values": []interface{}{"pro"}},
+				},
+			}},
+			NewValue: []interface{}{map[string]interface{}{
+				"operation": "ANY",
+				"constraints": []interface{}{
+					map[string]interface{}{"property": "beta", "operation": "ONE_OF", "values": []interface{}{true}},
+				},
+			}},
+		},
+	}, diff)
+}
@@ -0,0 +1,163 @@
+package flaggio
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/uw-labs/flaggio/internal/errors"
+)
+
+// Config is a set of flags, segments and exclusion groups that can be
+// evaluated without connecting to a database.
+type Config struct {
+	Flags           []*Flag
+	Segments        []*Segment
+	ExclusionGroups []*ExclusionGroup
+}
+
+// ReadConfig reads a config from JSON. Flags, segments and exclusion groups
+// have the same fields as on the admin API, so the results of the flags,
+// segments and exclusionGroups queries can be used as they are. Variants are
+// referenced by ID from the defaults and distributions of a flag, rules are
+// enabled unless they have "enabled": false, and numbers are read the same
+// way as they are on user contexts.
+func ReadConfig(r io.Reader) (*Config, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var cfg Config
+	if err := decodeConfig(b, &cfg); err != nil {
+		return nil, err
+	}
+	// rules are disabled by their enabled field on the admin API
+	var toggles ruleToggles
+	if err := decodeConfig(b, &toggles); err != nil {
+		return nil, err
+	}
+	for idx, flg := range cfg.Flags {
+		if err := resolveFlag(flg); err != nil {
+			return nil, err
+		}
+		for ridx, rl := range flg.Rules {
+			if toggles.Flags[idx].Rules[ridx].disabled() {
+				rl.Disabled = true
+			}
+		}
+	}
+	for idx, sgmnt := range cfg.Segments {
+		for ridx, rl := range sgmnt.Rules {
+			if toggles.Segments[idx].Rules[ridx].disabled() {
+				rl.Disabled = true
+			}
+			if err := resolveConstraints(rl.Constraints); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, grp := range cfg.ExclusionGroups {
+		if err := validateSlotAllocations(grp.Allocations); err != nil {
+			return nil, err
+		}
+	}
+	// segments can reference other segments
+	SegmentList(cfg.Segments).Populate()
+	return &cfg, nil
+}
+
+// Identifiers returns the segments and exclusion groups of the config as
+// identifiers, so they can be used to populate the flags.
+func (c *Config) Identifiers() []Identifier {
+	iders := make([]Identifier, 0, len(c.Segments)+len(c.ExclusionGroups))
+	for _, sgmnt := range c.Segments {
+		iders = append(iders, sgmnt)
+	}
+	for _, grp := range c.ExclusionGroups {
+		iders = append(iders, grp)
+	}
+	return iders
+}
+
+// ruleToggles holds the enabled field of the rules in a config.
+type ruleToggles struct {
+	Flags    []struct{ Rules []ruleToggle }
+	Segments []struct{ Rules []ruleToggle }
+}
+
+type ruleToggle struct {
+	Enabled *bool
+}
+
+func (t ruleToggle) disabled() bool {
+	return t.Enabled != nil && !*t.Enabled
+}
+
+func decodeConfig(b []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+func resolveFlag(flg *Flag) error {
+	vrnts := make(map[string]*Variant, len(flg.Variants))
+	for _, vrnt := range flg.Variants {
+		vrnt.Value = typedValue(vrnt.Value)
+		vrnts[vrnt.ID] = vrnt
+	}
+	// replace the references to variants with the variants of the flag
+	resolve := func(vrnt **Variant) error {
+		if *vrnt == nil {
+			return nil
+		}
+		found, ok := vrnts[(*vrnt).ID]
+		if !ok {
+			return errors.InvalidFlag(fmt.Sprintf("%s references an unknown variant %q", flg.Key, (*vrnt).ID))
+		}
+		*vrnt = found
+		return nil
+	}
+	if err := resolve(&flg.DefaultVariantWhenOn); err != nil {
+		return err
+	}
+	if err := resolve(&flg.DefaultVariantWhenOff); err != nil {
+		return err
+	}
+	for _, rl := range flg.Rules {
+		for _, dstrbtn := range rl.Distributions {
+			if err := resolve(&dstrbtn.Variant); err != nil {
+				return err
+			}
+		}
+		if err := resolveConstraints(rl.Constraints); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resolveConstraints(cnstrnts []*Constraint) error {
+	for _, cnstrnt := range cnstrnts {
+		for idx, value := range cnstrnt.Values {
+			cnstrnt.Values[idx] = typedValue(value)
+		}
+		if err := cnstrnt.Operation.ValidateValues(cnstrnt.Values); err != nil {
+			return err
+		}
+		if err := resolveConstraints(cnstrnt.Constraints); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSlotAllocations(allocs []*SlotAllocation) error {
+	input := make([]*NewSlotAllocation, len(allocs))
+	for idx, alloc := range allocs {
+		input[idx] = &NewSlotAllocation{FlagID: alloc.FlagID, Start: alloc.Start, End: alloc.End}
+	}
+	_, err := NewSlotAllocations(input)
+	return err
+}
@@ -0,0 +1,124 @@
+package flaggio_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+func TestReadConfig(t *testing.T) {
+	t.Parallel()
+	cfg, err := flaggio.ReadConfig(strings.NewReader(`
+	{
+		"flags": [{
+			"id": "f1",
+			"key": "seats",
+			"enabled": true,
+			"variants": [{"id": "v1", "value": 10}, {"id": "v2", "value": 2.5}],
+			"defaultVariantWhenOn": {"id": "v1"},
+			"defaultVariantWhenOff": {"id": "v2"},
+			"rules": [{
+				"id": "r1",
+				"enabled": true,
+				"constraints": [
+					{"operation": "IS_IN_SEGMENT", "values": ["s1"]},
+					{"property": "age", "operation": "ONE_OF", "values": [18, 21]}
+				],
+				"distributions": [{"id": "d1", "variant": {"id": "v2"}, "percentage": 100}]
+			}, {
+				"id": "r2",
+				"enabled": false,
+				"constraints": [{"property": "age", "operation": "ONE_OF", "values": [30]}],
+				"distributions": [{"id": "d2", "variant": {"id": "v2"}, "percentage": 100}]
+			}]
+		}],
+		"segments": [{
+			"id": "s1",
+			"rules": [{"constraints": [{"property": "plan", "operation": "ONE_OF", "values": ["pro"]}]}]
+		}],
+		"exclusionGroups": [{
+			"id": "g1",
+			"allocations": [{"flagId": "f1", "start": 0, "end": 1000}]
+		}]
+	}`))
+	require.NoError(t, err)
+	require.Len(t, cfg.Flags, 1)
+	flg := cfg.Flags[0]
+	assert.Equal(t, int64(10), flg.Variants[0].Value)
+	assert.Equal(t, 2.5, flg.Variants[1].Value)
+	assert.Same(t, flg.Variants[0], flg.DefaultVariantWhenOn)
+	assert.Same(t, flg.Variants[1], flg.DefaultVariantWhenOff)
+	assert.Same(t, flg.Variants[1], flg.Rules[0].Distributions[0].Variant)
+	assert.Equal(t, []interface{}{int64(18), int64(21)}, flg.Rules[0].Constraints[1].Values)
+	// rules are enabled unless they are disabled explicitly
+	assert.False(t, flg.Rules[0].Disabled)
+	assert.True(t, flg.Rules[1].Disabled)
+	assert.False(t, cfg.Segments[0].Rules[0].Disabled)
+
+	plan := flaggio.CompilePlan(flg, cfg.Identifiers())
+	res, err := plan.Evaluate(map[string]interface{}{"$userId": "john", "plan": "pro", "age": int64(18)})
+	require.NoError(t, err)
+	assert.Equal(t, 2.5, res.Answer)
+	res, err = plan.Evaluate(map[string]interface{}{"$userId": "john", "plan": "free", "age": int64(18)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), res.Answer)
+	res, err = plan.Evaluate(map[string]interface{}{"$userId": "john", "plan": "free", "age": int64(30)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), res.Answer)
+	res, err = plan.Evaluate(map[string]interface{}{"plan": "free", "age": int64(18)})
+	require.NoError(t, err)
+	assert.Equal(t, flaggio.ReasonExcluded, res.Reason)
+}
+
+func TestReadConfigErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		config      string
+		expectedErr string
+	}{
+		{
+			name:        "invalid json",
+			config:      `{"flags": [`,
+			expectedErr: "unexpected EOF",
+		},
+		{
+			name:        "unknown default variant",
+			config:      `{"flags": [{"key": "a", "variants": [{"id": "v1"}], "defaultVariantWhenOn": {"id": "v2"}}]}`,
+			expectedErr: `invalid flag: a references an unknown variant "v2"`,
+		},
+		{
+			name: "unknown distribution variant",
+			config: `{"flags": [{"key": "a", "rules": [
+				{"distributions": [{"variant": {"id": "v1"}, "percentage": 100}]}
+			]}]}`,
+			expectedErr: `invalid flag: a references an unknown variant "v1"`,
+		},
+		{
+			name: "invalid segment regex",
+			config: `{"segments": [{"rules": [
+				{"constraints": [{"property": "email", "operation": "MATCHES_REGEX", "values": ["[a-z"]}]}
+			]}]}`,
+			expectedErr: "invalid flag: MATCHES_REGEX has an invalid regex \"[a-z\": error parsing regexp: missing closing ]: `[a-z`",
+		},
+		{
+			name: "overlapping exclusion group slots",
+			config: `{"exclusionGroups": [{"allocations": [
+				{"flagId": "f1", "start": 0, "end": 500},
+				{"flagId": "f2", "start": 400, "end": 1000}
+			]}]}`,
+			expectedErr: "bad request: slots of flags f1 and f2 overlap",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := flaggio.ReadConfig(strings.NewReader(tt.config))
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
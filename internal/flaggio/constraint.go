@@ -15,17 +15,29 @@ var _ operator.Validator = (*Constraint)(nil)
 type Operator func(usrValue interface{}, validValues []interface{}) (bool, error)
 
 // Constraint holds all the information needed for an operation to be executed.
+// Constraints with a group operation hold a list of nested constraints instead.
 type Constraint struct {
-	ID        string
-	Property  string
-	Operation Operation
-	Values    []interface{}
+	ID          string
+	Property    string
+	Operation   Operation
+	Values      []interface{}
+	Constraints []*Constraint
 }
 
 // Validate will check if a property in the user context passes some operation based on
 // some configured valid values. Some operations don't need the property to be defined,
-// while some others don't required any valid values.
+// while some others don't required any valid values. Constraint groups validate their
+// nested constraints instead.
 func (c Constraint) Validate(usrContext map[string]interface{}) (bool, error) {
+	switch c.Operation {
+	case OperationAll:
+		return ConstraintList(c.Constraints).Validate(usrContext)
+	case OperationAny:
+		return ConstraintList(c.Constraints).validateAny(usrContext)
+	case OperationNone:
+		ok, err := ConstraintList(c.Constraints).validateAny(usrContext)
+		return !ok && err == nil, err
+	}
 	operate, ok := operatorMap[c.Operation]
 	if !ok {
 		// unknown operation, this is a configuration problem
@@ -35,7 +47,7 @@ func (c Constraint) Validate(usrContext map[string]interface{}) (bool, error) {
 	case OperationIsInSegment, OperationIsntInSegment:
 		return operate(usrContext, c.Values)
 	default:
-		return operate(usrContext[c.Property], c.Values)
+		return operate(lookup(usrContext, c.Property), c.Values)
 	}
 }
 
@@ -46,6 +58,8 @@ func (c *Constraint) Populate(identifiers []Identifier) {
 	switch c.Operation {
 	case OperationIsInSegment, OperationIsntInSegment:
 		c.populateSegments(identifiers)
+	case OperationAll, OperationAny, OperationNone:
+		ConstraintList(c.Constraints).Populate(identifiers)
 	}
 }
 
@@ -78,6 +92,21 @@ func (l ConstraintList) Validate(usrContext map[string]interface{}) (bool, error
 	return true, nil
 }
 
+// validateAny will check if any of the constraints on this list passes their
+// validation. An empty list never validates to true.
+func (l ConstraintList) validateAny(usrContext map[string]interface{}) (bool, error) {
+	for _, c := range l {
+		ok, err := c.Validate(usrContext)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // Populate will try to populate all references on this constraint list.
 func (l ConstraintList) Populate(identifiers []Identifier) {
 	for _, c := range l {
@@ -85,6 +114,98 @@ func (l ConstraintList) Populate(identifiers []Identifier) {
 	}
 }
 
+// SegmentIDs returns the IDs of the segments referenced by the constraints
+// on this list, including nested ones.
+func (l ConstraintList) SegmentIDs() []string {
+	var ids []string
+	for _, c := range l {
+		switch c.Operation {
+		case OperationIsInSegment, OperationIsntInSegment:
+			for _, v := range c.Values {
+				if ref := segmentRefID(v); ref != "" {
+					ids = append(ids, ref)
+				}
+			}
+		case OperationAll, OperationAny, OperationNone:
+			ids = append(ids, ConstraintList(c.Constraints).SegmentIDs()...)
+		}
+	}
+	return ids
+}
+
+// WithoutSegment returns a copy of the constraints on this list without the
+// references to a segment, including nested ones. Constraints that are left
+// without segments, and groups that are left without nested constraints, are
+// removed.
+func (l ConstraintList) WithoutSegment(segmentID string) ConstraintList {
+	var cnstrnts ConstraintList
+	for _, c := range l {
+		cnstrnt := *c
+		switch c.Operation {
+		case OperationIsInSegment, OperationIsntInSegment:
+			cnstrnt.Values = nil
+			for _, v := range c.Values {
+				if segmentRefID(v) != segmentID {
+					cnstrnt.Values = append(cnstrnt.Values, v)
+				}
+			}
+			if len(cnstrnt.Values) == 0 {
+				continue
+			}
+		case OperationAll, OperationAny, OperationNone:
+			cnstrnt.Constraints = ConstraintList(c.Constraints).WithoutSegment(segmentID)
+			if len(cnstrnt.Constraints) == 0 {
+				continue
+			}
+		}
+		cnstrnts = append(cnstrnts, &cnstrnt)
+	}
+	return cnstrnts
+}
+
+// segmentRefID returns the ID of the segment referenced by a constraint
+// value, which is either the ID or the populated segment.
+func segmentRefID(value interface{}) string {
+	switch ref := value.(type) {
+	case string:
+		return ref
+	case Identifier:
+		return ref.GetID()
+	}
+	return ""
+}
+
+// IsGroup returns true if the operation is a group of nested constraints.
+func (o Operation) IsGroup() bool {
+	switch o {
+	case OperationAll, OperationAny, OperationNone:
+		return true
+	}
+	return false
+}
+
+// ValidateValues checks that the values can be used by the operation. Regexes
+// and networks must be valid, otherwise the flag would fail to evaluate.
+func (o Operation) ValidateValues(values []interface{}) error {
+	for _, v := range values {
+		str, ok := v.(string)
+		if !ok {
+			continue
+		}
+		switch o {
+		case OperationMatchesRegex, OperationDoesntMatchRegex:
+			if _, err := operator.CompileRegex(str); err != nil {
+				return errors.InvalidFlag(fmt.Sprintf("%s has an invalid regex %q: %s", o, str, err))
+			}
+		case OperationIsInNetwork:
+			if _, err := operator.ParseCIDR(str); err != nil {
+				return errors.InvalidFlag(fmt.Sprintf("%s has an invalid CIDR %q", o, str))
+			}
+		}
+	}
+	return nil
+}
+
 // Maps the GraphQL enum to the operator func.
 var operatorMap = map[Operation]Operator{
 	OperationOneOf:            operator.OneOf,
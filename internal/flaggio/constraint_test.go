@@ -45,6 +45,33 @@ func TestConstraint_Validate(t *testing.T) {
 			expectedUsrValue: 1,
 			expectedResult:   false,
 		},
+		{
+			name:             "passes nested values addressed by dotted path",
+			cnstrnt:          Constraint{Property: "company.plan", Operation: OperationOneOf, Values: []interface{}{"pro"}},
+			usrContext:       map[string]interface{}{"company": map[string]interface{}{"plan": "pro"}},
+			operatorCalls:    1,
+			operatorResult:   true,
+			expectedUsrValue: "pro",
+			expectedResult:   true,
+		},
+		{
+			name:             "prefers properties with dots over nested values",
+			cnstrnt:          Constraint{Property: "company.plan", Operation: OperationOneOf, Values: []interface{}{"pro"}},
+			usrContext:       map[string]interface{}{"company.plan": "free", "company": map[string]interface{}{"plan": "pro"}},
+			operatorCalls:    1,
+			operatorResult:   false,
+			expectedUsrValue: "free",
+			expectedResult:   false,
+		},
+		{
+			name:             "passes nil when the nested value doesn't exist",
+			cnstrnt:          Constraint{Property: "company.plan", Operation: OperationOneOf, Values: []interface{}{"pro"}},
+			usrContext:       map[string]interface{}{"company": "acme"},
+			operatorCalls:    1,
+			operatorResult:   false,
+			expectedUsrValue: nil,
+			expectedResult:   false,
+		},
 		{
 			name:             "passes the user context as argument for IsInSegment operations",
 			cnstrnt:          Constraint{Property: "key", Operation: OperationIsInSegment, Values: []interface{}{1}},
@@ -114,6 +141,64 @@ func TestConstraintList_Validate(t *testing.T) {
 			operatorResult: true,
 			expectedResult: true,
 		},
+		{
+			name: "returns true when a nested ANY group has a constraint that returns true",
+			cnstrnts: ConstraintList{
+				&Constraint{Property: "country", Operation: OperationOneOf, Values: []interface{}{"UK", "IE"}},
+				&Constraint{Operation: OperationAny, Constraints: []*Constraint{
+					{Property: "plan", Operation: OperationOneOf, Values: []interface{}{"pro"}},
+					{Property: "beta", Operation: OperationOneOf, Values: []interface{}{true}},
+				}},
+			},
+			usrContext:     map[string]interface{}{"country": "IE", "plan": "free", "beta": true},
+			expectedResult: true,
+		},
+		{
+			name: "returns false when a nested ANY group has no constraints that return true",
+			cnstrnts: ConstraintList{
+				&Constraint{Property: "country", Operation: OperationOneOf, Values: []interface{}{"UK", "IE"}},
+				&Constraint{Operation: OperationAny, Constraints: []*Constraint{
+					{Property: "plan", Operation: OperationOneOf, Values: []interface{}{"pro"}},
+					{Property: "beta", Operation: OperationOneOf, Values: []interface{}{true}},
+				}},
+			},
+			usrContext:     map[string]interface{}{"country": "UK", "plan": "free"},
+			expectedResult: false,
+		},
+		{
+			name: "returns false when a nested ALL group has a constraint that returns false",
+			cnstrnts: ConstraintList{
+				&Constraint{Operation: OperationAll, Constraints: []*Constraint{
+					{Property: "plan", Operation: OperationOneOf, Values: []interface{}{"pro"}},
+					{Property: "beta", Operation: OperationOneOf, Values: []interface{}{true}},
+				}},
+			},
+			usrContext:     map[string]interface{}{"plan": "pro", "beta": false},
+			expectedResult: false,
+		},
+		{
+			name: "returns true when a nested NONE group has no constraints that return true",
+			cnstrnts: ConstraintList{
+				&Constraint{Operation: OperationNone, Constraints: []*Constraint{
+					{Property: "plan", Operation: OperationOneOf, Values: []interface{}{"pro"}},
+					{Operation: OperationAll, Constraints: []*Constraint{
+						{Property: "beta", Operation: OperationOneOf, Values: []interface{}{true}},
+					}},
+				}},
+			},
+			usrContext:     map[string]interface{}{"plan": "free", "beta": false},
+			expectedResult: true,
+		},
+		{
+			name: "returns false when a nested NONE group has a constraint that returns true",
+			cnstrnts: ConstraintList{
+				&Constraint{Operation: OperationNone, Constraints: []*Constraint{
+					{Property: "plan", Operation: OperationOneOf, Values: []interface{}{"pro"}},
+				}},
+			},
+			usrContext:     map[string]interface{}{"plan": "pro"},
+			expectedResult: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -125,3 +210,106 @@ func TestConstraintList_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestConstraintList_WithoutSegment(t *testing.T) {
+	tests := []struct {
+		name             string
+		cnstrnts         ConstraintList
+		expectedCnstrnts ConstraintList
+	}{
+		{
+			name: "removes the constraints that only reference the segment",
+			cnstrnts: ConstraintList{
+				&Constraint{ID: "1", Operation: OperationIsInSegment, Values: []interface{}{"s1"}},
+				&Constraint{ID: "2", Property: "plan", Operation: OperationOneOf, Values: []interface{}{"s1"}},
+			},
+			expectedCnstrnts: ConstraintList{
+				&Constraint{ID: "2", Property: "plan", Operation: OperationOneOf, Values: []interface{}{"s1"}},
+			},
+		},
+		{
+			name: "keeps the other segments of a constraint",
+			cnstrnts: ConstraintList{
+				&Constraint{ID: "1", Operation: OperationIsntInSegment, Values: []interface{}{"s1", &Segment{ID: "s2"}}},
+			},
+			expectedCnstrnts: ConstraintList{
+				&Constraint{ID: "1", Operation: OperationIsntInSegment, Values: []interface{}{&Segment{ID: "s2"}}},
+			},
+		},
+		{
+			name: "removes the references from nested groups",
+			cnstrnts: ConstraintList{
+				&Constraint{ID: "1", Operation: OperationAny, Constraints: []*Constraint{
+					{ID: "2", Operation: OperationIsInSegment, Values: []interface{}{"s1"}},
+					{ID: "3", Property: "plan", Operation: OperationOneOf, Values: []interface{}{"pro"}},
+				}},
+				&Constraint{ID: "4", Operation: OperationNone, Constraints: []*Constraint{
+					{ID: "5", Operation: OperationIsInSegment, Values: []interface{}{"s1"}},
+				}},
+			},
+			expectedCnstrnts: ConstraintList{
+				&Constraint{ID: "1", Operation: OperationAny, Constraints: []*Constraint{
+					{ID: "3", Property: "plan", Operation: OperationOneOf, Values: []interface{}{"pro"}},
+				}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedCnstrnts, tt.cnstrnts.WithoutSegment("s1"))
+			// the original constraints are left untouched
+			assert.Contains(t, tt.cnstrnts.SegmentIDs(), "s1")
+		})
+	}
+}
+
+func TestOperation_ValidateValues(t *testing.T) {
+	tests := []struct {
+		name        string
+		operation   Operation
+		values      []interface{}
+		expectedErr string
+	}{
+		{
+			name:      "valid regexes",
+			operation: OperationMatchesRegex,
+			values:    []interface{}{"^[a-z]+$", ".+@flaggio.com"},
+		},
+		{
+			name:        "invalid regex",
+			operation:   OperationDoesntMatchRegex,
+			values:      []interface{}{"^[a-z]+$", "[a-z"},
+			expectedErr: "invalid flag: DOESNT_MATCH_REGEX has an invalid regex \"[a-z\": error parsing regexp: missing closing ]: `[a-z`",
+		},
+		{
+			name:      "valid network",
+			operation: OperationIsInNetwork,
+			values:    []interface{}{"10.0.0.0/8"},
+		},
+		{
+			name:        "invalid network",
+			operation:   OperationIsInNetwork,
+			values:      []interface{}{"10.0.0.0"},
+			expectedErr: "invalid flag: IS_IN_NETWORK has an invalid CIDR \"10.0.0.0\"",
+		},
+		{
+			name:      "other operations",
+			operation: OperationOneOf,
+			values:    []interface{}{"[a-z", "10.0.0.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.operation.ValidateValues(tt.values)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
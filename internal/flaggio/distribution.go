@@ -28,11 +28,29 @@ func (dl DistributionList) Evaluate(usrContext map[string]interface{}) (EvalResu
 		// configuration problem, return error
 		return EvalResult{}, errors.ErrNoVariantToDistribute
 	}
+	reason := ReasonTargetingMatch
+	if dl.isSplit() {
+		reason = ReasonSplit
+	}
 	return EvalResult{
-		Answer: ref.Value,
+		Answer:  ref.Value,
+		Variant: ref,
+		Reason:  reason,
 	}, nil
 }
 
+// isSplit returns true when more than one distribution has a chance
+// of being selected.
+func (dl DistributionList) isSplit() bool {
+	var candidates int
+	for _, dstrbtn := range dl {
+		if dstrbtn.Percentage > 0 {
+			candidates++
+		}
+	}
+	return candidates > 1
+}
+
 // Distribute selects a distribution randomly, respecting the configured probability.
 func (dl DistributionList) Distribute() *Variant {
 	r1 := rand.New(rand.NewSource(time.Now().UnixNano())) // nolint:gosec // not security critical
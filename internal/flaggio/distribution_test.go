@@ -4,9 +4,49 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/errors"
 	"github.com/uw-labs/flaggio/internal/flaggio"
 )
 
+func TestDistributionList_Evaluate(t *testing.T) {
+	t.Parallel()
+	vrnt1 := &flaggio.Variant{ID: "1", Value: 1}
+	vrnt2 := &flaggio.Variant{ID: "2", Value: 2}
+
+	tests := []struct {
+		name           string
+		distributions  flaggio.DistributionList
+		expectedResult flaggio.EvalResult
+		expectedError  error
+	}{
+		{
+			name:           "returns targeting match when a single variant is distributed",
+			distributions:  flaggio.DistributionList{{Variant: vrnt1, Percentage: 100}, {Variant: vrnt2, Percentage: 0}},
+			expectedResult: flaggio.EvalResult{Answer: 1, Variant: vrnt1, Reason: flaggio.ReasonTargetingMatch},
+		},
+		{
+			name:           "returns split when more than one variant is distributed",
+			distributions:  flaggio.DistributionList{{Variant: vrnt2, Percentage: 50}, {Variant: vrnt2, Percentage: 50}},
+			expectedResult: flaggio.EvalResult{Answer: 2, Variant: vrnt2, Reason: flaggio.ReasonSplit},
+		},
+		{
+			name:          "returns error when there is no variant to distribute",
+			distributions: flaggio.DistributionList{{Percentage: 100}},
+			expectedError: errors.ErrNoVariantToDistribute,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			eval, err := tt.distributions.Evaluate(nil)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedResult, eval)
+		})
+	}
+}
+
 func TestDistributionList_Distribute(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping testing in short mode")
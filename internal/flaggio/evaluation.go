@@ -17,19 +17,46 @@ type Evaluation struct {
 	FlagVersion int           `json:"-"`
 	RequestHash string        `json:"-"`
 	CreatedAt   time.Time     `json:"-"`
+	VariantID   string        `json:"-"`
+	Reason      Reason        `json:"-"`
+	RuleName    string        `json:"-"`
 	FlagKey     string        `json:"flagKey"`
 	Value       interface{}   `json:"value,omitempty"`
 	Error       string        `json:"error,omitempty"`
 	StackTrace  []*StackTrace `json:"stackTrace,omitempty"`
 }
 
+// Reason describes why an evaluation resulted in a given value.
+type Reason string
+
+// List of evaluation reasons
+const (
+	// ReasonDisabled means the flag is off and the default variant
+	// for the off state was returned.
+	ReasonDisabled Reason = "DISABLED"
+	// ReasonDefault means the flag is on but no rules matched, so the
+	// default variant for the on state was returned.
+	ReasonDefault Reason = "DEFAULT"
+	// ReasonTargetingMatch means a rule matched and its only variant
+	// was returned.
+	ReasonTargetingMatch Reason = "TARGETING_MATCH"
+	// ReasonSplit means a rule matched and the variant was selected
+	// from a percentage distribution.
+	ReasonSplit Reason = "SPLIT"
+	// ReasonExcluded means the flag is on, but the user is outside the
+	// slice of its exclusion group, so the default variant for the off
+	// state was returned.
+	ReasonExcluded Reason = "EXCLUDED"
+)
+
 // StackTrace contains detailed information about the evaluation process.
 // Type is the type of the model object that evaluated the user context
-// ID holds the ID of the same object, if any. Answer is the evaluation
-// answer, if any.
+// ID and Name hold the ID and name of the same object, if any. Answer is
+// the evaluation answer, if any.
 type StackTrace struct {
 	Type   string      `json:"type"`
 	ID     *string     `json:"id"`
+	Name   *string     `json:"name,omitempty"`
 	Answer interface{} `json:"answer"`
 }
 
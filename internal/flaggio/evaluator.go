@@ -19,10 +19,17 @@ type Identifier interface {
 	GetID() string
 }
 
+// Namer represents an object that can return a name.
+type Namer interface {
+	GetName() string
+}
+
 // EvalResult is the result generated by an Evaluator. It possibly contains
 // an answer and/or a list of the next Evaluators that should be called.
 type EvalResult struct {
 	Answer    interface{}
+	Variant   *Variant
+	Reason    Reason
 	Next      []Evaluator
 	evaluator Evaluator
 	previous  *EvalResult
@@ -38,11 +45,17 @@ func (r EvalResult) Stack() (stack []*StackTrace) {
 			v := ider.GetID()
 			id = &v
 		}
+		var name *string
+		if nmr, ok := prev.evaluator.(Namer); ok && nmr.GetName() != "" {
+			v := nmr.GetName()
+			name = &v
+		}
 		stack = append(stack, &StackTrace{
 			Type: strings.Replace(
 				fmt.Sprintf("%T", prev.evaluator), "flaggio.", "", 1,
 			),
 			ID:     id,
+			Name:   name,
 			Answer: prev.Answer,
 		})
 		prev = prev.previous
@@ -50,6 +63,24 @@ func (r EvalResult) Stack() (stack []*StackTrace) {
 	return
 }
 
+// Rule returns the flag rule that matched the user context, if the answer
+// came from a rule.
+func (r EvalResult) Rule() *FlagRule {
+	if r.Reason != ReasonTargetingMatch && r.Reason != ReasonSplit {
+		return nil
+	}
+	// the rule is the closest one up the evaluation chain
+	for prev := r.previous; prev != nil; prev = prev.previous {
+		switch rl := prev.evaluator.(type) {
+		case *FlagRule:
+			return rl
+		case FlagRule:
+			return &rl
+		}
+	}
+	return nil
+}
+
 // Evaluate is the starting point for a chain of evaluations.
 func Evaluate(usrContext map[string]interface{}, root Evaluator) (EvalResult, error) {
 	return evaluate(usrContext, []Evaluator{root})
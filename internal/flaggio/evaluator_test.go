@@ -189,3 +189,66 @@ func TestEvaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestEvalResult_Rule(t *testing.T) {
+	t.Parallel()
+	vrnt1 := &flaggio.Variant{ID: "1", Value: 1}
+	vrnt2 := &flaggio.Variant{ID: "2", Value: 2}
+	disabledName, matchingName := "staged", "adults"
+	flg := &flaggio.Flag{
+		ID:                   "abc",
+		Enabled:              true,
+		DefaultVariantWhenOn: vrnt1,
+		Rules: []*flaggio.FlagRule{
+			{
+				Rule:          flaggio.Rule{ID: "1", Name: &disabledName, Disabled: true},
+				Distributions: []*flaggio.Distribution{{ID: "1", Variant: vrnt1, Percentage: 100}},
+			},
+			{
+				Rule: flaggio.Rule{ID: "2", Name: &matchingName, Constraints: []*flaggio.Constraint{{
+					Property:  "age",
+					Operation: flaggio.OperationGreaterOrEqual,
+					Values:    []interface{}{18},
+				}}},
+				Distributions: []*flaggio.Distribution{{ID: "2", Variant: vrnt2, Percentage: 100}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		usrContext    map[string]interface{}
+		expectedRule  *string
+		expectedValue interface{}
+	}{
+		{
+			name:          "returns the enabled rule that matched",
+			usrContext:    map[string]interface{}{"age": 20},
+			expectedRule:  &matchingName,
+			expectedValue: 2,
+		},
+		{
+			name:          "returns no rule when the answer is the default variant",
+			usrContext:    map[string]interface{}{"age": 16},
+			expectedValue: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := flaggio.Evaluate(tt.usrContext, flg)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedValue, res.Answer)
+			if tt.expectedRule == nil {
+				assert.Nil(t, res.Rule())
+				return
+			}
+			assert.Equal(t, *tt.expectedRule, res.Rule().GetName())
+			stack := res.Stack()
+			assert.Equal(t, "*FlagRule", stack[1].Type)
+			assert.Equal(t, tt.expectedRule, stack[1].Name)
+		})
+	}
+}
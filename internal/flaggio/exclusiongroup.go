@@ -0,0 +1,129 @@
+package flaggio
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uw-labs/flaggio/internal/errors"
+)
+
+var _ Identifier = (*ExclusionGroup)(nil)
+
+// ExclusionGroupSlots is how many slots the traffic of an exclusion group is
+// split into, so each slot is 0.1% of the users.
+const ExclusionGroupSlots = 1000
+
+// ExclusionGroup splits the users into slots, and gives each of its flags a
+// slice of them. Slices don't overlap, so a user is only ever in one of the
+// flags of the group, and flags are evaluated as disabled for the users
+// outside their slice.
+type ExclusionGroup struct {
+	ID          string
+	Name        string
+	Description *string
+	Allocations []*SlotAllocation
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
+
+// SlotAllocation is the slice of the slots of an exclusion group given to a
+// flag, from the start slot up to, but not including, the end slot.
+type SlotAllocation struct {
+	FlagID string
+	Start  int
+	End    int
+}
+
+// GetID returns the exclusion group ID.
+func (g *ExclusionGroup) GetID() string {
+	return g.ID
+}
+
+// Allocation returns the slice of the group given to the flag, or nil if
+// the flag is not part of the group.
+func (g *ExclusionGroup) Allocation(flagID string) *SlotAllocation {
+	for _, alloc := range g.Allocations {
+		if alloc.FlagID == flagID {
+			return alloc
+		}
+	}
+	return nil
+}
+
+// Slot returns the slot of the user in the group. The slot is a hash of the
+// user and group IDs, so a user always gets the same slot in a group, and
+// unrelated slots in different groups.
+func (g *ExclusionGroup) Slot(userID string) int {
+	sum := sha256.Sum256([]byte(g.ID + ":" + userID))
+	return int(binary.BigEndian.Uint64(sum[:8]) % ExclusionGroupSlots)
+}
+
+// Includes returns whether the user is in the slice of the group given to
+// the flag. Users are identified by the $userId property of the user
+// context, so users without an ID are never included.
+func (g *ExclusionGroup) Includes(flagID string, usrContext map[string]interface{}) bool {
+	alloc := g.Allocation(flagID)
+	if alloc == nil {
+		return false
+	}
+	userID, ok := usrContext["$userId"].(string)
+	if !ok || userID == "" {
+		return false
+	}
+	slot := g.Slot(userID)
+	return slot >= alloc.Start && slot < alloc.End
+}
+
+// NewSlotAllocations converts the input into slot allocations, checking that
+// the slices are within the slots of a group and don't overlap, and that each
+// flag has at most one slice.
+func NewSlotAllocations(input []*NewSlotAllocation) ([]*SlotAllocation, error) {
+	allocs := make([]*SlotAllocation, len(input))
+	flagIDs := make(map[string]bool, len(input))
+	for idx, alloc := range input {
+		if alloc.Start < 0 || alloc.End > ExclusionGroupSlots || alloc.Start >= alloc.End {
+			return nil, errors.BadRequest(fmt.Sprintf(
+				"invalid slots for flag %s: %d to %d, must be within 0 and %d",
+				alloc.FlagID, alloc.Start, alloc.End, ExclusionGroupSlots))
+		}
+		if flagIDs[alloc.FlagID] {
+			return nil, errors.BadRequest(fmt.Sprintf("flag %s has more than one slice of slots", alloc.FlagID))
+		}
+		flagIDs[alloc.FlagID] = true
+		allocs[idx] = &SlotAllocation{FlagID: alloc.FlagID, Start: alloc.Start, End: alloc.End}
+	}
+
+	sorted := make([]*SlotAllocation, len(allocs))
+	copy(sorted, allocs)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+	for idx := 1; idx < len(sorted); idx++ {
+		if prev, alloc := sorted[idx-1], sorted[idx]; alloc.Start < prev.End {
+			return nil, errors.BadRequest(fmt.Sprintf("slots of flags %s and %s overlap", prev.FlagID, alloc.FlagID))
+		}
+	}
+	return allocs, nil
+}
+
+// ChangedSlotAllocations returns the IDs of the flags that were added to,
+// removed from, or given a different slice of a group, sorted.
+func ChangedSlotAllocations(before, after []*SlotAllocation) []string {
+	previous := make(map[string]SlotAllocation, len(before))
+	for _, alloc := range before {
+		previous[alloc.FlagID] = *alloc
+	}
+	var changed []string
+	for _, alloc := range after {
+		if prev, ok := previous[alloc.FlagID]; !ok || prev != *alloc {
+			changed = append(changed, alloc.FlagID)
+		}
+		delete(previous, alloc.FlagID)
+	}
+	for flagID := range previous {
+		changed = append(changed, flagID)
+	}
+	sort.Strings(changed)
+	return changed
+}
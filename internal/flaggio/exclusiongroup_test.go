@@ -0,0 +1,173 @@
+package flaggio_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+func TestExclusionGroup_GetID(t *testing.T) {
+	t.Parallel()
+	grp := flaggio.ExclusionGroup{ID: "123456"}
+	assert.Equal(t, "123456", grp.GetID())
+}
+
+func TestExclusionGroup_Slot(t *testing.T) {
+	t.Parallel()
+	grp1, grp2 := &flaggio.ExclusionGroup{ID: "1"}, &flaggio.ExclusionGroup{ID: "2"}
+	counts := make([]int, flaggio.ExclusionGroupSlots/100)
+	differentSlots := 0
+	for i := 0; i < 10000; i++ {
+		userID := fmt.Sprintf("user-%d", i)
+		slot := grp1.Slot(userID)
+		assert.True(t, slot >= 0 && slot < flaggio.ExclusionGroupSlots, "slot out of range: %d", slot)
+		assert.Equal(t, slot, grp1.Slot(userID), "slot is not deterministic")
+		counts[slot/100]++
+		if slot != grp2.Slot(userID) {
+			differentSlots++
+		}
+	}
+	// users are spread evenly across the slots
+	for idx, count := range counts {
+		assert.InDelta(t, 1000, count, 150, "uneven slots %d to %d", idx*100, (idx+1)*100)
+	}
+	// and are given unrelated slots in different groups
+	assert.Greater(t, differentSlots, 9900)
+}
+
+func TestExclusionGroup_Includes(t *testing.T) {
+	t.Parallel()
+	grp := &flaggio.ExclusionGroup{ID: "1"}
+	slot := grp.Slot("john")
+	grp.Allocations = []*flaggio.SlotAllocation{
+		{FlagID: "f1", Start: slot, End: slot + 1},
+		{FlagID: "f2", Start: slot + 1, End: flaggio.ExclusionGroupSlots},
+	}
+
+	tests := []struct {
+		name           string
+		flagID         string
+		usrContext     map[string]interface{}
+		expectedResult bool
+	}{
+		{
+			name:           "includes users in the slice of the flag",
+			flagID:         "f1",
+			usrContext:     map[string]interface{}{"$userId": "john"},
+			expectedResult: true,
+		},
+		{
+			name:           "excludes users outside the slice of the flag",
+			flagID:         "f2",
+			usrContext:     map[string]interface{}{"$userId": "john"},
+			expectedResult: false,
+		},
+		{
+			name:           "excludes users from flags outside the group",
+			flagID:         "f3",
+			usrContext:     map[string]interface{}{"$userId": "john"},
+			expectedResult: false,
+		},
+		{
+			name:           "excludes users without an ID",
+			flagID:         "f1",
+			usrContext:     map[string]interface{}{"name": "john"},
+			expectedResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expectedResult, grp.Includes(tt.flagID, tt.usrContext))
+		})
+	}
+}
+
+func TestNewSlotAllocations(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		input          []*flaggio.NewSlotAllocation
+		expectedResult []*flaggio.SlotAllocation
+		expectedError  string
+	}{
+		{
+			name: "converts adjacent slices",
+			input: []*flaggio.NewSlotAllocation{
+				{FlagID: "f2", Start: 500, End: 1000},
+				{FlagID: "f1", Start: 0, End: 500},
+			},
+			expectedResult: []*flaggio.SlotAllocation{
+				{FlagID: "f2", Start: 500, End: 1000},
+				{FlagID: "f1", Start: 0, End: 500},
+			},
+		},
+		{
+			name:           "converts no slices",
+			input:          nil,
+			expectedResult: []*flaggio.SlotAllocation{},
+		},
+		{
+			name:          "fails when the slice is empty",
+			input:         []*flaggio.NewSlotAllocation{{FlagID: "f1", Start: 500, End: 500}},
+			expectedError: "bad request: invalid slots for flag f1: 500 to 500, must be within 0 and 1000",
+		},
+		{
+			name:          "fails when the slice is outside the slots",
+			input:         []*flaggio.NewSlotAllocation{{FlagID: "f1", Start: 900, End: 1001}},
+			expectedError: "bad request: invalid slots for flag f1: 900 to 1001, must be within 0 and 1000",
+		},
+		{
+			name: "fails when a flag has two slices",
+			input: []*flaggio.NewSlotAllocation{
+				{FlagID: "f1", Start: 0, End: 100},
+				{FlagID: "f1", Start: 200, End: 300},
+			},
+			expectedError: "bad request: flag f1 has more than one slice of slots",
+		},
+		{
+			name: "fails when slices overlap",
+			input: []*flaggio.NewSlotAllocation{
+				{FlagID: "f2", Start: 499, End: 1000},
+				{FlagID: "f1", Start: 0, End: 500},
+			},
+			expectedError: "bad request: slots of flags f1 and f2 overlap",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := flaggio.NewSlotAllocations(tt.input)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
+
+func TestChangedSlotAllocations(t *testing.T) {
+	t.Parallel()
+	before := []*flaggio.SlotAllocation{
+		{FlagID: "f1", Start: 0, End: 100},
+		{FlagID: "f2", Start: 100, End: 200},
+		{FlagID: "f3", Start: 200, End: 300},
+	}
+	after := []*flaggio.SlotAllocation{
+		{FlagID: "f4", Start: 300, End: 400},
+		{FlagID: "f2", Start: 100, End: 250},
+		{FlagID: "f1", Start: 0, End: 100},
+	}
+	assert.Equal(t, []string{"f2", "f3", "f4"}, flaggio.ChangedSlotAllocations(before, after))
+	assert.Equal(t, []string{"f1", "f2", "f3"}, flaggio.ChangedSlotAllocations(before, nil))
+	assert.Empty(t, flaggio.ChangedSlotAllocations(before, before))
+}
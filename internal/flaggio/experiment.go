@@ -0,0 +1,115 @@
+package flaggio
+
+import (
+	"math"
+	"time"
+)
+
+// confidenceZ is the z-score for a 95% confidence level.
+const confidenceZ = 1.959964
+
+// Metric is a goal tracked by an experiment. Conversions are tracked
+// by the metric key.
+type Metric struct {
+	ID          string
+	Key         string
+	Name        string
+	Description *string
+}
+
+// Conversion is tracked when a user completes the goal of a metric.
+type Conversion struct {
+	UserID    string
+	MetricKey string
+	CreatedAt time.Time
+}
+
+// VariantCount holds how many users were exposed to a variant and, out
+// of those, how many converted on a metric.
+type VariantCount struct {
+	VariantID   string
+	MetricKey   string
+	Exposures   int
+	Conversions int
+}
+
+// ExperimentResults holds the results of an experiment, per metric.
+type ExperimentResults struct {
+	Metrics []*MetricResults
+}
+
+// MetricResults holds the results of a metric, per variant.
+type MetricResults struct {
+	Metric   *Metric
+	Variants []*VariantResults
+}
+
+// VariantResults holds the conversion rate of a variant for a metric.
+type VariantResults struct {
+	Variant            *Variant
+	Exposures          int
+	Conversions        int
+	ConversionRate     float64
+	ConfidenceInterval *ConfidenceInterval
+}
+
+// ConfidenceInterval is the range the conversion rate is expected to be
+// within, with a 95% confidence level.
+type ConfidenceInterval struct {
+	Lower float64
+	Upper float64
+}
+
+// NewExperimentResults calculates the results of the flag experiment from
+// the exposure and conversion counts. Counts for variants or metrics that
+// are not in the flag anymore are ignored.
+func NewExperimentResults(flg *Flag, counts []*VariantCount) *ExperimentResults {
+	type countKey struct{ variantID, metricKey string }
+	countsMap := make(map[countKey]*VariantCount, len(counts))
+	for _, c := range counts {
+		countsMap[countKey{c.VariantID, c.MetricKey}] = c
+	}
+
+	res := &ExperimentResults{Metrics: make([]*MetricResults, len(flg.Metrics))}
+	for idx, mtrc := range flg.Metrics {
+		mtrcRes := &MetricResults{
+			Metric:   mtrc,
+			Variants: make([]*VariantResults, len(flg.Variants)),
+		}
+		for vidx, vrnt := range flg.Variants {
+			var exposures, conversions int
+			if c, ok := countsMap[countKey{vrnt.ID, mtrc.Key}]; ok {
+				exposures, conversions = c.Exposures, c.Conversions
+			}
+			vrntRes := &VariantResults{
+				Variant:            vrnt,
+				Exposures:          exposures,
+				Conversions:        conversions,
+				ConfidenceInterval: wilsonInterval(conversions, exposures),
+			}
+			if exposures > 0 {
+				vrntRes.ConversionRate = float64(conversions) / float64(exposures)
+			}
+			mtrcRes.Variants[vidx] = vrntRes
+		}
+		res.Metrics[idx] = mtrcRes
+	}
+	return res
+}
+
+// wilsonInterval returns the Wilson score interval for the conversion rate,
+// which behaves well for small samples and rates close to 0 or 1.
+func wilsonInterval(conversions, exposures int) *ConfidenceInterval {
+	if exposures == 0 {
+		return &ConfidenceInterval{Lower: 0, Upper: 0}
+	}
+	n := float64(exposures)
+	p := float64(conversions) / n
+	z2 := confidenceZ * confidenceZ
+	center := (p + z2/(2*n)) / (1 + z2/n)
+	margin := confidenceZ / (1 + z2/n) * math.Sqrt(p*(1-p)/n+z2/(4*n*n))
+	return &ConfidenceInterval{
+		Lower: math.Max(0, center-margin),
+		Upper: math.Min(1, center+margin),
+	}
+}
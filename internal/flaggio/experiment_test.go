@@ -0,0 +1,85 @@
+package flaggio_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+func TestNewExperimentResults(t *testing.T) {
+	t.Parallel()
+	vrnt1 := &flaggio.Variant{ID: "1", Value: true}
+	vrnt2 := &flaggio.Variant{ID: "2", Value: false}
+	mtrc := &flaggio.Metric{ID: "3", Key: "signup", Name: "Signup"}
+	flg := &flaggio.Flag{
+		Variants: []*flaggio.Variant{vrnt1, vrnt2},
+		Metrics:  []*flaggio.Metric{mtrc},
+	}
+
+	tests := []struct {
+		name             string
+		counts           []*flaggio.VariantCount
+		expectedVariants []*flaggio.VariantResults
+	}{
+		{
+			name: "returns zeroed results when there are no counts",
+			expectedVariants: []*flaggio.VariantResults{
+				{Variant: vrnt1, ConfidenceInterval: &flaggio.ConfidenceInterval{}},
+				{Variant: vrnt2, ConfidenceInterval: &flaggio.ConfidenceInterval{}},
+			},
+		},
+		{
+			name: "calculates conversion rate and confidence interval",
+			counts: []*flaggio.VariantCount{
+				{VariantID: "1", MetricKey: "signup", Exposures: 100, Conversions: 10},
+				{VariantID: "2", MetricKey: "signup", Exposures: 20, Conversions: 0},
+			},
+			expectedVariants: []*flaggio.VariantResults{
+				{
+					Variant:            vrnt1,
+					Exposures:          100,
+					Conversions:        10,
+					ConversionRate:     0.1,
+					ConfidenceInterval: &flaggio.ConfidenceInterval{Lower: 0.055229, Upper: 0.174366},
+				},
+				{
+					Variant:            vrnt2,
+					Exposures:          20,
+					ConfidenceInterval: &flaggio.ConfidenceInterval{Lower: 0, Upper: 0.161125},
+				},
+			},
+		},
+		{
+			name: "ignores counts of unknown variants and metrics",
+			counts: []*flaggio.VariantCount{
+				{VariantID: "9", MetricKey: "signup", Exposures: 10, Conversions: 5},
+				{VariantID: "1", MetricKey: "purchase", Exposures: 10, Conversions: 5},
+			},
+			expectedVariants: []*flaggio.VariantResults{
+				{Variant: vrnt1, ConfidenceInterval: &flaggio.ConfidenceInterval{}},
+				{Variant: vrnt2, ConfidenceInterval: &flaggio.ConfidenceInterval{}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res := flaggio.NewExperimentResults(flg, tt.counts)
+			assert.Len(t, res.Metrics, 1)
+			assert.Equal(t, mtrc, res.Metrics[0].Metric)
+			assert.Len(t, res.Metrics[0].Variants, len(tt.expectedVariants))
+			for idx, expected := range tt.expectedVariants {
+				vrntRes := res.Metrics[0].Variants[idx]
+				assert.Equal(t, expected.Variant, vrntRes.Variant)
+				assert.Equal(t, expected.Exposures, vrntRes.Exposures)
+				assert.Equal(t, expected.Conversions, vrntRes.Conversions)
+				assert.InDelta(t, expected.ConversionRate, vrntRes.ConversionRate, 0.000001)
+				assert.InDelta(t, expected.ConfidenceInterval.Lower, vrntRes.ConfidenceInterval.Lower, 0.000001)
+				assert.InDelta(t, expected.ConfidenceInterval.Upper, vrntRes.ConfidenceInterval.Upper, 0.000001)
+			}
+		})
+	}
+}
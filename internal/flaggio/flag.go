@@ -15,14 +15,30 @@ type Flag struct {
 	Key                   string
 	Name                  string
 	Description           *string
+	Kind                  FlagKind
+	Tags                  []string
+	OwnerTeam             *string
+	Maintainer            *string
+	Links                 []string
+	ExpiresAt             *time.Time
+	ClientExposed         bool
+	Archived              bool
+	ArchivedAt            *time.Time
 	Enabled               bool
 	Version               int
 	Variants              []*Variant
 	Rules                 []*FlagRule
 	DefaultVariantWhenOn  *Variant
 	DefaultVariantWhenOff *Variant
+	Experiment            bool
+	Metrics               []*Metric
+	Protected             bool
+	RequiredApprovals     int
+	ChangeRequests        []*ChangeRequest
 	CreatedAt             time.Time
 	UpdatedAt             *time.Time
+	// the exclusion group of the flag, set by Populate
+	exclusionGroup *ExclusionGroup
 }
 
 // GetID returns the flag ID.
@@ -30,37 +46,72 @@ func (f *Flag) GetID() string {
 	return f.ID
 }
 
+// FindVariant returns the flag variant with the given ID.
+func (f *Flag) FindVariant(id string) (*Variant, error) {
+	for _, vrnt := range f.Variants {
+		if vrnt.ID == id {
+			return vrnt, nil
+		}
+	}
+	return nil, errors.NotFound("variant")
+}
+
+// FindRule returns the flag rule with the given ID.
+func (f *Flag) FindRule(id string) (*FlagRule, error) {
+	for _, rl := range f.Rules {
+		if rl.ID == id {
+			return rl, nil
+		}
+	}
+	return nil, errors.NotFound("flag rule")
+}
+
 // Evaluate will return the default variant as answer based on the flag status (on or off).
-// If the flag is on, it will also return the list of rules to be evaluated.
+// If the flag is on, it will also return the list of rules to be evaluated. Users outside
+// the slice of the exclusion group given to the flag are answered as if the flag was off.
 // If there is no default variant configured for the given flag enabled state, an error
 // is returned.
 func (f *Flag) Evaluate(usrContext map[string]interface{}) (EvalResult, error) {
-	var answer interface{}
+	vrnt, reason := f.defaultVariant(usrContext)
+	if vrnt == nil {
+		return EvalResult{}, errors.ErrNoDefaultVariant
+	}
 	var next []Evaluator
-	if f.Enabled {
-		vrnt := f.DefaultVariantWhenOn
-		if vrnt == nil {
-			return EvalResult{}, errors.ErrNoDefaultVariant
-		}
-		answer = vrnt.Value
+	if reason == ReasonDefault {
 		for _, rl := range f.Rules {
 			next = append(next, rl)
 		}
-	} else {
-		vrnt := f.DefaultVariantWhenOff
-		if vrnt == nil {
-			return EvalResult{}, errors.ErrNoDefaultVariant
-		}
-		answer = vrnt.Value
 	}
 	return EvalResult{
-		Answer: answer,
-		Next:   next,
+		Answer:  vrnt.Value,
+		Variant: vrnt,
+		Reason:  reason,
+		Next:    next,
 	}, nil
 }
 
-// Populate will try to populate all references in the list of rules.
+// defaultVariant returns the variant served to the user when no rule matches,
+// and the reason for it. Rules are only evaluated for ReasonDefault.
+func (f *Flag) defaultVariant(usrContext map[string]interface{}) (*Variant, Reason) {
+	switch {
+	case !f.Enabled:
+		return f.DefaultVariantWhenOff, ReasonDisabled
+	case f.exclusionGroup != nil && !f.exclusionGroup.Includes(f.ID, usrContext):
+		return f.DefaultVariantWhenOff, ReasonExcluded
+	default:
+		return f.DefaultVariantWhenOn, ReasonDefault
+	}
+}
+
+// Populate will try to populate all references in the list of rules, and
+// the exclusion group the flag is part of, if any.
 func (f *Flag) Populate(identifiers []Identifier) {
+	f.exclusionGroup = nil
+	for _, ider := range identifiers {
+		if grp, ok := ider.(*ExclusionGroup); ok && grp.Allocation(f.ID) != nil {
+			f.exclusionGroup = grp
+		}
+	}
 	for _, r := range f.Rules {
 		r.Populate(identifiers)
 	}
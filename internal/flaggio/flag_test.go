@@ -51,7 +51,7 @@ func TestFlag_Evaluate(t *testing.T) {
 				DefaultVariantWhenOn:  vrnt1,
 				DefaultVariantWhenOff: vrnt2,
 			},
-			expectedResult: flaggio.EvalResult{Answer: 2},
+			expectedResult: flaggio.EvalResult{Answer: 2, Variant: vrnt2, Reason: flaggio.ReasonDisabled},
 		},
 		{
 			name: "returns default variant when on",
@@ -62,7 +62,12 @@ func TestFlag_Evaluate(t *testing.T) {
 				DefaultVariantWhenOn:  vrnt1,
 				DefaultVariantWhenOff: vrnt2,
 			},
-			expectedResult: flaggio.EvalResult{Answer: 1, Next: []flaggio.Evaluator{rl1}},
+			expectedResult: flaggio.EvalResult{
+				Answer:  1,
+				Variant: vrnt1,
+				Reason:  flaggio.ReasonDefault,
+				Next:    []flaggio.Evaluator{rl1},
+			},
 		},
 	}
 
@@ -77,3 +82,69 @@ func TestFlag_Evaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestFlag_EvaluateExclusionGroup(t *testing.T) {
+	t.Parallel()
+	vrnt1 := &flaggio.Variant{ID: "1", Value: 1}
+	vrnt2 := &flaggio.Variant{ID: "2", Value: 2}
+	rl1 := &flaggio.FlagRule{}
+	grp := &flaggio.ExclusionGroup{ID: "g1"}
+	slot := grp.Slot("john")
+	grp.Allocations = []*flaggio.SlotAllocation{{FlagID: "f1", Start: slot, End: slot + 1}}
+
+	tests := []struct {
+		name           string
+		enabled        bool
+		usrContext     map[string]interface{}
+		expectedResult flaggio.EvalResult
+	}{
+		{
+			name:       "returns default variant when on for users in the slice of the flag",
+			enabled:    true,
+			usrContext: map[string]interface{}{"$userId": "john"},
+			expectedResult: flaggio.EvalResult{
+				Answer:  1,
+				Variant: vrnt1,
+				Reason:  flaggio.ReasonDefault,
+				Next:    []flaggio.Evaluator{rl1},
+			},
+		},
+		{
+			name:           "returns default variant when off for users outside the slice of the flag",
+			enabled:        true,
+			usrContext:     map[string]interface{}{"$userId": "mary"},
+			expectedResult: flaggio.EvalResult{Answer: 2, Variant: vrnt2, Reason: flaggio.ReasonExcluded},
+		},
+		{
+			name:           "returns default variant when off for users without an ID",
+			enabled:        true,
+			usrContext:     map[string]interface{}{"name": "John"},
+			expectedResult: flaggio.EvalResult{Answer: 2, Variant: vrnt2, Reason: flaggio.ReasonExcluded},
+		},
+		{
+			name:           "returns disabled before checking the group",
+			enabled:        false,
+			usrContext:     map[string]interface{}{"$userId": "mary"},
+			expectedResult: flaggio.EvalResult{Answer: 2, Variant: vrnt2, Reason: flaggio.ReasonDisabled},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			flg := flaggio.Flag{
+				ID:                    "f1",
+				Enabled:               tt.enabled,
+				Variants:              []*flaggio.Variant{vrnt1, vrnt2},
+				Rules:                 []*flaggio.FlagRule{rl1},
+				DefaultVariantWhenOn:  vrnt1,
+				DefaultVariantWhenOff: vrnt2,
+			}
+			flg.Populate([]flaggio.Identifier{grp})
+			eval, err := flg.Evaluate(tt.usrContext)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, eval)
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package flaggio
+
+// LintIssue is a problem found in the configuration of a flag. Issues
+// with error severity make the flag fail or misbehave when evaluated.
+type LintIssue struct {
+	Severity LintSeverity `json:"severity"`
+	Code     LintCode     `json:"code"`
+	Message  string       `json:"message"`
+	RuleID   *string      `json:"ruleId,omitempty"`
+}
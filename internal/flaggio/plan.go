@@ -0,0 +1,249 @@
+package flaggio
+
+import (
+	"fmt"
+
+	"github.com/uw-labs/flaggio/internal/errors"
+	"github.com/uw-labs/flaggio/internal/operator"
+)
+
+// Plan is a flag compiled for evaluation: the values of its constraints are
+// prepared, like regexes and networks being parsed, and the segments they
+// reference are resolved. Evaluating a plan gives the same result as
+// evaluating the flag itself. Plans are never changed after they're compiled,
+// so they can be shared between evaluations.
+type Plan struct {
+	flag  *Flag
+	rules []*planRule
+}
+
+type planRule struct {
+	rule  *FlagRule
+	match matcher
+}
+
+// matcher checks if the user context satisfies a compiled constraint.
+type matcher func(usrContext map[string]interface{}) (bool, error)
+
+// Maps the GraphQL enum to the operators that prepare the values configured
+// on the flag. Other operations use the operator func as it is.
+var preparerMap = map[Operation]func(validValues []interface{}) operator.Matcher{
+	OperationOneOf:            operator.PrepareOneOf,
+	OperationNotOneOf:         operator.PrepareNotOneOf,
+	OperationMatchesRegex:     operator.PrepareMatchesRegex,
+	OperationDoesntMatchRegex: operator.PrepareDoesntMatchRegex,
+	OperationIsInNetwork:      operator.PrepareInNetwork,
+}
+
+// CompilePlan compiles the flag into an evaluation plan. The flag is populated
+// with the identifiers, so the segments referenced by its rules and its
+// exclusion group are resolved.
+func CompilePlan(flg *Flag, identifiers []Identifier) *Plan {
+	flg.Populate(identifiers)
+	c := &planCompiler{segments: make(map[*Segment]matcher)}
+	plan := &Plan{flag: flg, rules: make([]*planRule, len(flg.Rules))}
+	for idx, rl := range flg.Rules {
+		plan.rules[idx] = &planRule{rule: rl, match: c.all(rl.Constraints)}
+	}
+	return plan
+}
+
+// Flag returns the flag the plan was compiled from.
+func (p *Plan) Flag() *Flag {
+	return p.flag
+}
+
+// Evaluate evaluates the plan with the user context. The result, including
+// its stack trace, is the same as evaluating the flag with Evaluate.
+func (p *Plan) Evaluate(usrContext map[string]interface{}) (EvalResult, error) {
+	flg := p.flag
+	vrnt, reason := flg.defaultVariant(usrContext)
+	if vrnt == nil {
+		return EvalResult{}, errors.ErrNoDefaultVariant
+	}
+	res := EvalResult{
+		Answer:    vrnt.Value,
+		Variant:   vrnt,
+		Reason:    reason,
+		evaluator: flg,
+	}
+	// the last evaluation result that had an answer
+	var lastWithResult EvalResult
+	if res.Answer != nil {
+		lastWithResult = res
+	}
+	if reason != ReasonDefault {
+		return lastWithResult, nil
+	}
+
+	// rules are evaluated in order, until one of them serves an answer
+	prev := &res
+	for _, rl := range p.rules {
+		rlRes := EvalResult{evaluator: rl.rule, previous: prev}
+		prev = &rlRes
+		if rl.rule.Disabled {
+			continue
+		}
+		ok, err := rl.match(usrContext)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if !ok {
+			continue
+		}
+		dl := DistributionList(rl.rule.Distributions)
+		dlRes, err := dl.Evaluate(usrContext)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		dlRes.evaluator = dl
+		dlRes.previous = &rlRes
+		if dlRes.Answer != nil {
+			return dlRes, nil
+		}
+		prev = &dlRes
+	}
+	return lastWithResult, nil
+}
+
+type planCompiler struct {
+	// segments that were already compiled, so segments referenced by many
+	// constraints are only compiled once
+	segments map[*Segment]matcher
+}
+
+// all compiles the constraints into a matcher that's satisfied when all the
+// constraints are, like ConstraintList.Validate.
+func (c *planCompiler) all(cnstrnts []*Constraint) matcher {
+	matchers := c.constraints(cnstrnts)
+	return func(usrContext map[string]interface{}) (bool, error) {
+		for _, match := range matchers {
+			ok, err := match(usrContext)
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+}
+
+// any compiles the constraints into a matcher that's satisfied when any of
+// the constraints are. An empty list is never satisfied.
+func (c *planCompiler) any(cnstrnts []*Constraint) matcher {
+	matchers := c.constraints(cnstrnts)
+	return func(usrContext map[string]interface{}) (bool, error) {
+		for _, match := range matchers {
+			ok, err := match(usrContext)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+func (c *planCompiler) constraints(cnstrnts []*Constraint) []matcher {
+	matchers := make([]matcher, len(cnstrnts))
+	for idx, cnstrnt := range cnstrnts {
+		matchers[idx] = c.constraint(cnstrnt)
+	}
+	return matchers
+}
+
+func (c *planCompiler) constraint(cnstrnt *Constraint) matcher {
+	switch cnstrnt.Operation {
+	case OperationAll:
+		return c.all(cnstrnt.Constraints)
+	case OperationAny:
+		return c.any(cnstrnt.Constraints)
+	case OperationNone:
+		match := c.any(cnstrnt.Constraints)
+		return func(usrContext map[string]interface{}) (bool, error) {
+			ok, err := match(usrContext)
+			return !ok && err == nil, err
+		}
+	case OperationIsInSegment, OperationIsntInSegment:
+		return c.segmentConstraint(cnstrnt)
+	}
+	property := cnstrnt.Property
+	if prepare, ok := preparerMap[cnstrnt.Operation]; ok {
+		match := prepare(cnstrnt.Values)
+		return func(usrContext map[string]interface{}) (bool, error) {
+			return match(lookup(usrContext, property))
+		}
+	}
+	operate, ok := operatorMap[cnstrnt.Operation]
+	if !ok {
+		// unknown operation, this is a configuration problem
+		err := errors.InvalidFlag(fmt.Sprintf("unknown operation: %s", cnstrnt.Operation))
+		return func(map[string]interface{}) (bool, error) {
+			return false, err
+		}
+	}
+	values := cnstrnt.Values
+	return func(usrContext map[string]interface{}) (bool, error) {
+		return operate(lookup(usrContext, property), values)
+	}
+}
+
+// segmentConstraint compiles a constraint that references segments, which
+// is satisfied when the user belongs to all of them, or none of them.
+func (c *planCompiler) segmentConstraint(cnstrnt *Constraint) matcher {
+	matchers := make([]matcher, len(cnstrnt.Values))
+	for idx, v := range cnstrnt.Values {
+		sgmnt, ok := v.(*Segment)
+		if !ok {
+			// the reference couldn't be resolved, so the constraint is
+			// validated as it is
+			return cnstrnt.Validate
+		}
+		matchers[idx] = c.segment(sgmnt)
+	}
+	want := cnstrnt.Operation == OperationIsInSegment
+	return func(usrContext map[string]interface{}) (bool, error) {
+		for _, match := range matchers {
+			ok, err := match(usrContext)
+			if err != nil {
+				return false, err
+			}
+			if ok != want {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+}
+
+// segment compiles a segment into a matcher that's satisfied when any of its
+// enabled rules are, like Segment.Validate.
+func (c *planCompiler) segment(sgmnt *Segment) matcher {
+	if match, ok := c.segments[sgmnt]; ok {
+		return match
+	}
+	var rules []matcher
+	for _, rl := range sgmnt.Rules {
+		if !rl.Disabled {
+			rules = append(rules, c.all(rl.Constraints))
+		}
+	}
+	match := func(usrContext map[string]interface{}) (bool, error) {
+		for _, rl := range rules {
+			ok, err := rl(usrContext)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	c.segments[sgmnt] = match
+	return match
+}
@@ -0,0 +1,195 @@
+package flaggio_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+func newPlanFlag() (*flaggio.Flag, []flaggio.Identifier) {
+	vrnt1, vrnt2, vrnt3 := &flaggio.Variant{ID: "1", Value: "a"}, &flaggio.Variant{ID: "2", Value: "b"},
+		&flaggio.Variant{ID: "3", Value: "c"}
+	staff := &flaggio.Segment{ID: "s1", Rules: []*flaggio.SegmentRule{
+		{Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{
+			{Property: "email", Operation: flaggio.OperationMatchesRegex, Values: []interface{}{`@flaggio\.com$`}},
+		}}},
+		{Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{
+			{Property: "ip", Operation: flaggio.OperationIsInNetwork, Values: []interface{}{"10.0.0.0/8"}},
+		}}},
+	}}
+	blocked := &flaggio.Segment{ID: "s2", Rules: []*flaggio.SegmentRule{
+		{Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{
+			{Property: "country", Operation: flaggio.OperationOneOf, Values: []interface{}{"XX", "YY", "ZZ"}},
+		}}},
+		{Rule: flaggio.Rule{Disabled: true, Constraints: []*flaggio.Constraint{
+			{Property: "country", Operation: flaggio.OperationOneOf, Values: []interface{}{"UK"}},
+		}}},
+	}}
+	blockedName, staffName := "blocked", "staff"
+	flg := &flaggio.Flag{
+		ID:                    "f1",
+		Enabled:               true,
+		Variants:              []*flaggio.Variant{vrnt1, vrnt2, vrnt3},
+		DefaultVariantWhenOn:  vrnt1,
+		DefaultVariantWhenOff: vrnt1,
+		Rules: []*flaggio.FlagRule{
+			{
+				Rule: flaggio.Rule{ID: "r1", Name: &blockedName, Constraints: []*flaggio.Constraint{
+					{Operation: flaggio.OperationIsInSegment, Values: []interface{}{"s2"}},
+				}},
+				Distributions: []*flaggio.Distribution{{ID: "d1", Variant: vrnt1, Percentage: 100}},
+			},
+			{
+				Rule: flaggio.Rule{ID: "r2", Disabled: true, Constraints: []*flaggio.Constraint{
+					{Property: "plan", Operation: flaggio.OperationExists},
+				}},
+				Distributions: []*flaggio.Distribution{{ID: "d2", Variant: vrnt3, Percentage: 100}},
+			},
+			{
+				Rule: flaggio.Rule{ID: "r3", Name: &staffName, Constraints: []*flaggio.Constraint{
+					{Operation: flaggio.OperationIsInSegment, Values: []interface{}{"s1"}},
+					{Operation: flaggio.OperationNone, Constraints: []*flaggio.Constraint{
+						{Property: "email", Operation: flaggio.OperationDoesntMatchRegex, Values: []interface{}{"^admin"}},
+						{Property: "age", Operation: flaggio.OperationLower, Values: []interface{}{int64(18)}},
+					}},
+				}},
+				Distributions: []*flaggio.Distribution{{ID: "d3", Variant: vrnt2, Percentage: 100}},
+			},
+			{
+				Rule: flaggio.Rule{ID: "r4", Constraints: []*flaggio.Constraint{
+					{Operation: flaggio.OperationIsntInSegment, Values: []interface{}{"s1", "s3"}},
+					{Operation: flaggio.OperationAny, Constraints: []*flaggio.Constraint{
+						{Property: "plan", Operation: flaggio.OperationOneOf, Values: []interface{}{"pro", "team"}},
+						{Property: "seats", Operation: flaggio.OperationNotOneOf, Values: []interface{}{int64(1), int64(2)}},
+					}},
+				}},
+				Distributions: []*flaggio.Distribution{{ID: "d4", Variant: vrnt3, Percentage: 100}},
+			},
+		},
+	}
+	return flg, []flaggio.Identifier{staff, blocked}
+}
+
+func TestPlan_Evaluate(t *testing.T) {
+	t.Parallel()
+	usrContexts := []map[string]interface{}{
+		{},
+		{"country": "XX"},
+		{"country": "UK", "plan": "free"},
+		{"email": "admin@flaggio.com", "age": int64(30)},
+		{"email": "john@flaggio.com", "age": int64(30)},
+		{"email": "admin@example.com", "ip": "10.1.1.1", "age": int64(30)},
+		{"email": "admin@flaggio.com", "age": int64(16)},
+		{"plan": "pro"},
+		{"plan": "free", "seats": int64(1)},
+		{"plan": "free", "seats": 5},
+		{"seats": "5"},
+		{"plan": []interface{}{"free", "team"}},
+		{"$userId": "john", "plan": "pro"},
+		{"$userId": "mary", "plan": "pro"},
+		{"$userId": "anna", "country": "XX"},
+	}
+	newIdentifiers := func(grouped bool, iders []flaggio.Identifier) []flaggio.Identifier {
+		if !grouped {
+			return iders
+		}
+		return append(iders, &flaggio.ExclusionGroup{ID: "g1", Allocations: []*flaggio.SlotAllocation{
+			{FlagID: "f1", Start: 0, End: 500},
+		}})
+	}
+	for _, grouped := range []bool{false, true} {
+		for _, enabled := range []bool{true, false} {
+			for _, usrContext := range usrContexts {
+				grouped, enabled, usrContext := grouped, enabled, usrContext
+				t.Run(fmt.Sprintf("grouped %t enabled %t with %v", grouped, enabled, usrContext), func(t *testing.T) {
+					t.Parallel()
+					// plans give the same results as evaluating the flag
+					flg, iders := newPlanFlag()
+					flg.Enabled = enabled
+					flg.Populate(newIdentifiers(grouped, iders))
+					expectedRes, expectedErr := flaggio.Evaluate(usrContext, flg)
+
+					flg, iders = newPlanFlag()
+					flg.Enabled = enabled
+					res, err := flaggio.CompilePlan(flg, newIdentifiers(grouped, iders)).Evaluate(usrContext)
+
+					assert.Equal(t, expectedErr, err)
+					assert.Equal(t, expectedRes.Answer, res.Answer)
+					assert.Equal(t, expectedRes.Variant, res.Variant)
+					assert.Equal(t, expectedRes.Reason, res.Reason)
+					assert.Equal(t, expectedRes.Stack(), res.Stack())
+				})
+			}
+		}
+	}
+}
+
+func TestPlan_EvaluateErrors(t *testing.T) {
+	t.Parallel()
+	vrnt := &flaggio.Variant{ID: "1", Value: true}
+	tests := []struct {
+		name        string
+		flag        *flaggio.Flag
+		expectedErr string
+	}{
+		{
+			name:        "no default variant",
+			flag:        &flaggio.Flag{Enabled: true},
+			expectedErr: "no default variant defined for flag",
+		},
+		{
+			name: "unknown operation",
+			flag: &flaggio.Flag{Enabled: true, DefaultVariantWhenOn: vrnt, Rules: []*flaggio.FlagRule{{
+				Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{
+					{Property: "name", Operation: flaggio.Operation("UNKNOWN")},
+				}},
+			}}},
+			expectedErr: "invalid flag: unknown operation: UNKNOWN",
+		},
+		{
+			name: "invalid regex",
+			flag: &flaggio.Flag{Enabled: true, DefaultVariantWhenOn: vrnt, Rules: []*flaggio.FlagRule{{
+				Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{
+					{Property: "name", Operation: flaggio.OperationMatchesRegex, Values: []interface{}{"[a-z"}},
+				}},
+			}}},
+			expectedErr: "error parsing regexp: missing closing ]: `[a-z`",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := flaggio.CompilePlan(tt.flag, nil).Evaluate(map[string]interface{}{"name": "john"})
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+var benchmarkUsrContext = map[string]interface{}{
+	"email":   "john@flaggio.com",
+	"ip":      "192.168.0.1",
+	"country": "UK",
+	"plan":    "free",
+	"seats":   int64(2),
+}
+
+func BenchmarkEvaluate(b *testing.B) {
+	flg, iders := newPlanFlag()
+	for n := 0; n < b.N; n++ {
+		flg.Populate(iders)
+		_, _ = flaggio.Evaluate(benchmarkUsrContext, flg)
+	}
+}
+
+func BenchmarkPlan_Evaluate(b *testing.B) {
+	flg, iders := newPlanFlag()
+	plan := flaggio.CompilePlan(flg, iders)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, _ = plan.Evaluate(benchmarkUsrContext)
+	}
+}
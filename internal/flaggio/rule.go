@@ -1,12 +1,18 @@
 package flaggio
 
 var _ Identifier = (*Rule)(nil)
+var _ Namer = (*Rule)(nil)
 var _ Evaluator = (*FlagRule)(nil)
 
 // Rule has a list of constraints that all need to be satisfied so that
-// it can pass.
+// it can pass. Disabled rules never pass. Rules are enabled unless they
+// are disabled, so rules stored or cached before rules could be disabled
+// are enabled.
 type Rule struct {
 	ID          string
+	Name        *string
+	Description *string
+	Disabled    bool
 	Constraints []*Constraint
 }
 
@@ -18,6 +24,19 @@ func (r Rule) GetID() string {
 	return r.ID
 }
 
+// GetName returns the rule name.
+func (r Rule) GetName() string {
+	if r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// Enabled returns whether the rule is enabled.
+func (r Rule) Enabled() bool {
+	return !r.Disabled
+}
+
 // Populate will try to populate all references in the list of constraints.
 func (r *Rule) Populate(identifiers []Identifier) {
 	ConstraintList(r.Constraints).Populate(identifiers)
@@ -33,8 +52,12 @@ type FlagRule struct {
 // is the case, it returns the list of distributions as next to be evaluated.
 // If any of the constraints fail to pass, the rule returns an empty list of
 // next evaluators. In any case, no answer is returned from the evaluation.
+// Disabled rules are skipped.
 func (r FlagRule) Evaluate(usrContext map[string]interface{}) (EvalResult, error) {
 	var next []Evaluator
+	if r.Disabled {
+		return EvalResult{}, nil
+	}
 	ok, err := ConstraintList(r.Constraints).Validate(usrContext)
 	if ok {
 		next = []Evaluator{DistributionList(r.Distributions)}
@@ -13,6 +13,13 @@ func TestRule_GetID(t *testing.T) {
 	assert.Equal(t, "123456", rl.GetID())
 }
 
+func TestRule_GetName(t *testing.T) {
+	t.Parallel()
+	name := "beta testers"
+	assert.Equal(t, "beta testers", flaggio.Rule{Name: &name}.GetName())
+	assert.Equal(t, "", flaggio.Rule{}.GetName())
+}
+
 func TestFlagRule_Evaluate(t *testing.T) {
 	t.Parallel()
 	vrnt1 := &flaggio.Variant{ID: "1", Value: 1}
@@ -64,6 +71,15 @@ func TestFlagRule_Evaluate(t *testing.T) {
 				Next:   []flaggio.Evaluator{flaggio.DistributionList([]*flaggio.Distribution{dstrbtn})},
 			},
 		},
+		{
+			name:       "doesn't return the distribution list when the rule is disabled",
+			usrContext: map[string]interface{}{"name": "John", "age": 30},
+			rule: flaggio.FlagRule{
+				Rule:          flaggio.Rule{ID: rl.ID, Disabled: true, Constraints: rl.Constraints},
+				Distributions: []*flaggio.Distribution{dstrbtn},
+			},
+			expectedResult: flaggio.EvalResult{Answer: nil, Next: nil},
+		},
 	}
 
 	for _, tt := range tests {
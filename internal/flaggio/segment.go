@@ -1,8 +1,10 @@
 package flaggio
 
 import (
+	"strings"
 	"time"
 
+	"github.com/uw-labs/flaggio/internal/errors"
 	"github.com/uw-labs/flaggio/internal/operator"
 )
 
@@ -26,9 +28,13 @@ func (s *Segment) GetID() string {
 }
 
 // Validate will check if any of the segment rules passes validation. If so,
-// the validation is successful, otherwise it returns false.
+// the validation is successful, otherwise it returns false. Disabled rules
+// are skipped.
 func (s *Segment) Validate(usrContext map[string]interface{}) (bool, error) {
 	for _, rl := range s.Rules {
+		if rl.Disabled {
+			continue
+		}
 		ok, err := ConstraintList(rl.Constraints).Validate(usrContext)
 		if err != nil {
 			return false, err
@@ -39,3 +45,105 @@ func (s *Segment) Validate(usrContext map[string]interface{}) (bool, error) {
 	}
 	return false, nil
 }
+
+// Populate will try to populate all references in the list of rules, so
+// that a segment can be composed of other segments.
+func (s *Segment) Populate(identifiers []Identifier) {
+	for _, rl := range s.Rules {
+		rl.Populate(identifiers)
+	}
+}
+
+// SegmentIDs returns the IDs of the segments referenced by the segment rules.
+func (s *Segment) SegmentIDs() []string {
+	var ids []string
+	for _, rl := range s.Rules {
+		ids = append(ids, ConstraintList(rl.Constraints).SegmentIDs()...)
+	}
+	return ids
+}
+
+// SegmentUsage holds a flag and the flag rules that reference a segment.
+type SegmentUsage struct {
+	Flag  *Flag
+	Rules []*FlagRule
+}
+
+// NewSegmentUsages returns the flags with rules that reference the segment
+// with the given ID.
+func NewSegmentUsages(flgs []*Flag, segmentID string) []*SegmentUsage {
+	var usages []*SegmentUsage
+	for _, flg := range flgs {
+		var rules []*FlagRule
+		for _, rl := range flg.Rules {
+			for _, ref := range ConstraintList(rl.Constraints).SegmentIDs() {
+				if ref == segmentID {
+					rules = append(rules, rl)
+					break
+				}
+			}
+		}
+		if len(rules) > 0 {
+			usages = append(usages, &SegmentUsage{Flag: flg, Rules: rules})
+		}
+	}
+	return usages
+}
+
+// SegmentList is a slice of *Segment.
+type SegmentList []*Segment
+
+// Populate will populate the references between the segments on this list,
+// so that segments can be composed of other segments. Segments that are part
+// of a cycle are not populated, so their references to other segments never
+// validate to true.
+func (l SegmentList) Populate() {
+	iders := make([]Identifier, len(l))
+	refs := make(map[string][]string, len(l))
+	for idx, sgmnt := range l {
+		iders[idx] = sgmnt
+		refs[sgmnt.ID] = sgmnt.SegmentIDs()
+	}
+	for _, sgmnt := range l {
+		if FindSegmentCycle(refs, sgmnt.ID) != nil {
+			continue
+		}
+		sgmnt.Populate(iders)
+	}
+}
+
+// FindSegmentCycle returns the path of segment IDs that starts and ends on
+// the given segment, if any, where refs maps the ID of each segment to the
+// IDs of the segments it references.
+func FindSegmentCycle(refs map[string][]string, id string) []string {
+	return findSegmentPath(refs, id, id, map[string]bool{})
+}
+
+func findSegmentPath(refs map[string][]string, from, to string, visited map[string]bool) []string {
+	for _, ref := range refs[from] {
+		if ref == to {
+			return []string{from, to}
+		}
+		if visited[ref] {
+			continue
+		}
+		visited[ref] = true
+		if path := findSegmentPath(refs, ref, to, visited); path != nil {
+			return append([]string{from}, path...)
+		}
+	}
+	return nil
+}
+
+// SegmentCycleError returns an error describing a cycle between segments,
+// using the segment names when available.
+func SegmentCycleError(cycle []string, names map[string]string) error {
+	path := make([]string, len(cycle))
+	for idx, id := range cycle {
+		path[idx] = id
+		if name, ok := names[id]; ok {
+			path[idx] = name
+		}
+	}
+	return errors.BadRequest("segments can't reference each other in a cycle: " + strings.Join(path, " -> "))
+}
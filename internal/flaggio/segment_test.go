@@ -49,6 +49,14 @@ func TestSegment_Validate(t *testing.T) {
 			segment:        flaggio.Segment{Rules: []*flaggio.SegmentRule{{rl1}, {rl2}}},
 			expectedResult: false,
 		},
+		{
+			name:       "returns false when the only rule that validates to true is disabled",
+			usrContext: map[string]interface{}{"name": "Mary", "age": 40},
+			segment: flaggio.Segment{Rules: []*flaggio.SegmentRule{
+				{rl1}, {flaggio.Rule{Disabled: true, Constraints: rl2.Constraints}},
+			}},
+			expectedResult: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -61,3 +69,102 @@ func TestSegment_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestSegmentList_Populate(t *testing.T) {
+	t.Parallel()
+	newSegment := func(id, property string, sgmntIDs ...interface{}) *flaggio.Segment {
+		cnstrnts := []*flaggio.Constraint{{Property: property, Operation: flaggio.OperationExists}}
+		if len(sgmntIDs) > 0 {
+			cnstrnts = append(cnstrnts, &flaggio.Constraint{Operation: flaggio.OperationIsInSegment, Values: sgmntIDs})
+		}
+		return &flaggio.Segment{ID: id, Rules: []*flaggio.SegmentRule{
+			{flaggio.Rule{Constraints: cnstrnts}},
+		}}
+	}
+
+	tests := []struct {
+		name           string
+		segments       flaggio.SegmentList
+		usrContext     map[string]interface{}
+		expectedResult bool
+	}{
+		{
+			name: "validates segments composed of other segments",
+			segments: flaggio.SegmentList{
+				newSegment("1", "name", "2"),
+				newSegment("2", "age", "3"),
+				newSegment("3", "country"),
+			},
+			usrContext:     map[string]interface{}{"name": "John", "age": 30, "country": "UK"},
+			expectedResult: true,
+		},
+		{
+			name: "doesn't validate when a referenced segment doesn't validate",
+			segments: flaggio.SegmentList{
+				newSegment("1", "name", "2"),
+				newSegment("2", "age", "3"),
+				newSegment("3", "country"),
+			},
+			usrContext:     map[string]interface{}{"name": "John", "age": 30},
+			expectedResult: false,
+		},
+		{
+			name: "doesn't populate segments in a cycle",
+			segments: flaggio.SegmentList{
+				newSegment("1", "name", "2"),
+				newSegment("2", "age", "1"),
+			},
+			usrContext:     map[string]interface{}{"name": "John", "age": 30},
+			expectedResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.segments.Populate()
+			result, err := tt.segments[0].Validate(tt.usrContext)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestNewSegmentUsages(t *testing.T) {
+	t.Parallel()
+	rl1 := &flaggio.FlagRule{Rule: flaggio.Rule{ID: "r1", Constraints: []*flaggio.Constraint{
+		{Operation: flaggio.OperationIsInSegment, Values: []interface{}{"1"}},
+	}}}
+	rl2 := &flaggio.FlagRule{Rule: flaggio.Rule{ID: "r2", Constraints: []*flaggio.Constraint{
+		{Operation: flaggio.OperationAny, Constraints: []*flaggio.Constraint{
+			{Operation: flaggio.OperationIsntInSegment, Values: []interface{}{"2", "1"}},
+		}},
+	}}}
+	rl3 := &flaggio.FlagRule{Rule: flaggio.Rule{ID: "r3", Constraints: []*flaggio.Constraint{
+		{Operation: flaggio.OperationIsInSegment, Values: []interface{}{"2"}},
+	}}}
+	flg1 := &flaggio.Flag{ID: "f1", Rules: []*flaggio.FlagRule{rl1, rl2, rl3}}
+	flg2 := &flaggio.Flag{ID: "f2", Rules: []*flaggio.FlagRule{rl3}}
+	assert.Equal(t, []*flaggio.SegmentUsage{
+		{Flag: flg1, Rules: []*flaggio.FlagRule{rl1, rl2}},
+	}, flaggio.NewSegmentUsages([]*flaggio.Flag{flg1, flg2}, "1"))
+	assert.Empty(t, flaggio.NewSegmentUsages([]*flaggio.Flag{flg1, flg2}, "3"))
+}
+
+func TestFindSegmentCycle(t *testing.T) {
+	t.Parallel()
+	refs := map[string][]string{
+		"1": {"2", "4"},
+		"2": {"3"},
+		"3": {"1"},
+		"4": {"4"},
+		"5": {"1"},
+	}
+	assert.Equal(t, []string{"1", "2", "3", "1"}, flaggio.FindSegmentCycle(refs, "1"))
+	assert.Equal(t, []string{"4", "4"}, flaggio.FindSegmentCycle(refs, "4"))
+	assert.Nil(t, flaggio.FindSegmentCycle(refs, "5"))
+	assert.EqualError(t,
+		flaggio.SegmentCycleError([]string{"1", "2", "1"}, map[string]string{"1": "staff", "2": "beta"}),
+		"bad request: segments can't reference each other in a cycle: staff -> beta -> staff")
+}
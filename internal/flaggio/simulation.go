@@ -0,0 +1,236 @@
+package flaggio
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/uw-labs/flaggio/internal/errors"
+)
+
+// FlagSimulation is the impact that proposed changes to a flag would have
+// on the users that were evaluated before.
+type FlagSimulation struct {
+	Users         int
+	TotalUsers    int
+	AffectedUsers int
+	SampleUserIDs []string
+}
+
+// ProposeFlag returns a copy of the flag with the proposed changes applied, so
+// it can be evaluated without saving them. Only the changes to how the flag is
+// evaluated are applied. When rules are proposed, they replace all the rules
+// of the flag.
+func ProposeFlag(flg *Flag, proposed FlagProposal) (*Flag, error) {
+	prpsd := *flg
+	if input := proposed.Flag; input != nil {
+		if input.Enabled != nil {
+			prpsd.Enabled = *input.Enabled
+		}
+		if input.DefaultVariantWhenOn != nil {
+			vrnt, err := flg.FindVariant(*input.DefaultVariantWhenOn)
+			if err != nil {
+				return nil, err
+			}
+			prpsd.DefaultVariantWhenOn = vrnt
+		}
+		if input.DefaultVariantWhenOff != nil {
+			vrnt, err := flg.FindVariant(*input.DefaultVariantWhenOff)
+			if err != nil {
+				return nil, err
+			}
+			prpsd.DefaultVariantWhenOff = vrnt
+		}
+	}
+	if proposed.Rules != nil {
+		prpsd.Rules = make([]*FlagRule, len(proposed.Rules))
+		for idx, rl := range proposed.Rules {
+			cnstrnts, err := proposeConstraints(rl.Constraints)
+			if err != nil {
+				return nil, err
+			}
+			dstrbtns := make([]*Distribution, len(rl.Distributions))
+			for i, dstrbtn := range rl.Distributions {
+				vrnt, err := flg.FindVariant(dstrbtn.VariantID)
+				if err != nil {
+					return nil, err
+				}
+				dstrbtns[i] = &Distribution{Variant: vrnt, Percentage: dstrbtn.Percentage}
+			}
+			prpsd.Rules[idx] = &FlagRule{
+				Rule: Rule{
+					Name:        rl.Name,
+					Description: rl.Description,
+					Disabled:    rl.Enabled != nil && !*rl.Enabled,
+					Constraints: cnstrnts,
+				},
+				Distributions: dstrbtns,
+			}
+		}
+	}
+	return &prpsd, nil
+}
+
+// ProposeFlagRule returns a copy of the flag with the proposed changes to one
+// of its rules applied, the same way ProposeFlag does.
+func ProposeFlagRule(flg *Flag, id string, input UpdateFlagRule) (*Flag, error) {
+	rl, err := flg.FindRule(id)
+	if err != nil {
+		return nil, err
+	}
+	prpsd, err := ProposeFlag(flg, FlagProposal{Rules: []*NewFlagRule{(*NewFlagRule)(&input)}})
+	if err != nil {
+		return nil, err
+	}
+	prpsdRule := prpsd.Rules[0]
+	prpsdRule.ID = rl.ID
+	if input.Name == nil {
+		prpsdRule.Name = rl.Name
+	}
+	if input.Description == nil {
+		prpsdRule.Description = rl.Description
+	}
+	if input.Enabled == nil {
+		prpsdRule.Disabled = rl.Disabled
+	}
+	prpsd.Rules = make([]*FlagRule, len(flg.Rules))
+	for idx, current := range flg.Rules {
+		prpsd.Rules[idx] = current
+		if current.ID == id {
+			prpsd.Rules[idx] = prpsdRule
+		}
+	}
+	return prpsd, nil
+}
+
+func proposeConstraints(cnstrnts []*NewConstraint) ([]*Constraint, error) {
+	constraints := make([]*Constraint, len(cnstrnts))
+	for idx, c := range cnstrnts {
+		if c.Operation.IsGroup() && len(c.Constraints) == 0 {
+			return nil, errors.BadRequest(fmt.Sprintf("constraint group %s must have nested constraints", c.Operation))
+		}
+		if !c.Operation.IsGroup() && len(c.Constraints) > 0 {
+			return nil, errors.BadRequest(fmt.Sprintf("operation %s can't have nested constraints", c.Operation))
+		}
+		if err := c.Operation.ValidateValues(c.Values); err != nil {
+			return nil, err
+		}
+		nested, err := proposeConstraints(c.Constraints)
+		if err != nil {
+			return nil, err
+		}
+		if len(nested) == 0 {
+			nested = nil
+		}
+		var property string
+		if c.Property != nil {
+			property = *c.Property
+		}
+		// values are copied, as segment references are replaced when populated
+		constraints[idx] = &Constraint{
+			Property:    property,
+			Operation:   c.Operation,
+			Values:      append([]interface{}{}, c.Values...),
+			Constraints: nested,
+		}
+	}
+	return constraints, nil
+}
+
+// SimulateFlag evaluates the users with both the current and the proposed
+// flag, and counts the users that would be served something different. Users
+// matching a rule that splits them between variants are only affected if the
+// split changes, since the variant they get is picked at random. Up to
+// sampleSize IDs of affected users are returned. Both flags are part of the
+// same exclusion group, if any.
+func SimulateFlag(
+	current, proposed *Flag,
+	sgmnts []*Segment,
+	grps []*ExclusionGroup,
+	usrs []*User,
+	sampleSize int,
+) *FlagSimulation {
+	simulator := NewFlagSimulator(current, proposed, sgmnts, grps, sampleSize)
+	simulator.Simulate(usrs)
+	sim := simulator.Result()
+	sim.TotalUsers = len(usrs)
+	return sim
+}
+
+// FlagSimulator simulates a flag change the same way SimulateFlag does, but
+// for users given in batches, so they don't need to be loaded all at once.
+type FlagSimulator struct {
+	current, proposed *Flag
+	sampleSize        int
+	sim               *FlagSimulation
+}
+
+// NewFlagSimulator returns a simulator of the change from the current to
+// the proposed flag.
+func NewFlagSimulator(
+	current, proposed *Flag,
+	sgmnts []*Segment,
+	grps []*ExclusionGroup,
+	sampleSize int,
+) *FlagSimulator {
+	// segments can reference other segments
+	SegmentList(sgmnts).Populate()
+	iders := make([]Identifier, 0, len(sgmnts)+len(grps))
+	for _, sgmnt := range sgmnts {
+		iders = append(iders, sgmnt)
+	}
+	for _, grp := range grps {
+		iders = append(iders, grp)
+	}
+	current.Populate(iders)
+	proposed.Populate(iders)
+
+	return &FlagSimulator{
+		current:    current,
+		proposed:   proposed,
+		sampleSize: sampleSize,
+		sim:        &FlagSimulation{SampleUserIDs: []string{}},
+	}
+}
+
+// Simulate evaluates a batch of users.
+func (s *FlagSimulator) Simulate(usrs []*User) {
+	s.sim.Users += len(usrs)
+	for _, usr := range usrs {
+		if reflect.DeepEqual(simulationOutcome(s.current, usr.Context), simulationOutcome(s.proposed, usr.Context)) {
+			continue
+		}
+		s.sim.AffectedUsers++
+		if len(s.sim.SampleUserIDs) < s.sampleSize {
+			s.sim.SampleUserIDs = append(s.sim.SampleUserIDs, usr.ID)
+		}
+	}
+}
+
+// Result returns the simulation of the users evaluated so far. The total of
+// users is left to the caller, since it may include users that were not
+// evaluated.
+func (s *FlagSimulator) Result() *FlagSimulation {
+	return s.sim
+}
+
+// simulationOutcome returns the chance of each variant being served to the
+// user, or nil if the flag can't be evaluated.
+func simulationOutcome(flg *Flag, usrContext map[string]interface{}) map[string]int {
+	res, err := Evaluate(usrContext, flg)
+	if err != nil {
+		return nil
+	}
+	if rl := res.Rule(); rl != nil {
+		outcome := make(map[string]int, len(rl.Distributions))
+		for _, dstrbtn := range rl.Distributions {
+			if dstrbtn.Percentage > 0 && dstrbtn.Variant != nil {
+				outcome[dstrbtn.Variant.ID] += dstrbtn.Percentage
+			}
+		}
+		return outcome
+	}
+	if res.Variant == nil {
+		return nil
+	}
+	return map[string]int{res.Variant.ID: 100}
+}
@@ -0,0 +1,221 @@
+package flaggio_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+func TestProposeFlag(t *testing.T) {
+	t.Parallel()
+	vrnt1, vrnt2 := &flaggio.Variant{ID: "1"}, &flaggio.Variant{ID: "2"}
+	flg := &flaggio.Flag{
+		Variants:             []*flaggio.Variant{vrnt1, vrnt2},
+		DefaultVariantWhenOn: vrnt1,
+		Rules:                []*flaggio.FlagRule{{Rule: flaggio.Rule{ID: "1"}}},
+	}
+	enabled, whenOn, property := true, "2", "plan"
+
+	prpsd, err := flaggio.ProposeFlag(flg, flaggio.FlagProposal{
+		Flag: &flaggio.UpdateFlag{Enabled: &enabled, DefaultVariantWhenOn: &whenOn},
+		Rules: []*flaggio.NewFlagRule{{
+			Constraints: []*flaggio.NewConstraint{
+				{Property: &property, Operation: flaggio.OperationOneOf, Values: []interface{}{"pro"}},
+			},
+			Distributions: []*flaggio.NewDistribution{{VariantID: "1", Percentage: 100}},
+		}},
+	})
+	assert.NoError(t, err)
+	assert.True(t, prpsd.Enabled)
+	assert.Equal(t, vrnt2, prpsd.DefaultVariantWhenOn)
+	assert.Equal(t, []*flaggio.FlagRule{{
+		Rule: flaggio.Rule{
+			Constraints: []*flaggio.Constraint{
+				{Property: "plan", Operation: flaggio.OperationOneOf, Values: []interface{}{"pro"}},
+			},
+		},
+		Distributions: []*flaggio.Distribution{{Variant: vrnt1, Percentage: 100}},
+	}}, prpsd.Rules)
+	// the current flag is left untouched
+	assert.False(t, flg.Enabled)
+	assert.Equal(t, vrnt1, flg.DefaultVariantWhenOn)
+	assert.Len(t, flg.Rules, 1)
+	assert.Equal(t, "1", flg.Rules[0].ID)
+
+	unknown := "3"
+	_, err = flaggio.ProposeFlag(flg, flaggio.FlagProposal{
+		Flag: &flaggio.UpdateFlag{DefaultVariantWhenOff: &unknown},
+	})
+	assert.EqualError(t, err, "variant: not found")
+
+	_, err = flaggio.ProposeFlag(flg, flaggio.FlagProposal{
+		Rules: []*flaggio.NewFlagRule{{
+			Constraints: []*flaggio.NewConstraint{{Operation: flaggio.OperationAny}},
+		}},
+	})
+	assert.EqualError(t, err, "bad request: constraint group ANY must have nested constraints")
+
+	_, err = flaggio.ProposeFlag(flg, flaggio.FlagProposal{
+		Rules: []*flaggio.NewFlagRule{{
+			Constraints: []*flaggio.NewConstraint{
+				{Property: &property, Operation: flaggio.OperationIsInNetwork, Values: []interface{}{"10.0.0.0/33"}},
+			},
+		}},
+	})
+	assert.EqualError(t, err, "invalid flag: IS_IN_NETWORK has an invalid CIDR \"10.0.0.0/33\"")
+}
+
+func TestProposeFlagRule(t *testing.T) {
+	t.Parallel()
+	vrnt := &flaggio.Variant{ID: "1"}
+	name := "pro users"
+	flg := &flaggio.Flag{
+		Variants: []*flaggio.Variant{vrnt},
+		Rules: []*flaggio.FlagRule{
+			{Rule: flaggio.Rule{ID: "1", Name: &name, Disabled: true}},
+			{Rule: flaggio.Rule{ID: "2"}},
+		},
+	}
+
+	prpsd, err := flaggio.ProposeFlagRule(flg, "1", flaggio.UpdateFlagRule{
+		Distributions: []*flaggio.NewDistribution{{VariantID: "1", Percentage: 50}},
+	})
+	assert.NoError(t, err)
+	// only the constraints and distributions are replaced when not set
+	assert.Equal(t, []*flaggio.FlagRule{
+		{
+			Rule:          flaggio.Rule{ID: "1", Name: &name, Disabled: true, Constraints: []*flaggio.Constraint{}},
+			Distributions: []*flaggio.Distribution{{Variant: vrnt, Percentage: 50}},
+		},
+		flg.Rules[1],
+	}, prpsd.Rules)
+	// the current flag is left untouched
+	assert.Empty(t, flg.Rules[0].Distributions)
+
+	_, err = flaggio.ProposeFlagRule(flg, "3", flaggio.UpdateFlagRule{})
+	assert.EqualError(t, err, "flag rule: not found")
+}
+
+func TestSimulateFlag(t *testing.T) {
+	t.Parallel()
+	vrnt1, vrnt2 := &flaggio.Variant{ID: "1", Value: false}, &flaggio.Variant{ID: "2", Value: true}
+	sgmnt := &flaggio.Segment{
+		ID: "s1",
+		Rules: []*flaggio.SegmentRule{{Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{
+			{Property: "plan", Operation: flaggio.OperationOneOf, Values: []interface{}{"pro"}},
+		}}}},
+	}
+	usrs := []*flaggio.User{
+		{ID: "u1", Context: map[string]interface{}{"plan": "pro", "country": "UK"}},
+		{ID: "u2", Context: map[string]interface{}{"plan": "free", "country": "UK"}},
+		{ID: "u3", Context: map[string]interface{}{"plan": "pro", "country": "BR"}},
+		{ID: "u4", Context: map[string]interface{}{"plan": "pro", "country": "UK"}},
+	}
+	newFlag := func(rls ...*flaggio.FlagRule) *flaggio.Flag {
+		return &flaggio.Flag{
+			Enabled:               true,
+			Variants:              []*flaggio.Variant{vrnt1, vrnt2},
+			DefaultVariantWhenOn:  vrnt1,
+			DefaultVariantWhenOff: vrnt1,
+			Rules:                 rls,
+		}
+	}
+	newRule := func(cnstrnt *flaggio.Constraint, dstrbtns ...*flaggio.Distribution) *flaggio.FlagRule {
+		return &flaggio.FlagRule{
+			Rule:          flaggio.Rule{Constraints: []*flaggio.Constraint{cnstrnt}},
+			Distributions: dstrbtns,
+		}
+	}
+	tests := []struct {
+		name           string
+		current        *flaggio.Flag
+		proposed       *flaggio.Flag
+		sampleSize     int
+		expectedResult *flaggio.FlagSimulation
+	}{
+		{
+			name:     "no changes",
+			current:  newFlag(),
+			proposed: newFlag(),
+			expectedResult: &flaggio.FlagSimulation{
+				Users:         4,
+				TotalUsers:    4,
+				SampleUserIDs: []string{},
+			},
+		},
+		{
+			name:    "users switching variants",
+			current: newFlag(),
+			proposed: newFlag(newRule(
+				&flaggio.Constraint{Operation: flaggio.OperationIsInSegment, Values: []interface{}{"s1"}},
+				&flaggio.Distribution{Variant: vrnt2, Percentage: 100},
+			)),
+			sampleSize: 2,
+			expectedResult: &flaggio.FlagSimulation{
+				Users:         4,
+				TotalUsers:    4,
+				AffectedUsers: 3,
+				SampleUserIDs: []string{"u1", "u3"},
+			},
+		},
+		{
+			name: "users in a split that changes",
+			current: newFlag(newRule(
+				&flaggio.Constraint{Property: "country", Operation: flaggio.OperationOneOf, Values: []interface{}{"UK"}},
+				&flaggio.Distribution{Variant: vrnt1, Percentage: 50},
+				&flaggio.Distribution{Variant: vrnt2, Percentage: 50},
+			)),
+			proposed: newFlag(newRule(
+				&flaggio.Constraint{Property: "country", Operation: flaggio.OperationOneOf, Values: []interface{}{"UK"}},
+				&flaggio.Distribution{Variant: vrnt1, Percentage: 10},
+				&flaggio.Distribution{Variant: vrnt2, Percentage: 90},
+			)),
+			sampleSize: 10,
+			expectedResult: &flaggio.FlagSimulation{
+				Users:         4,
+				TotalUsers:    4,
+				AffectedUsers: 3,
+				SampleUserIDs: []string{"u1", "u2", "u4"},
+			},
+		},
+		{
+			name: "users in a split that doesn't change",
+			current: newFlag(newRule(
+				&flaggio.Constraint{Property: "country", Operation: flaggio.OperationOneOf, Values: []interface{}{"UK"}},
+				&flaggio.Distribution{Variant: vrnt1, Percentage: 50},
+				&flaggio.Distribution{Variant: vrnt2, Percentage: 50},
+			)),
+			proposed: newFlag(newRule(
+				&flaggio.Constraint{Property: "country", Operation: flaggio.OperationOneOf, Values: []interface{}{"UK", "BR"}},
+				&flaggio.Distribution{Variant: vrnt1, Percentage: 50},
+				&flaggio.Distribution{Variant: vrnt2, Percentage: 50},
+			)),
+			sampleSize: 10,
+			expectedResult: &flaggio.FlagSimulation{
+				Users:         4,
+				TotalUsers:    4,
+				AffectedUsers: 1,
+				SampleUserIDs: []string{"u3"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			sgmnts := []*flaggio.Segment{sgmnt}
+			result := flaggio.SimulateFlag(tt.current, tt.proposed, sgmnts, nil, usrs, tt.sampleSize)
+			assert.Equal(t, tt.expectedResult, result)
+
+			// simulating the users in batches gives the same results
+			simulator := flaggio.NewFlagSimulator(tt.current, tt.proposed, sgmnts, nil, tt.sampleSize)
+			simulator.Simulate(usrs[:3])
+			simulator.Simulate(usrs[3:])
+			result = simulator.Result()
+			result.TotalUsers = len(usrs)
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package flaggio
+
+import (
+	"sort"
+	"time"
+)
+
+// FlagUsage holds how many times a flag was evaluated and when each of
+// its variants was last served.
+type FlagUsage struct {
+	FlagKey          string
+	Evaluations      int
+	FirstEvaluatedAt time.Time
+	LastEvaluatedAt  time.Time
+	Variants         []*VariantUsage
+}
+
+// VariantUsage holds when a variant was last served.
+type VariantUsage struct {
+	VariantID    string
+	LastServedAt time.Time
+}
+
+// StaleFlag is a flag that is a candidate for removal.
+type StaleFlag struct {
+	Flag            *Flag
+	Reasons         []StaleReason
+	Evaluations     int
+	LastEvaluatedAt *time.Time
+}
+
+// NewStaleFlags returns the flags that are stale, given their usage, the
+// amount of days used to check for activity and the current time. A flag
+// is stale if:
+// * it was not evaluated in the last days;
+// * it is temporary and served the same variant to everyone in the last days;
+// * it is past its expiry date.
+func NewStaleFlags(flgs []*Flag, usages []*FlagUsage, days int, now time.Time) []*StaleFlag {
+	usagesMap := make(map[string]*FlagUsage, len(usages))
+	for _, usg := range usages {
+		usagesMap[usg.FlagKey] = usg
+	}
+	since := now.AddDate(0, 0, -days)
+
+	var staleFlags []*StaleFlag
+	for _, flg := range flgs {
+		stlFlg := &StaleFlag{Flag: flg}
+		usg, ok := usagesMap[flg.Key]
+		if ok {
+			lastEvaluatedAt := usg.LastEvaluatedAt
+			stlFlg.Evaluations = usg.Evaluations
+			stlFlg.LastEvaluatedAt = &lastEvaluatedAt
+		}
+		switch {
+		case !ok && flg.CreatedAt.Before(since), ok && usg.LastEvaluatedAt.Before(since):
+			stlFlg.Reasons = append(stlFlg.Reasons, StaleReasonNotEvaluated)
+		case ok && flg.Kind != FlagKindPermanent &&
+			!usg.FirstEvaluatedAt.After(since) && usg.variantsServedSince(since) == 1:
+			stlFlg.Reasons = append(stlFlg.Reasons, StaleReasonSingleVariant)
+		}
+		if flg.ExpiresAt != nil && flg.ExpiresAt.Before(now) {
+			stlFlg.Reasons = append(stlFlg.Reasons, StaleReasonExpired)
+		}
+		if len(stlFlg.Reasons) > 0 {
+			staleFlags = append(staleFlags, stlFlg)
+		}
+	}
+	// least recently evaluated flags first
+	sort.SliceStable(staleFlags, func(i, j int) bool {
+		a, b := staleFlags[i].LastEvaluatedAt, staleFlags[j].LastEvaluatedAt
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+		return a.Before(*b)
+	})
+	return staleFlags
+}
+
+func (u *FlagUsage) variantsServedSince(since time.Time) int {
+	count := 0
+	for _, vrntUsg := range u.Variants {
+		if !vrntUsg.LastServedAt.Before(since) {
+			count++
+		}
+	}
+	return count
+}
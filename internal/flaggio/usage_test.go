@@ -0,0 +1,115 @@
+package flaggio_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+func TestNewStaleFlags(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) time.Time {
+		return now.AddDate(0, 0, -days)
+	}
+	expired := daysAgo(1)
+	notExpired := now.AddDate(0, 0, 1)
+
+	tests := []struct {
+		name            string
+		flg             *flaggio.Flag
+		usage           *flaggio.FlagUsage
+		expectedReasons []flaggio.StaleReason
+	}{
+		{
+			name: "new flags that were never evaluated are not stale",
+			flg:  &flaggio.Flag{Key: "a", CreatedAt: daysAgo(1)},
+		},
+		{
+			name:            "old flags that were never evaluated are stale",
+			flg:             &flaggio.Flag{Key: "a", CreatedAt: daysAgo(40)},
+			expectedReasons: []flaggio.StaleReason{flaggio.StaleReasonNotEvaluated},
+		},
+		{
+			name: "flags not evaluated recently are stale",
+			flg:  &flaggio.Flag{Key: "a", CreatedAt: daysAgo(100)},
+			usage: &flaggio.FlagUsage{
+				FlagKey: "a", Evaluations: 10, FirstEvaluatedAt: daysAgo(90), LastEvaluatedAt: daysAgo(31),
+			},
+			expectedReasons: []flaggio.StaleReason{flaggio.StaleReasonNotEvaluated},
+		},
+		{
+			name: "flags serving multiple variants are not stale",
+			flg:  &flaggio.Flag{Key: "a", CreatedAt: daysAgo(100)},
+			usage: &flaggio.FlagUsage{
+				FlagKey: "a", Evaluations: 10, FirstEvaluatedAt: daysAgo(90), LastEvaluatedAt: daysAgo(1),
+				Variants: []*flaggio.VariantUsage{
+					{VariantID: "1", LastServedAt: daysAgo(1)},
+					{VariantID: "2", LastServedAt: daysAgo(2)},
+				},
+			},
+		},
+		{
+			name: "temporary flags serving a single variant are stale",
+			flg:  &flaggio.Flag{Key: "a", Kind: flaggio.FlagKindTemporary, CreatedAt: daysAgo(100)},
+			usage: &flaggio.FlagUsage{
+				FlagKey: "a", Evaluations: 10, FirstEvaluatedAt: daysAgo(90), LastEvaluatedAt: daysAgo(1),
+				Variants: []*flaggio.VariantUsage{
+					{VariantID: "1", LastServedAt: daysAgo(1)},
+					{VariantID: "2", LastServedAt: daysAgo(31)},
+				},
+			},
+			expectedReasons: []flaggio.StaleReason{flaggio.StaleReasonSingleVariant},
+		},
+		{
+			name: "flags serving a single variant for less than the days are not stale",
+			flg:  &flaggio.Flag{Key: "a", Kind: flaggio.FlagKindTemporary, CreatedAt: daysAgo(10)},
+			usage: &flaggio.FlagUsage{
+				FlagKey: "a", Evaluations: 10, FirstEvaluatedAt: daysAgo(10), LastEvaluatedAt: daysAgo(1),
+				Variants: []*flaggio.VariantUsage{{VariantID: "1", LastServedAt: daysAgo(1)}},
+			},
+		},
+		{
+			name: "permanent flags serving a single variant are not stale",
+			flg:  &flaggio.Flag{Key: "a", Kind: flaggio.FlagKindPermanent, CreatedAt: daysAgo(100)},
+			usage: &flaggio.FlagUsage{
+				FlagKey: "a", Evaluations: 10, FirstEvaluatedAt: daysAgo(90), LastEvaluatedAt: daysAgo(1),
+				Variants: []*flaggio.VariantUsage{{VariantID: "1", LastServedAt: daysAgo(1)}},
+			},
+		},
+		{
+			name:            "expired flags are stale",
+			flg:             &flaggio.Flag{Key: "a", CreatedAt: daysAgo(40), ExpiresAt: &expired},
+			expectedReasons: []flaggio.StaleReason{flaggio.StaleReasonNotEvaluated, flaggio.StaleReasonExpired},
+		},
+		{
+			name: "flags that didn't expire yet are not stale",
+			flg:  &flaggio.Flag{Key: "a", CreatedAt: daysAgo(1), ExpiresAt: &notExpired},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var usages []*flaggio.FlagUsage
+			if tt.usage != nil {
+				usages = append(usages, tt.usage)
+			}
+			staleFlags := flaggio.NewStaleFlags([]*flaggio.Flag{tt.flg}, usages, 30, now)
+			if tt.expectedReasons == nil {
+				assert.Empty(t, staleFlags)
+				return
+			}
+			assert.Len(t, staleFlags, 1)
+			assert.Equal(t, tt.flg, staleFlags[0].Flag)
+			assert.Equal(t, tt.expectedReasons, staleFlags[0].Reasons)
+			if tt.usage != nil {
+				assert.Equal(t, tt.usage.Evaluations, staleFlags[0].Evaluations)
+				assert.Equal(t, &tt.usage.LastEvaluatedAt, staleFlags[0].LastEvaluatedAt)
+			}
+		})
+	}
+}
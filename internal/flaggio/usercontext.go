@@ -1,37 +1,91 @@
 package flaggio
 
 import (
+	"bytes"
 	"encoding/json"
-	"strconv"
 )
 
 var _ json.Unmarshaler = (*UserContext)(nil)
 
 // UserContext is a map of strings and one of:
-// int64, float64, bool, string
+// int64, float64, bool, string, nil, []interface{} or map[string]interface{}.
+// Lists and objects hold values of the same types.
 type UserContext map[string]interface{}
 
-// UnmarshalJSON unmarshals the bytes into UserContext
-func (a UserContext) UnmarshalJSON(b []byte) error {
-	data := make(map[string]json.RawMessage)
-	if err := json.Unmarshal(b, &data); err != nil {
+// UnmarshalJSON unmarshals the bytes into UserContext. Values keep the
+// type they have on the JSON, so strings are never converted to numbers.
+// Integer numbers are unmarshalled as int64, all other numbers as float64.
+func (a *UserContext) UnmarshalJSON(b []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	data := make(map[string]interface{})
+	if err := dec.Decode(&data); err != nil {
 		return err
 	}
+	if *a == nil {
+		*a = make(UserContext, len(data))
+	}
 	for k, v := range data {
-		strV := string(v)
-		if n, err := strconv.ParseInt(strV, 10, 64); err == nil {
-			a[k] = n
-		} else if n, err := strconv.ParseFloat(strV, 64); err == nil {
-			a[k] = n
-		} else if b, err := strconv.ParseBool(strV); err == nil {
-			a[k] = b
-		} else {
-			// everything else is treated as a string, even null.
-			// strings will still be quoted on the json.RawMessage so we
-			// try to unmarshal them. it will fail for objects and arrays
-			// in that case, ignore the error and return the raw string
-			_ = json.Unmarshal(v, &strV)
-			a[k] = strV
+		(*a)[k] = typedValue(v)
+	}
+	return nil
+}
+
+// NewUserContext returns a copy of values decoded from JSON as a user
+// context, with numbers converted the same way UnmarshalJSON does.
+func NewUserContext(values map[string]interface{}) UserContext {
+	uc := make(UserContext, len(values))
+	for k, v := range values {
+		uc[k] = typedValue(v)
+	}
+	return uc
+}
+
+// typedValue converts json numbers to int64 or float64, recursively. Lists
+// and objects are copied, so the original value is left untouched.
+func typedValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		n, _ := v.Float64()
+		return n
+	case []interface{}:
+		typed := make([]interface{}, len(v))
+		for idx, elem := range v {
+			typed[idx] = typedValue(elem)
+		}
+		return typed
+	case map[string]interface{}:
+		typed := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			typed[key] = typedValue(elem)
+		}
+		return typed
+	default:
+		return v
+	}
+}
+
+// lookup returns the value of the property from the user context. Values
+// from nested objects can be addressed using a dotted path, like
+// "company.plan". A property that contains dots is matched first.
+func lookup(usrContext map[string]interface{}, property string) interface{} {
+	if value, ok := usrContext[property]; ok {
+		return value
+	}
+	// try every dot as the separator between an object and its property
+	for idx := 0; idx < len(property); idx++ {
+		if property[idx] != '.' {
+			continue
+		}
+		nested, ok := usrContext[property[:idx]].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if value := lookup(nested, property[idx+1:]); value != nil {
+			return value
 		}
 	}
 	return nil
@@ -13,21 +13,59 @@ func TestUserContext_UnmarshalJSON(t *testing.T) {
 	ucJSON := []byte(`
 	{
 		"string": "value",
+		"numeric string": "123",
 		"int": 1,
 		"float": 2.5,
 		"bool": true,
 		"null": null,
-		"object": {},
-		"array": []
+		"object": {"plan": "pro", "seats": 10},
+		"array": ["beta", 2, 2.5, {"a": true}]
 	}`)
 	uc := make(flaggio.UserContext)
 	err := json.Unmarshal(ucJSON, &uc)
 	assert.NoError(t, err)
 	assert.Equal(t, "value", uc["string"])
+	assert.Equal(t, "123", uc["numeric string"])
 	assert.Equal(t, int64(1), uc["int"])
 	assert.Equal(t, float64(2.5), uc["float"])
 	assert.Equal(t, true, uc["bool"])
-	assert.Equal(t, "null", uc["null"])
-	assert.Equal(t, "{}", uc["object"])
-	assert.Equal(t, "[]", uc["array"])
+	assert.Nil(t, uc["null"])
+	assert.Contains(t, uc, "null")
+	assert.Equal(t, map[string]interface{}{"plan": "pro", "seats": int64(10)}, uc["object"])
+	assert.Equal(t, []interface{}{"beta", int64(2), 2.5, map[string]interface{}{"a": true}}, uc["array"])
+}
+
+func TestNewUserContext(t *testing.T) {
+	t.Parallel()
+	values := map[string]interface{}{
+		"int":    json.Number("1"),
+		"float":  json.Number("2.5"),
+		"string": "123",
+		"object": map[string]interface{}{"seats": json.Number("10")},
+		"array":  []interface{}{json.Number("2")},
+	}
+	uc := flaggio.NewUserContext(values)
+	assert.Equal(t, flaggio.UserContext{
+		"int":    int64(1),
+		"float":  2.5,
+		"string": "123",
+		"object": map[string]interface{}{"seats": int64(10)},
+		"array":  []interface{}{int64(2)},
+	}, uc)
+
+	// the values are copied
+	uc["$userId"] = "john"
+	assert.NotContains(t, values, "$userId")
+	assert.Equal(t, json.Number("10"), values["object"].(map[string]interface{})["seats"])
+	assert.Equal(t, json.Number("2"), values["array"].([]interface{})[0])
+}
+
+func TestUserContext_UnmarshalJSONNil(t *testing.T) {
+	t.Parallel()
+	var req struct {
+		Context flaggio.UserContext `json:"context"`
+	}
+	err := json.Unmarshal([]byte(`{"context": {"int": 1}}`), &req)
+	assert.NoError(t, err)
+	assert.Equal(t, flaggio.UserContext{"int": int64(1)}, req.Context)
 }
@@ -0,0 +1,80 @@
+package flaggio
+
+import (
+	"time"
+)
+
+var _ Identifier = (*Webhook)(nil)
+
+// Webhook is an endpoint that gets notified when the configuration changes.
+type Webhook struct {
+	ID  string
+	URL string
+	// Secret is used to sign the payloads sent to the webhook.
+	Secret string
+	// EntityTypes filters the changes the webhook is notified about.
+	// An empty list matches all entity types.
+	EntityTypes []EntityType
+	// FlagKeys filters the flags the webhook is notified about. An empty
+	// list matches all flags and segments, otherwise segment changes are
+	// not notified.
+	FlagKeys  []string
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
+
+// GetID returns the webhook ID.
+func (w *Webhook) GetID() string {
+	return w.ID
+}
+
+// Matches returns true if the webhook should be notified about a change
+// to an entity type, for a given flag key. Changes that don't belong to
+// a flag have an empty flag key.
+func (w *Webhook) Matches(entityType EntityType, flagKey string) bool {
+	if !w.Enabled {
+		return false
+	}
+	if len(w.EntityTypes) > 0 && !containsEntityType(w.EntityTypes, entityType) {
+		return false
+	}
+	if len(w.FlagKeys) > 0 && !containsString(w.FlagKeys, flagKey) {
+		return false
+	}
+	return true
+}
+
+// WebhookDelivery is the record of a change notification sent to a webhook.
+type WebhookDelivery struct {
+	ID         string
+	WebhookID  string
+	EntityType EntityType
+	EntityID   string
+	Action     ChangeAction
+	Payload    string
+	Attempts   int
+	StatusCode *int
+	Error      *string
+	Delivered  bool
+	CreatedAt  time.Time
+	UpdatedAt  *time.Time
+}
+
+func containsEntityType(list []EntityType, entityType EntityType) bool {
+	for _, et := range list {
+		if et == entityType {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
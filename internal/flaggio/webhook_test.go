@@ -0,0 +1,81 @@
+package flaggio_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+func TestWebhook_Matches(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		webhook    flaggio.Webhook
+		entityType flaggio.EntityType
+		flagKey    string
+		expected   bool
+	}{
+		{
+			name:       "doesn't match when disabled",
+			webhook:    flaggio.Webhook{Enabled: false},
+			entityType: flaggio.EntityTypeFlag,
+			flagKey:    "a",
+			expected:   false,
+		},
+		{
+			name:       "matches everything without filters",
+			webhook:    flaggio.Webhook{Enabled: true},
+			entityType: flaggio.EntityTypeSegment,
+			expected:   true,
+		},
+		{
+			name: "matches the entity type",
+			webhook: flaggio.Webhook{
+				Enabled:     true,
+				EntityTypes: []flaggio.EntityType{flaggio.EntityTypeFlag, flaggio.EntityTypeRule},
+			},
+			entityType: flaggio.EntityTypeRule,
+			flagKey:    "a",
+			expected:   true,
+		},
+		{
+			name: "doesn't match other entity types",
+			webhook: flaggio.Webhook{
+				Enabled:     true,
+				EntityTypes: []flaggio.EntityType{flaggio.EntityTypeFlag},
+			},
+			entityType: flaggio.EntityTypeVariant,
+			flagKey:    "a",
+			expected:   false,
+		},
+		{
+			name:       "matches the flag key",
+			webhook:    flaggio.Webhook{Enabled: true, FlagKeys: []string{"a", "b"}},
+			entityType: flaggio.EntityTypeVariant,
+			flagKey:    "b",
+			expected:   true,
+		},
+		{
+			name:       "doesn't match other flag keys",
+			webhook:    flaggio.Webhook{Enabled: true, FlagKeys: []string{"a"}},
+			entityType: flaggio.EntityTypeFlag,
+			flagKey:    "b",
+			expected:   false,
+		},
+		{
+			name:       "doesn't match segments when filtering by flag key",
+			webhook:    flaggio.Webhook{Enabled: true, FlagKeys: []string{"a"}},
+			entityType: flaggio.EntityTypeSegment,
+			expected:   false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.webhook.Matches(tt.entityType, tt.flagKey))
+		})
+	}
+}
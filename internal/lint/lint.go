@@ -0,0 +1,216 @@
+// Package lint checks flag configurations for problems that make them fail
+// or misbehave when evaluated.
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+	"regexp"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+// Flag returns the issues found in the configuration of the flag. The list of
+// segments is used to find references to segments that don't exist.
+func Flag(flg *flaggio.Flag, sgmnts []*flaggio.Segment) []*flaggio.LintIssue {
+	l := &linter{
+		variants: make(map[string]struct{}, len(flg.Variants)),
+		segments: make(map[string]struct{}, len(sgmnts)),
+	}
+	for _, vrnt := range flg.Variants {
+		l.variants[vrnt.ID] = struct{}{}
+	}
+	for _, sgmnt := range sgmnts {
+		l.segments[sgmnt.ID] = struct{}{}
+	}
+
+	if flg.DefaultVariantWhenOn == nil {
+		l.add(nil, flaggio.LintSeverityError, flaggio.LintCodeMissingDefaultVariant,
+			"flag has no default variant when it's on")
+	} else {
+		l.checkVariant(nil, flg.DefaultVariantWhenOn)
+	}
+	if flg.DefaultVariantWhenOff == nil {
+		l.add(nil, flaggio.LintSeverityError, flaggio.LintCodeMissingDefaultVariant,
+			"flag has no default variant when it's off")
+	} else {
+		l.checkVariant(nil, flg.DefaultVariantWhenOff)
+	}
+
+	var catchAll bool
+	for _, rl := range flg.Rules {
+		if catchAll && !rl.Disabled {
+			l.add(rl, flaggio.LintSeverityWarning, flaggio.LintCodeUnreachableRule,
+				"rule is never evaluated, because a previous rule matches every user")
+		}
+		l.checkDistributions(rl)
+		l.checkConstraints(rl, rl.Constraints)
+		if !rl.Disabled && len(rl.Constraints) == 0 {
+			catchAll = true
+		}
+	}
+	return l.issues
+}
+
+type linter struct {
+	variants map[string]struct{}
+	segments map[string]struct{}
+	issues   []*flaggio.LintIssue
+}
+
+func (l *linter) add(rl *flaggio.FlagRule, severity flaggio.LintSeverity, code flaggio.LintCode, format string, args ...interface{}) {
+	issue := &flaggio.LintIssue{
+		Severity: severity,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+	}
+	if rl != nil {
+		ruleID := rl.ID
+		issue.RuleID = &ruleID
+	}
+	l.issues = append(l.issues, issue)
+}
+
+func (l *linter) checkVariant(rl *flaggio.FlagRule, vrnt *flaggio.Variant) {
+	if _, ok := l.variants[vrnt.ID]; !ok {
+		l.add(rl, flaggio.LintSeverityError, flaggio.LintCodeUnknownVariant,
+			"variant %s doesn't exist", vrnt.ID)
+	}
+}
+
+func (l *linter) checkDistributions(rl *flaggio.FlagRule) {
+	var total int
+	for _, dstrbtn := range rl.Distributions {
+		total += dstrbtn.Percentage
+		if dstrbtn.Variant == nil {
+			// the variant was deleted after the distribution was created
+			l.add(rl, flaggio.LintSeverityError, flaggio.LintCodeUnknownVariant,
+				"distribution references a variant that doesn't exist")
+			continue
+		}
+		l.checkVariant(rl, dstrbtn.Variant)
+	}
+	if total != 100 {
+		l.add(rl, flaggio.LintSeverityError, flaggio.LintCodeDistributionSum,
+			"distributions add up to %d%%, instead of 100%%", total)
+	}
+}
+
+func (l *linter) checkConstraints(rl *flaggio.FlagRule, cnstrnts []*flaggio.Constraint) {
+	for idx, cnstrnt := range cnstrnts {
+		for _, prev := range cnstrnts[:idx] {
+			if sameConstraint(prev, cnstrnt) {
+				l.add(rl, flaggio.LintSeverityWarning, flaggio.LintCodeDuplicateConstraint,
+					"%s constraint is duplicated", constraintName(cnstrnt))
+				break
+			}
+		}
+		if cnstrnt.Operation.IsGroup() {
+			l.checkConstraints(rl, cnstrnt.Constraints)
+			continue
+		}
+		for _, v := range cnstrnt.Values {
+			l.checkValue(rl, cnstrnt, v)
+		}
+	}
+}
+
+func (l *linter) checkValue(rl *flaggio.FlagRule, cnstrnt *flaggio.Constraint, value interface{}) {
+	switch cnstrnt.Operation {
+	case flaggio.OperationGreater, flaggio.OperationGreaterOrEqual,
+		flaggio.OperationLower, flaggio.OperationLowerOrEqual:
+		if !isNumber(value) {
+			l.invalidValueType(rl, cnstrnt, value, "a number")
+		}
+	case flaggio.OperationContains, flaggio.OperationDoesntContain,
+		flaggio.OperationStartsWith, flaggio.OperationDoesntStartWith,
+		flaggio.OperationEndsWith, flaggio.OperationDoesntEndWith:
+		if _, ok := toString(value); !ok {
+			l.invalidValueType(rl, cnstrnt, value, "a string")
+		}
+	case flaggio.OperationMatchesRegex, flaggio.OperationDoesntMatchRegex:
+		str, ok := toString(value)
+		if !ok {
+			l.invalidValueType(rl, cnstrnt, value, "a string")
+			return
+		}
+		if _, err := regexp.Compile(str); err != nil {
+			l.add(rl, flaggio.LintSeverityError, flaggio.LintCodeInvalidRegex,
+				"%s constraint has an invalid regex %q: %s", constraintName(cnstrnt), str, err)
+		}
+	case flaggio.OperationIsInNetwork:
+		str, ok := value.(string)
+		if !ok {
+			l.invalidValueType(rl, cnstrnt, value, "a string")
+			return
+		}
+		if _, _, err := net.ParseCIDR(str); err != nil {
+			l.add(rl, flaggio.LintSeverityError, flaggio.LintCodeInvalidCidr,
+				"%s constraint has an invalid CIDR %q", constraintName(cnstrnt), str)
+		}
+	case flaggio.OperationIsInSegment, flaggio.OperationIsntInSegment:
+		var id string
+		switch v := value.(type) {
+		case string:
+			id = v
+		case flaggio.Identifier:
+			id = v.GetID()
+		default:
+			l.invalidValueType(rl, cnstrnt, value, "a segment ID")
+			return
+		}
+		if _, ok := l.segments[id]; !ok {
+			l.add(rl, flaggio.LintSeverityError, flaggio.LintCodeUnknownSegment,
+				"segment %s doesn't exist", id)
+		}
+	}
+}
+
+func (l *linter) invalidValueType(rl *flaggio.FlagRule, cnstrnt *flaggio.Constraint, value interface{}, expected string) {
+	l.add(rl, flaggio.LintSeverityError, flaggio.LintCodeInvalidValueType,
+		"%s constraint value %v must be %s", constraintName(cnstrnt), value, expected)
+}
+
+// sameConstraint returns true if both constraints check the same values,
+// regardless of their IDs.
+func sameConstraint(c1, c2 *flaggio.Constraint) bool {
+	if c1.Property != c2.Property || c1.Operation != c2.Operation ||
+		!reflect.DeepEqual(c1.Values, c2.Values) || len(c1.Constraints) != len(c2.Constraints) {
+		return false
+	}
+	for idx := range c1.Constraints {
+		if !sameConstraint(c1.Constraints[idx], c2.Constraints[idx]) {
+			return false
+		}
+	}
+	return true
+}
+
+func constraintName(cnstrnt *flaggio.Constraint) string {
+	if cnstrnt.Operation.IsGroup() {
+		return string(cnstrnt.Operation)
+	}
+	return fmt.Sprintf("%s %s", cnstrnt.Property, cnstrnt.Operation)
+}
+
+func isNumber(value interface{}) bool {
+	switch value.(type) {
+	case int, int32, int64, uint, uint32, uint64, float32, float64, json.Number:
+		return true
+	default:
+		return false
+	}
+}
+
+func toString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	default:
+		return "", false
+	}
+}
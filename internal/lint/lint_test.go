@@ -0,0 +1,207 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/lint"
+)
+
+func TestFlag(t *testing.T) {
+	t.Parallel()
+	vrnt1, vrnt2 := &flaggio.Variant{ID: "v1"}, &flaggio.Variant{ID: "v2"}
+	sgmnts := []*flaggio.Segment{{ID: "s1"}}
+	rule := func(id string, cnstrnts []*flaggio.Constraint, dstrbtns ...*flaggio.Distribution) *flaggio.FlagRule {
+		if dstrbtns == nil {
+			dstrbtns = []*flaggio.Distribution{{Variant: vrnt1, Percentage: 100}}
+		}
+		return &flaggio.FlagRule{
+			Rule:          flaggio.Rule{ID: id, Constraints: cnstrnts},
+			Distributions: dstrbtns,
+		}
+	}
+	flag := func(rls ...*flaggio.FlagRule) *flaggio.Flag {
+		return &flaggio.Flag{
+			Variants:              []*flaggio.Variant{vrnt1, vrnt2},
+			DefaultVariantWhenOn:  vrnt1,
+			DefaultVariantWhenOff: vrnt2,
+			Rules:                 rls,
+		}
+	}
+	ruleID := func(id string) *string {
+		return &id
+	}
+	tests := []struct {
+		name           string
+		flag           *flaggio.Flag
+		expectedIssues []*flaggio.LintIssue
+	}{
+		{
+			name: "no issues",
+			flag: flag(
+				rule("1", []*flaggio.Constraint{
+					{Property: "age", Operation: flaggio.OperationGreater, Values: []interface{}{int64(18)}},
+					{Property: "email", Operation: flaggio.OperationMatchesRegex, Values: []interface{}{".+@flaggio.com"}},
+					{Property: "ip", Operation: flaggio.OperationIsInNetwork, Values: []interface{}{"10.0.0.0/8"}},
+					{Operation: flaggio.OperationIsInSegment, Values: []interface{}{"s1"}},
+				}),
+				rule("2", nil,
+					&flaggio.Distribution{Variant: vrnt1, Percentage: 60},
+					&flaggio.Distribution{Variant: vrnt2, Percentage: 40}),
+			),
+		},
+		{
+			name: "missing default variants",
+			flag: &flaggio.Flag{},
+			expectedIssues: []*flaggio.LintIssue{
+				{
+					Severity: flaggio.LintSeverityError,
+					Code:     flaggio.LintCodeMissingDefaultVariant,
+					Message:  "flag has no default variant when it's on",
+				},
+				{
+					Severity: flaggio.LintSeverityError,
+					Code:     flaggio.LintCodeMissingDefaultVariant,
+					Message:  "flag has no default variant when it's off",
+				},
+			},
+		},
+		{
+			name: "distributions don't add up to 100",
+			flag: flag(rule("1", nil,
+				&flaggio.Distribution{Variant: vrnt1, Percentage: 60},
+				&flaggio.Distribution{Variant: vrnt2, Percentage: 30})),
+			expectedIssues: []*flaggio.LintIssue{{
+				Severity: flaggio.LintSeverityError,
+				Code:     flaggio.LintCodeDistributionSum,
+				Message:  "distributions add up to 90%, instead of 100%",
+				RuleID:   ruleID("1"),
+			}},
+		},
+		{
+			name: "deleted variants",
+			flag: flag(rule("1", nil,
+				&flaggio.Distribution{Percentage: 50},
+				&flaggio.Distribution{Variant: &flaggio.Variant{ID: "v3"}, Percentage: 50})),
+			expectedIssues: []*flaggio.LintIssue{
+				{
+					Severity: flaggio.LintSeverityError,
+					Code:     flaggio.LintCodeUnknownVariant,
+					Message:  "distribution references a variant that doesn't exist",
+					RuleID:   ruleID("1"),
+				},
+				{
+					Severity: flaggio.LintSeverityError,
+					Code:     flaggio.LintCodeUnknownVariant,
+					Message:  "variant v3 doesn't exist",
+					RuleID:   ruleID("1"),
+				},
+			},
+		},
+		{
+			name: "deleted segments",
+			flag: flag(rule("1", []*flaggio.Constraint{
+				{Operation: flaggio.OperationIsntInSegment, Values: []interface{}{"s1", "s2"}},
+			})),
+			expectedIssues: []*flaggio.LintIssue{{
+				Severity: flaggio.LintSeverityError,
+				Code:     flaggio.LintCodeUnknownSegment,
+				Message:  "segment s2 doesn't exist",
+				RuleID:   ruleID("1"),
+			}},
+		},
+		{
+			name: "invalid regexes and CIDRs",
+			flag: flag(rule("1", []*flaggio.Constraint{
+				{Property: "email", Operation: flaggio.OperationDoesntMatchRegex, Values: []interface{}{"[a-z"}},
+				{Property: "ip", Operation: flaggio.OperationIsInNetwork, Values: []interface{}{"10.0.0.0/33"}},
+			})),
+			expectedIssues: []*flaggio.LintIssue{
+				{
+					Severity: flaggio.LintSeverityError,
+					Code:     flaggio.LintCodeInvalidRegex,
+					Message:  "email DOESNT_MATCH_REGEX constraint has an invalid regex \"[a-z\": error parsing regexp: missing closing ]: `[a-z`",
+					RuleID:   ruleID("1"),
+				},
+				{
+					Severity: flaggio.LintSeverityError,
+					Code:     flaggio.LintCodeInvalidCidr,
+					Message:  "ip IS_IN_NETWORK constraint has an invalid CIDR \"10.0.0.0/33\"",
+					RuleID:   ruleID("1"),
+				},
+			},
+		},
+		{
+			name: "values that don't suit the operator",
+			flag: flag(rule("1", []*flaggio.Constraint{
+				{Property: "age", Operation: flaggio.OperationLowerOrEqual, Values: []interface{}{"18"}},
+				{Property: "name", Operation: flaggio.OperationStartsWith, Values: []interface{}{int64(1)}},
+			})),
+			expectedIssues: []*flaggio.LintIssue{
+				{
+					Severity: flaggio.LintSeverityError,
+					Code:     flaggio.LintCodeInvalidValueType,
+					Message:  "age LOWER_OR_EQUAL constraint value 18 must be a number",
+					RuleID:   ruleID("1"),
+				},
+				{
+					Severity: flaggio.LintSeverityError,
+					Code:     flaggio.LintCodeInvalidValueType,
+					Message:  "name STARTS_WITH constraint value 1 must be a string",
+					RuleID:   ruleID("1"),
+				},
+			},
+		},
+		{
+			name: "rules after a rule without constraints",
+			flag: flag(
+				rule("1", nil),
+				rule("2", []*flaggio.Constraint{
+					{Property: "name", Operation: flaggio.OperationOneOf, Values: []interface{}{"john"}},
+				}),
+			),
+			expectedIssues: []*flaggio.LintIssue{{
+				Severity: flaggio.LintSeverityWarning,
+				Code:     flaggio.LintCodeUnreachableRule,
+				Message:  "rule is never evaluated, because a previous rule matches every user",
+				RuleID:   ruleID("2"),
+			}},
+		},
+		{
+			name: "disabled rules don't make other rules unreachable",
+			flag: flag(
+				&flaggio.FlagRule{
+					Rule:          flaggio.Rule{ID: "1", Disabled: true},
+					Distributions: []*flaggio.Distribution{{Variant: vrnt1, Percentage: 100}},
+				},
+				rule("2", nil),
+			),
+		},
+		{
+			name: "duplicate constraints",
+			flag: flag(rule("1", []*flaggio.Constraint{
+				{ID: "c1", Property: "name", Operation: flaggio.OperationOneOf, Values: []interface{}{"john"}},
+				{ID: "c2", Property: "name", Operation: flaggio.OperationOneOf, Values: []interface{}{"jane"}},
+				{Operation: flaggio.OperationAny, Constraints: []*flaggio.Constraint{
+					{ID: "c3", Property: "name", Operation: flaggio.OperationOneOf, Values: []interface{}{"john"}},
+					{ID: "c4", Property: "name", Operation: flaggio.OperationOneOf, Values: []interface{}{"john"}},
+				}},
+			})),
+			expectedIssues: []*flaggio.LintIssue{{
+				Severity: flaggio.LintSeverityWarning,
+				Code:     flaggio.LintCodeDuplicateConstraint,
+				Message:  "name ONE_OF constraint is duplicated",
+				RuleID:   ruleID("1"),
+			}},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expectedIssues, lint.Flag(tt.flag, sgmnts))
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package operator
+
+import (
+	"container/list"
+	"net"
+	"regexp"
+	"sync"
+)
+
+// cacheSize is how many regexes and networks are kept parsed. When the cache
+// is full, the least recently used value is dropped.
+const cacheSize = 1024
+
+var (
+	regexCache   = newCache(cacheSize)
+	networkCache = newCache(cacheSize)
+)
+
+// CompileRegex compiles the regex, reusing it if it was compiled before.
+func CompileRegex(expr string) (*regexp.Regexp, error) {
+	v, err := regexCache.get(expr, func() (interface{}, error) {
+		return regexp.Compile(expr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*regexp.Regexp), nil
+}
+
+// ParseCIDR parses the network, reusing it if it was parsed before.
+func ParseCIDR(cidr string) (*net.IPNet, error) {
+	v, err := networkCache.get(cidr, func() (interface{}, error) {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		return ipnet, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*net.IPNet), nil
+}
+
+// cache is a concurrency safe LRU cache of parsed values, keyed by the string
+// they were parsed from. Parsing errors are cached as well.
+type cache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type cacheEntry struct {
+	key   string
+	value interface{}
+	err   error
+}
+
+func newCache(size int) *cache {
+	return &cache{
+		size:    size,
+		entries: make(map[string]*list.Element, size),
+		order:   list.New(),
+	}
+}
+
+// get returns the value for the key, calling parse if it's not cached.
+func (c *cache) get(key string, parse func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		c.order.MoveToFront(elem)
+		entry := elem.Value.(*cacheEntry)
+		c.mu.Unlock()
+		return entry.value, entry.err
+	}
+	c.mu.Unlock()
+
+	// parse without holding the lock, so other values can be fetched
+	// meanwhile. the same key might be parsed more than once, which is fine
+	value, err := parse()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok {
+		c.entries[key] = c.order.PushFront(&cacheEntry{key: key, value: value, err: err})
+		if c.order.Len() > c.size {
+			oldest := c.order.Back()
+			c.order.Remove(oldest)
+			delete(c.entries, oldest.Value.(*cacheEntry).key)
+		}
+	}
+	return value, err
+}
+
+// len returns how many values are cached.
+func (c *cache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,68 @@
+package operator
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+	c := newCache(2)
+	var calls int
+	parse := func(value string) func() (interface{}, error) {
+		return func() (interface{}, error) {
+			calls++
+			if value == "" {
+				return nil, errors.New("empty")
+			}
+			return value, nil
+		}
+	}
+
+	v, err := c.get("a", parse("a"))
+	assert.NoError(t, err)
+	assert.Equal(t, "a", v)
+	// cached values are not parsed again
+	v, err = c.get("a", parse("a"))
+	assert.NoError(t, err)
+	assert.Equal(t, "a", v)
+	assert.Equal(t, 1, calls)
+
+	// errors are cached too
+	_, err = c.get("", parse(""))
+	assert.EqualError(t, err, "empty")
+	_, err = c.get("", parse(""))
+	assert.EqualError(t, err, "empty")
+	assert.Equal(t, 2, calls)
+
+	// the least recently used value is dropped when the cache is full
+	_, _ = c.get("a", parse("a"))
+	_, _ = c.get("b", parse("b"))
+	assert.Equal(t, 2, c.len())
+	_, _ = c.get("a", parse("a"))
+	assert.Equal(t, 3, calls)
+	_, _ = c.get("", parse(""))
+	assert.Equal(t, 4, calls)
+}
+
+func TestCache_Concurrency(t *testing.T) {
+	t.Parallel()
+	c := newCache(10)
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := fmt.Sprint(i % 20)
+			v, err := c.get(key, func() (interface{}, error) { return key, nil })
+			assert.NoError(t, err)
+			assert.Equal(t, key, v)
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, 10, c.len())
+}
@@ -28,6 +28,15 @@ func DoesntContain(usrValue interface{}, validValues []interface{}) (bool, error
 }
 
 func contains(cnstrnValue, userValue interface{}) bool {
+	if list, ok := userValue.([]interface{}); ok {
+		// lists match if any of their elements match
+		for _, elem := range list {
+			if contains(cnstrnValue, elem) {
+				return true
+			}
+		}
+		return false
+	}
 	str, err := toString(userValue)
 	if err != nil {
 		return false
@@ -53,6 +53,21 @@ func TestContains(t *testing.T) {
 			values:         []interface{}{"cde"},
 			expectedResult: false,
 		},
+		// ========================================================================
+		{
+			name:           "any element of list contains string",
+			usrContext:     map[string]interface{}{"prop": []interface{}{"beta", "staff"}},
+			property:       "prop",
+			values:         []interface{}{"taf"},
+			expectedResult: true,
+		},
+		{
+			name:           "no element of list contains string",
+			usrContext:     map[string]interface{}{"prop": []interface{}{"beta", "staff"}},
+			property:       "prop",
+			values:         []interface{}{"xyz"},
+			expectedResult: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -111,6 +126,21 @@ func TestDoesntContain(t *testing.T) {
 			values:         []interface{}{"cde"},
 			expectedResult: true,
 		},
+		// ========================================================================
+		{
+			name:           "no element of list contains string",
+			usrContext:     map[string]interface{}{"prop": []interface{}{"beta", "staff"}},
+			property:       "prop",
+			values:         []interface{}{"xyz"},
+			expectedResult: true,
+		},
+		{
+			name:           "any element of list contains string",
+			usrContext:     map[string]interface{}{"prop": []interface{}{"beta", "staff"}},
+			property:       "prop",
+			values:         []interface{}{"taf"},
+			expectedResult: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -27,6 +27,15 @@ func DoesntEndWith(usrValue interface{}, validValues []interface{}) (bool, error
 }
 
 func endsWith(cnstrnValue, userValue interface{}) bool {
+	if list, ok := userValue.([]interface{}); ok {
+		// lists match if any of their elements match
+		for _, elem := range list {
+			if endsWith(cnstrnValue, elem) {
+				return true
+			}
+		}
+		return false
+	}
 	str, err := toString(userValue)
 	if err != nil {
 		return false
@@ -29,7 +29,7 @@ func inNetwork(cnstrnValue, userValue interface{}) (bool, error) {
 		return false, nil
 	}
 	userIP := net.ParseIP(u)
-	_, ipnet, err := net.ParseCIDR(v)
+	ipnet, err := ParseCIDR(v)
 	if err != nil {
 		return false, err
 	}
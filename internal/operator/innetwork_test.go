@@ -1,6 +1,7 @@
 package operator_test
 
 import (
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -72,3 +73,22 @@ func TestInNetwork(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkInNetwork(b *testing.B) {
+	values := []interface{}{"192.168.0.0/16", "10.0.0.0/8"}
+	b.Run("cached", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			_, _ = operator.InNetwork("10.1.2.3", values)
+		}
+	})
+	b.Run("parsed on every call", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			ip := net.ParseIP("10.1.2.3")
+			for _, v := range values {
+				if _, ipnet, _ := net.ParseCIDR(v.(string)); ipnet.Contains(ip) {
+					break
+				}
+			}
+		}
+	})
+}
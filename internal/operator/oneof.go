@@ -37,6 +37,15 @@ func NotOneOf(usrValue interface{}, validValues []interface{}) (bool, error) {
 }
 
 func equals(cnstrnValue, userValue interface{}) (bool, error) {
+	if list, ok := userValue.([]interface{}); ok {
+		// lists match if any of their elements match
+		for _, elem := range list {
+			if ok, err := equals(cnstrnValue, elem); err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
 	if userValue == nil {
 		return false, nil
 	}
@@ -439,6 +439,28 @@ func TestOneOf(t *testing.T) {
 			values:         []interface{}{struct{}{}},
 			expectedResult: false,
 		},
+		// ========================================================================
+		{
+			name:           "any element of list equals string",
+			usrContext:     map[string]interface{}{"prop": []interface{}{"beta", "staff"}},
+			property:       "prop",
+			values:         []interface{}{"staff"},
+			expectedResult: true,
+		},
+		{
+			name:           "no element of list equals string",
+			usrContext:     map[string]interface{}{"prop": []interface{}{"beta", "staff"}},
+			property:       "prop",
+			values:         []interface{}{"admin"},
+			expectedResult: false,
+		},
+		{
+			name:           "empty list",
+			usrContext:     map[string]interface{}{"prop": []interface{}{}},
+			property:       "prop",
+			values:         []interface{}{"staff"},
+			expectedResult: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -891,6 +913,21 @@ func TestNotOneOf(t *testing.T) {
 			values:         []interface{}{struct{}{}},
 			expectedResult: true,
 		},
+		// ========================================================================
+		{
+			name:           "no element of list equals string",
+			usrContext:     map[string]interface{}{"prop": []interface{}{"beta", "staff"}},
+			property:       "prop",
+			values:         []interface{}{"admin"},
+			expectedResult: true,
+		},
+		{
+			name:           "any element of list equals string",
+			usrContext:     map[string]interface{}{"prop": []interface{}{"beta", "staff"}},
+			property:       "prop",
+			values:         []interface{}{"staff"},
+			expectedResult: false,
+		},
 	}
 
 	for _, tt := range tests {
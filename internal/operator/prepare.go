@@ -0,0 +1,225 @@
+package operator
+
+import (
+	"net"
+	"regexp"
+)
+
+// Matcher checks if the value from the user context satisfies an operator,
+// using the values configured on the flag that were prepared beforehand.
+type Matcher func(usrValue interface{}) (bool, error)
+
+// PrepareOneOf prepares the values for the OneOf operator. Values of the
+// same kind are hashed, so they're not compared one by one.
+func PrepareOneOf(validValues []interface{}) Matcher {
+	set, ok := newValueSet(validValues)
+	if !ok {
+		return func(usrValue interface{}) (bool, error) {
+			return OneOf(usrValue, validValues)
+		}
+	}
+	return func(usrValue interface{}) (bool, error) {
+		if _, ok := usrValue.([]interface{}); ok {
+			return OneOf(usrValue, validValues)
+		}
+		return set.contains(usrValue)
+	}
+}
+
+// PrepareNotOneOf prepares the values for the NotOneOf operator. Values of the
+// same kind are hashed, so they're not compared one by one.
+func PrepareNotOneOf(validValues []interface{}) Matcher {
+	set, ok := newValueSet(validValues)
+	if !ok {
+		return func(usrValue interface{}) (bool, error) {
+			return NotOneOf(usrValue, validValues)
+		}
+	}
+	return func(usrValue interface{}) (bool, error) {
+		if _, ok := usrValue.([]interface{}); ok {
+			return NotOneOf(usrValue, validValues)
+		}
+		found, err := set.contains(usrValue)
+		return !found && err == nil, err
+	}
+}
+
+// PrepareMatchesRegex prepares the values for the MatchesRegex operator,
+// compiling the regexes.
+func PrepareMatchesRegex(validValues []interface{}) Matcher {
+	regexes := prepareRegexes(validValues)
+	return func(usrValue interface{}) (bool, error) {
+		for _, rx := range regexes {
+			ok, err := rx.matches(usrValue)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// PrepareDoesntMatchRegex prepares the values for the DoesntMatchRegex
+// operator, compiling the regexes.
+func PrepareDoesntMatchRegex(validValues []interface{}) Matcher {
+	regexes := prepareRegexes(validValues)
+	return func(usrValue interface{}) (bool, error) {
+		for _, rx := range regexes {
+			ok, err := rx.matches(usrValue)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+}
+
+// PrepareInNetwork prepares the values for the InNetwork operator, parsing
+// the networks.
+func PrepareInNetwork(validValues []interface{}) Matcher {
+	networks := make([]*preparedNetwork, len(validValues))
+	for idx, v := range validValues {
+		if s, ok := v.(string); ok {
+			ipnet, err := ParseCIDR(s)
+			networks[idx] = &preparedNetwork{ipnet: ipnet, err: err}
+		}
+	}
+	return func(usrValue interface{}) (bool, error) {
+		for _, n := range networks {
+			ok, err := n.contains(usrValue)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// valueSet is a set of values of the same kind. Integers are stored as
+// int64, all other values are stored as they are.
+type valueSet struct {
+	integers bool
+	values   map[interface{}]struct{}
+}
+
+// newValueSet returns a set with the values, or false if the values can't
+// be compared the same way.
+func newValueSet(values []interface{}) (valueSet, bool) {
+	if len(values) == 0 {
+		return valueSet{}, false
+	}
+	set := valueSet{values: make(map[interface{}]struct{}, len(values))}
+	for idx, v := range values {
+		var integer bool
+		switch v.(type) {
+		case string, bool, float64:
+		case int, int32, int64:
+			integer = true
+			v, _ = toInt64(v)
+		default:
+			return valueSet{}, false
+		}
+		if idx == 0 {
+			set.integers = integer
+		} else if set.integers != integer {
+			return valueSet{}, false
+		}
+		set.values[v] = struct{}{}
+	}
+	return set, true
+}
+
+// contains checks if the value equals any of the values in the set, the same
+// way the OneOf operator does.
+func (s valueSet) contains(usrValue interface{}) (bool, error) {
+	if usrValue == nil {
+		return false, nil
+	}
+	if s.integers {
+		n, err := toInt64(usrValue)
+		if err != nil {
+			return false, err
+		}
+		_, ok := s.values[n]
+		return ok, nil
+	}
+	switch usrValue.(type) {
+	case string, bool, float64:
+		_, ok := s.values[usrValue]
+		return ok, nil
+	default:
+		return false, nil
+	}
+}
+
+// preparedRegex is a compiled regex, or the error compiling it. Values
+// that aren't strings are nil, as they never match.
+type preparedRegex struct {
+	re  *regexp.Regexp
+	err error
+}
+
+func prepareRegexes(validValues []interface{}) []*preparedRegex {
+	regexes := make([]*preparedRegex, len(validValues))
+	for idx, v := range validValues {
+		var expr string
+		switch s := v.(type) {
+		case string:
+			expr = s
+		case []byte:
+			expr = string(s)
+		default:
+			continue
+		}
+		re, err := CompileRegex(expr)
+		regexes[idx] = &preparedRegex{re: re, err: err}
+	}
+	return regexes
+}
+
+func (rx *preparedRegex) matches(userValue interface{}) (bool, error) {
+	if list, ok := userValue.([]interface{}); ok {
+		// lists match if any of their elements match
+		for _, elem := range list {
+			if ok, err := rx.matches(elem); err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
+	str, err := toString(userValue)
+	if err != nil || rx == nil {
+		return false, nil
+	}
+	if rx.err != nil {
+		return false, rx.err
+	}
+	return rx.re.MatchString(str), nil
+}
+
+// preparedNetwork is a parsed network, or the error parsing it. Values
+// that aren't strings are nil, as they never match.
+type preparedNetwork struct {
+	ipnet *net.IPNet
+	err   error
+}
+
+func (n *preparedNetwork) contains(userValue interface{}) (bool, error) {
+	u, err := toString(userValue)
+	if err != nil || n == nil {
+		return false, nil
+	}
+	if n.err != nil {
+		return false, n.err
+	}
+	return n.ipnet.Contains(net.ParseIP(u)), nil
+}
@@ -0,0 +1,72 @@
+package operator_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uw-labs/flaggio/internal/operator"
+)
+
+func TestPrepare(t *testing.T) {
+	t.Parallel()
+	operators := []struct {
+		name    string
+		prepare func([]interface{}) operator.Matcher
+		operate func(interface{}, []interface{}) (bool, error)
+		values  [][]interface{}
+	}{
+		{
+			name:    "OneOf",
+			prepare: operator.PrepareOneOf,
+			operate: operator.OneOf,
+			values: [][]interface{}{
+				{"a", "b"}, {int64(1), 2}, {1.5, true, "x"}, {"a", int64(1)}, {}, {struct{}{}},
+			},
+		},
+		{
+			name:    "NotOneOf",
+			prepare: operator.PrepareNotOneOf,
+			operate: operator.NotOneOf,
+			values: [][]interface{}{
+				{"a", "b"}, {int64(1), 2}, {1.5, true, "x"}, {"a", int64(1)}, {}, {struct{}{}},
+			},
+		},
+		{
+			name:    "MatchesRegex",
+			prepare: operator.PrepareMatchesRegex,
+			operate: operator.MatchesRegex,
+			values:  [][]interface{}{{"^a"}, {"[0-9]+", []byte("b$")}, {"[a-z"}, {struct{}{}, "a"}, {}},
+		},
+		{
+			name:    "DoesntMatchRegex",
+			prepare: operator.PrepareDoesntMatchRegex,
+			operate: operator.DoesntMatchRegex,
+			values:  [][]interface{}{{"^a"}, {"[0-9]+", []byte("b$")}, {"[a-z"}, {struct{}{}, "a"}, {}},
+		},
+		{
+			name:    "InNetwork",
+			prepare: operator.PrepareInNetwork,
+			operate: operator.InNetwork,
+			values:  [][]interface{}{{"10.0.0.0/8"}, {"192.168.0.0/16", "10.0.0.0/8"}, {"10.0.0.0/33"}, {1, "10.0.0.0/8"}, {}},
+		},
+	}
+	usrValues := []interface{}{
+		nil, "a", "ab", "b", "x", "10.1.2.3", "127.0.0.1", int64(1), 2, int32(3), 1.5, true, false,
+		[]byte("ab"), struct{}{}, []interface{}{"c", "a"}, []interface{}{int64(2)},
+	}
+	// prepared operators must give the same results as the operators
+	for _, op := range operators {
+		for _, values := range op.values {
+			match := op.prepare(values)
+			for _, usrValue := range usrValues {
+				name := fmt.Sprintf("%s %v with %#v", op.name, values, usrValue)
+				expectedResult, expectedErr := op.operate(usrValue, values)
+				result, err := match(usrValue)
+				assert.Equal(t, expectedResult, result, name)
+				assert.Equal(t, expectedErr, err, name)
+			}
+		}
+	}
+}
@@ -1,9 +1,5 @@
 package operator
 
-import (
-	"regexp"
-)
-
 // MatchesRegex operator will check if the value from the user context matches
 // any regexes configured on the flag.
 func MatchesRegex(usrValue interface{}, validValues []interface{}) (bool, error) {
@@ -35,16 +31,31 @@ func DoesntMatchRegex(usrValue interface{}, validValues []interface{}) (bool, er
 }
 
 func matches(cnstrnValue, userValue interface{}) (bool, error) {
+	if list, ok := userValue.([]interface{}); ok {
+		// lists match if any of their elements match
+		for _, elem := range list {
+			if ok, err := matches(cnstrnValue, elem); err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
 	str, err := toString(userValue)
 	if err != nil {
 		return false, nil
 	}
+	var expr string
 	switch v := cnstrnValue.(type) {
 	case string:
-		return regexp.Match(v, []byte(str))
+		expr = v
 	case []byte:
-		return regexp.Match(string(v), []byte(str))
+		expr = string(v)
 	default:
 		return false, nil
 	}
+	re, err := CompileRegex(expr)
+	if err != nil {
+		return false, err
+	}
+	return re.MatchString(str), nil
 }
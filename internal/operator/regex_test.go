@@ -1,6 +1,7 @@
 package operator_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -119,3 +120,21 @@ func TestDoesntMatchRegex(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkMatchesRegex(b *testing.B) {
+	values := []interface{}{`^[a-z0-9._%+-]+@flaggio\.com$`, `^admin`}
+	b.Run("cached", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			_, _ = operator.MatchesRegex("john@flaggio.com", values)
+		}
+	})
+	b.Run("compiled on every call", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			for _, v := range values {
+				if ok, _ := regexp.MatchString(v.(string), "john@flaggio.com"); ok {
+					break
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,24 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/changerequest_mock.go -package=repository_mock github.com/uw-labs/flaggio/internal/repository ChangeRequest
+
+import (
+	"context"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+// ChangeRequest represents a set of operations available to manage the change
+// requests of protected flags.
+type ChangeRequest interface {
+	// FindByID returns a change request that has a given ID.
+	FindByID(ctx context.Context, flagID, id string) (*flaggio.ChangeRequest, error)
+	// Create creates a new pending change request under a flag.
+	Create(ctx context.Context, flagID string, cr *flaggio.ChangeRequest) (string, error)
+	// Approve adds the approval of a user to a change request. Once the change
+	// request has enough approvals, the proposed change is applied to the flag in
+	// the same operation, and true is returned.
+	Approve(ctx context.Context, flagID, id, user string) (bool, error)
+	// Reject rejects a change request.
+	Reject(ctx context.Context, flagID, id, user string) error
+}
@@ -0,0 +1,25 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/exclusiongroup_mock.go -package=repository_mock github.com/uw-labs/flaggio/internal/repository ExclusionGroup
+
+import (
+	"context"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+// ExclusionGroup represents a set of operations available to list and manage exclusion groups.
+type ExclusionGroup interface {
+	// FindAll returns all exclusion groups.
+	FindAll(ctx context.Context) ([]*flaggio.ExclusionGroup, error)
+	// FindByID returns an exclusion group that has a given ID.
+	FindByID(ctx context.Context, id string) (*flaggio.ExclusionGroup, error)
+	// Create creates a new exclusion group.
+	Create(ctx context.Context, input flaggio.NewExclusionGroup) (string, error)
+	// Update updates an exclusion group. The version of the flags given a
+	// different slice of the group is increased.
+	Update(ctx context.Context, id string, input flaggio.UpdateExclusionGroup) error
+	// Delete deletes an exclusion group. The version of the flags in the
+	// group is increased.
+	Delete(ctx context.Context, id string) error
+}
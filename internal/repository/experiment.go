@@ -0,0 +1,23 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/experiment_mock.go -package=repository_mock github.com/uw-labs/flaggio/internal/repository Experiment
+
+import (
+	"context"
+
+	"github.com/uw-labs/flaggio/internal/exposure"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+// Experiment represents a set of operations available to record and
+// aggregate experiment data.
+type Experiment interface {
+	// CreateExposures records the variant each user was first exposed to, per flag.
+	// Exposures of users that were already exposed to the flag are ignored.
+	CreateExposures(ctx context.Context, events []exposure.Event) error
+	// CreateConversion records a conversion of a user on a metric.
+	CreateConversion(ctx context.Context, conversion flaggio.Conversion) error
+	// CountByVariant returns how many users were exposed to each variant of the flag and,
+	// for each metric, how many of them converted after the exposure.
+	CountByVariant(ctx context.Context, flagKey string, metricKeys []string) ([]*flaggio.VariantCount, error)
+}
@@ -10,16 +10,23 @@ import (
 
 // Flag represents a set of operations available to list and manage flags.
 type Flag interface {
-	// FindAll returns a list of flags, based on an optional offset and limit.
-	FindAll(ctx context.Context, search *string, offset, limit *int64) (*flaggio.FlagResults, error)
+	// FindAll returns a list of flags, based on an optional search, filter, offset and limit.
+	// Archived flags are only returned when filtering by them.
+	FindAll(ctx context.Context, search *string, filter *flaggio.FlagFilter, offset, limit *int64) (*flaggio.FlagResults, error)
 	// FindByID returns a flag that has a given ID.
 	FindByID(ctx context.Context, id string) (*flaggio.Flag, error)
 	// FindByKey returns a flag that has a given key.
 	FindByKey(ctx context.Context, key string) (*flaggio.Flag, error)
 	// Create creates a new flag.
 	Create(ctx context.Context, input flaggio.NewFlag) (string, error)
+	// Clone creates a copy of a flag, including its variants and rules, with a new key and name.
+	Clone(ctx context.Context, id, key, name string) (string, error)
 	// Update updates a flag.
 	Update(ctx context.Context, id string, input flaggio.UpdateFlag) error
-	// Delete deletes a flag.
+	// Archive archives a flag, hiding it from the list of flags.
+	Archive(ctx context.Context, id string) error
+	// Restore restores an archived flag.
+	Restore(ctx context.Context, id string) error
+	// Delete deletes an archived flag.
 	Delete(ctx context.Context, id string) error
 }
@@ -0,0 +1,21 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/metric_mock.go -package=repository_mock github.com/uw-labs/flaggio/internal/repository Metric
+
+import (
+	"context"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+// Metric represents a set of operations available to list and manage experiment metrics.
+type Metric interface {
+	// FindByID returns a metric that has a given ID.
+	FindByID(ctx context.Context, flagIDHex, idHex string) (*flaggio.Metric, error)
+	// Create creates a new metric under a flag.
+	Create(ctx context.Context, flagID string, input flaggio.NewMetric) (string, error)
+	// Update updates a metric under a flag.
+	Update(ctx context.Context, flagID, id string, input flaggio.UpdateMetric) error
+	// Delete deletes a metric under a flag.
+	Delete(ctx context.Context, flagID, id string) error
+}
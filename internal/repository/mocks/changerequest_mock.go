@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/uw-labs/flaggio/internal/repository (interfaces: ChangeRequest)
+
+// Package repository_mock is a generated GoMock package.
+package repository_mock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	flaggio "github.com/uw-labs/flaggio/internal/flaggio"
+	reflect "reflect"
+)
+
+// MockChangeRequest is a mock of ChangeRequest interface
+type MockChangeRequest struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeRequestMockRecorder
+}
+
+// MockChangeRequestMockRecorder is the mock recorder for MockChangeRequest
+type MockChangeRequestMockRecorder struct {
+	mock *MockChangeRequest
+}
+
+// NewMockChangeRequest creates a new mock instance
+func NewMockChangeRequest(ctrl *gomock.Controller) *MockChangeRequest {
+	mock := &MockChangeRequest{ctrl: ctrl}
+	mock.recorder = &MockChangeRequestMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockChangeRequest) EXPECT() *MockChangeRequestMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method
+func (m *MockChangeRequest) Approve(arg0 context.Context, arg1, arg2, arg3 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve
+func (mr *MockChangeRequestMockRecorder) Approve(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockChangeRequest)(nil).Approve), arg0, arg1, arg2, arg3)
+}
+
+// Create mocks base method
+func (m *MockChangeRequest) Create(arg0 context.Context, arg1 string, arg2 *flaggio.ChangeRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create
+func (mr *MockChangeRequestMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChangeRequest)(nil).Create), arg0, arg1, arg2)
+}
+
+// FindByID mocks base method
+func (m *MockChangeRequest) FindByID(arg0 context.Context, arg1, arg2 string) (*flaggio.ChangeRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*flaggio.ChangeRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID
+func (mr *MockChangeRequestMockRecorder) FindByID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockChangeRequest)(nil).FindByID), arg0, arg1, arg2)
+}
+
+// Reject mocks base method
+func (m *MockChangeRequest) Reject(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reject indicates an expected call of Reject
+func (mr *MockChangeRequestMockRecorder) Reject(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockChangeRequest)(nil).Reject), arg0, arg1, arg2, arg3)
+}
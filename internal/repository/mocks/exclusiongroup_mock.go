@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/uw-labs/flaggio/internal/repository (interfaces: ExclusionGroup)
+
+// Package repository_mock is a generated GoMock package.
+package repository_mock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	flaggio "github.com/uw-labs/flaggio/internal/flaggio"
+	reflect "reflect"
+)
+
+// MockExclusionGroup is a mock of ExclusionGroup interface
+type MockExclusionGroup struct {
+	ctrl     *gomock.Controller
+	recorder *MockExclusionGroupMockRecorder
+}
+
+// MockExclusionGroupMockRecorder is the mock recorder for MockExclusionGroup
+type MockExclusionGroupMockRecorder struct {
+	mock *MockExclusionGroup
+}
+
+// NewMockExclusionGroup creates a new mock instance
+func NewMockExclusionGroup(ctrl *gomock.Controller) *MockExclusionGroup {
+	mock := &MockExclusionGroup{ctrl: ctrl}
+	mock.recorder = &MockExclusionGroupMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockExclusionGroup) EXPECT() *MockExclusionGroupMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockExclusionGroup) Create(arg0 context.Context, arg1 flaggio.NewExclusionGroup) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create
+func (mr *MockExclusionGroupMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExclusionGroup)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method
+func (m *MockExclusionGroup) Delete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockExclusionGroupMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExclusionGroup)(nil).Delete), arg0, arg1)
+}
+
+// FindAll mocks base method
+func (m *MockExclusionGroup) FindAll(arg0 context.Context) ([]*flaggio.ExclusionGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0)
+	ret0, _ := ret[0].([]*flaggio.ExclusionGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll
+func (mr *MockExclusionGroupMockRecorder) FindAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockExclusionGroup)(nil).FindAll), arg0)
+}
+
+// FindByID mocks base method
+func (m *MockExclusionGroup) FindByID(arg0 context.Context, arg1 string) (*flaggio.ExclusionGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*flaggio.ExclusionGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID
+func (mr *MockExclusionGroupMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockExclusionGroup)(nil).FindByID), arg0, arg1)
+}
+
+// Update mocks base method
+func (m *MockExclusionGroup) Update(arg0 context.Context, arg1 string, arg2 flaggio.UpdateExclusionGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockExclusionGroupMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockExclusionGroup)(nil).Update), arg0, arg1, arg2)
+}
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/uw-labs/flaggio/internal/repository (interfaces: Experiment)
+
+// Package repository_mock is a generated GoMock package.
+package repository_mock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	exposure "github.com/uw-labs/flaggio/internal/exposure"
+	flaggio "github.com/uw-labs/flaggio/internal/flaggio"
+	reflect "reflect"
+)
+
+// MockExperiment is a mock of Experiment interface
+type MockExperiment struct {
+	ctrl     *gomock.Controller
+	recorder *MockExperimentMockRecorder
+}
+
+// MockExperimentMockRecorder is the mock recorder for MockExperiment
+type MockExperimentMockRecorder struct {
+	mock *MockExperiment
+}
+
+// NewMockExperiment creates a new mock instance
+func NewMockExperiment(ctrl *gomock.Controller) *MockExperiment {
+	mock := &MockExperiment{ctrl: ctrl}
+	mock.recorder = &MockExperimentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockExperiment) EXPECT() *MockExperimentMockRecorder {
+	return m.recorder
+}
+
+// CountByVariant mocks base method
+func (m *MockExperiment) CountByVariant(arg0 context.Context, arg1 string, arg2 []string) ([]*flaggio.VariantCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByVariant", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*flaggio.VariantCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByVariant indicates an expected call of CountByVariant
+func (mr *MockExperimentMockRecorder) CountByVariant(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByVariant", reflect.TypeOf((*MockExperiment)(nil).CountByVariant), arg0, arg1, arg2)
+}
+
+// CreateConversion mocks base method
+func (m *MockExperiment) CreateConversion(arg0 context.Context, arg1 flaggio.Conversion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConversion", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateConversion indicates an expected call of CreateConversion
+func (mr *MockExperimentMockRecorder) CreateConversion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConversion", reflect.TypeOf((*MockExperiment)(nil).CreateConversion), arg0, arg1)
+}
+
+// CreateExposures mocks base method
+func (m *MockExperiment) CreateExposures(arg0 context.Context, arg1 []exposure.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExposures", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateExposures indicates an expected call of CreateExposures
+func (mr *MockExperimentMockRecorder) CreateExposures(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExposures", reflect.TypeOf((*MockExperiment)(nil).CreateExposures), arg0, arg1)
+}
@@ -34,6 +34,35 @@ func (m *MockFlag) EXPECT() *MockFlagMockRecorder {
 	return m.recorder
 }
 
+// Archive mocks base method
+func (m *MockFlag) Archive(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Archive indicates an expected call of Archive
+func (mr *MockFlagMockRecorder) Archive(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockFlag)(nil).Archive), arg0, arg1)
+}
+
+// Clone mocks base method
+func (m *MockFlag) Clone(arg0 context.Context, arg1, arg2, arg3 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Clone indicates an expected call of Clone
+func (mr *MockFlagMockRecorder) Clone(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockFlag)(nil).Clone), arg0, arg1, arg2, arg3)
+}
+
 // Create mocks base method
 func (m *MockFlag) Create(arg0 context.Context, arg1 flaggio.NewFlag) (string, error) {
 	m.ctrl.T.Helper()
@@ -64,18 +93,18 @@ func (mr *MockFlagMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 }
 
 // FindAll mocks base method
-func (m *MockFlag) FindAll(arg0 context.Context, arg1 *string, arg2, arg3 *int64) (*flaggio.FlagResults, error) {
+func (m *MockFlag) FindAll(arg0 context.Context, arg1 *string, arg2 *flaggio.FlagFilter, arg3, arg4 *int64) (*flaggio.FlagResults, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*flaggio.FlagResults)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAll indicates an expected call of FindAll
-func (mr *MockFlagMockRecorder) FindAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockFlagMockRecorder) FindAll(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockFlag)(nil).FindAll), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockFlag)(nil).FindAll), arg0, arg1, arg2, arg3, arg4)
 }
 
 // FindByID mocks base method
@@ -108,6 +137,20 @@ func (mr *MockFlagMockRecorder) FindByKey(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKey", reflect.TypeOf((*MockFlag)(nil).FindByKey), arg0, arg1)
 }
 
+// Restore mocks base method
+func (m *MockFlag) Restore(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore
+func (mr *MockFlagMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockFlag)(nil).Restore), arg0, arg1)
+}
+
 // Update mocks base method
 func (m *MockFlag) Update(arg0 context.Context, arg1 string, arg2 flaggio.UpdateFlag) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/uw-labs/flaggio/internal/repository (interfaces: Metric)
+
+// Package repository_mock is a generated GoMock package.
+package repository_mock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	flaggio "github.com/uw-labs/flaggio/internal/flaggio"
+	reflect "reflect"
+)
+
+// MockMetric is a mock of Metric interface
+type MockMetric struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetricMockRecorder
+}
+
+// MockMetricMockRecorder is the mock recorder for MockMetric
+type MockMetricMockRecorder struct {
+	mock *MockMetric
+}
+
+// NewMockMetric creates a new mock instance
+func NewMockMetric(ctrl *gomock.Controller) *MockMetric {
+	mock := &MockMetric{ctrl: ctrl}
+	mock.recorder = &MockMetricMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockMetric) EXPECT() *MockMetricMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockMetric) Create(arg0 context.Context, arg1 string, arg2 flaggio.NewMetric) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create
+func (mr *MockMetricMockRecorder) Create(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMetric)(nil).Create), arg0, arg1, arg2)
+}
+
+// Delete mocks base method
+func (m *MockMetric) Delete(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockMetricMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMetric)(nil).Delete), arg0, arg1, arg2)
+}
+
+// FindByID mocks base method
+func (m *MockMetric) FindByID(arg0 context.Context, arg1, arg2 string) (*flaggio.Metric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*flaggio.Metric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID
+func (mr *MockMetricMockRecorder) FindByID(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockMetric)(nil).FindByID), arg0, arg1, arg2)
+}
+
+// Update mocks base method
+func (m *MockMetric) Update(arg0 context.Context, arg1, arg2 string, arg3 flaggio.UpdateMetric) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockMetricMockRecorder) Update(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMetric)(nil).Update), arg0, arg1, arg2, arg3)
+}
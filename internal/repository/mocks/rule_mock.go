@@ -122,6 +122,34 @@ func (mr *MockRuleMockRecorder) FindSegmentRuleByID(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSegmentRuleByID", reflect.TypeOf((*MockRule)(nil).FindSegmentRuleByID), arg0, arg1, arg2)
 }
 
+// MoveFlagRule mocks base method
+func (m *MockRule) MoveFlagRule(arg0 context.Context, arg1, arg2 string, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveFlagRule", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveFlagRule indicates an expected call of MoveFlagRule
+func (mr *MockRuleMockRecorder) MoveFlagRule(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveFlagRule", reflect.TypeOf((*MockRule)(nil).MoveFlagRule), arg0, arg1, arg2, arg3)
+}
+
+// MoveSegmentRule mocks base method
+func (m *MockRule) MoveSegmentRule(arg0 context.Context, arg1, arg2 string, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveSegmentRule", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveSegmentRule indicates an expected call of MoveSegmentRule
+func (mr *MockRuleMockRecorder) MoveSegmentRule(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveSegmentRule", reflect.TypeOf((*MockRule)(nil).MoveSegmentRule), arg0, arg1, arg2, arg3)
+}
+
 // UpdateFlagRule mocks base method
 func (m *MockRule) UpdateFlagRule(arg0 context.Context, arg1, arg2 string, arg3 flaggio.UpdateFlagRule) error {
 	m.ctrl.T.Helper()
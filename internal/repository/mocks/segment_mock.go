@@ -50,17 +50,17 @@ func (mr *MockSegmentMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
 }
 
 // Delete mocks base method
-func (m *MockSegment) Delete(arg0 context.Context, arg1 string) error {
+func (m *MockSegment) Delete(arg0 context.Context, arg1 string, arg2 bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete
-func (mr *MockSegmentMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockSegmentMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSegment)(nil).Delete), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSegment)(nil).Delete), arg0, arg1, arg2)
 }
 
 // FindAll mocks base method
@@ -106,3 +106,18 @@ func (mr *MockSegmentMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSegment)(nil).Update), arg0, arg1, arg2)
 }
+
+// UsedBy mocks base method
+func (m *MockSegment) UsedBy(arg0 context.Context, arg1 string) ([]*flaggio.SegmentUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsedBy", arg0, arg1)
+	ret0, _ := ret[0].([]*flaggio.SegmentUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UsedBy indicates an expected call of UsedBy
+func (mr *MockSegmentMockRecorder) UsedBy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsedBy", reflect.TypeOf((*MockSegment)(nil).UsedBy), arg0, arg1)
+}
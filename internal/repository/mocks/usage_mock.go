@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/uw-labs/flaggio/internal/repository (interfaces: FlagUsage)
+
+// Package repository_mock is a generated GoMock package.
+package repository_mock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	flaggio "github.com/uw-labs/flaggio/internal/flaggio"
+	reflect "reflect"
+)
+
+// MockFlagUsage is a mock of FlagUsage interface
+type MockFlagUsage struct {
+	ctrl     *gomock.Controller
+	recorder *MockFlagUsageMockRecorder
+}
+
+// MockFlagUsageMockRecorder is the mock recorder for MockFlagUsage
+type MockFlagUsageMockRecorder struct {
+	mock *MockFlagUsage
+}
+
+// NewMockFlagUsage creates a new mock instance
+func NewMockFlagUsage(ctrl *gomock.Controller) *MockFlagUsage {
+	mock := &MockFlagUsage{ctrl: ctrl}
+	mock.recorder = &MockFlagUsageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFlagUsage) EXPECT() *MockFlagUsageMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method
+func (m *MockFlagUsage) FindAll(arg0 context.Context) ([]*flaggio.FlagUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0)
+	ret0, _ := ret[0].([]*flaggio.FlagUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll
+func (mr *MockFlagUsageMockRecorder) FindAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockFlagUsage)(nil).FindAll), arg0)
+}
+
+// FindByFlagKey mocks base method
+func (m *MockFlagUsage) FindByFlagKey(arg0 context.Context, arg1 string) (*flaggio.FlagUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByFlagKey", arg0, arg1)
+	ret0, _ := ret[0].(*flaggio.FlagUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByFlagKey indicates an expected call of FindByFlagKey
+func (mr *MockFlagUsageMockRecorder) FindByFlagKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByFlagKey", reflect.TypeOf((*MockFlagUsage)(nil).FindByFlagKey), arg0, arg1)
+}
+
+// RecordUsage mocks base method
+func (m *MockFlagUsage) RecordUsage(arg0 context.Context, arg1 []*flaggio.FlagUsage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordUsage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordUsage indicates an expected call of RecordUsage
+func (mr *MockFlagUsageMockRecorder) RecordUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordUsage", reflect.TypeOf((*MockFlagUsage)(nil).RecordUsage), arg0, arg1)
+}
@@ -0,0 +1,189 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/uw-labs/flaggio/internal/repository (interfaces: Webhook,WebhookDelivery)
+
+// Package repository_mock is a generated GoMock package.
+package repository_mock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	flaggio "github.com/uw-labs/flaggio/internal/flaggio"
+	reflect "reflect"
+)
+
+// MockWebhook is a mock of Webhook interface
+type MockWebhook struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookMockRecorder
+}
+
+// MockWebhookMockRecorder is the mock recorder for MockWebhook
+type MockWebhookMockRecorder struct {
+	mock *MockWebhook
+}
+
+// NewMockWebhook creates a new mock instance
+func NewMockWebhook(ctrl *gomock.Controller) *MockWebhook {
+	mock := &MockWebhook{ctrl: ctrl}
+	mock.recorder = &MockWebhookMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhook) EXPECT() *MockWebhookMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockWebhook) Create(arg0 context.Context, arg1 flaggio.NewWebhook) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create
+func (mr *MockWebhookMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhook)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method
+func (m *MockWebhook) Delete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockWebhookMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhook)(nil).Delete), arg0, arg1)
+}
+
+// FindAll mocks base method
+func (m *MockWebhook) FindAll(arg0 context.Context) ([]*flaggio.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0)
+	ret0, _ := ret[0].([]*flaggio.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll
+func (mr *MockWebhookMockRecorder) FindAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockWebhook)(nil).FindAll), arg0)
+}
+
+// FindByID mocks base method
+func (m *MockWebhook) FindByID(arg0 context.Context, arg1 string) (*flaggio.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*flaggio.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID
+func (mr *MockWebhookMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockWebhook)(nil).FindByID), arg0, arg1)
+}
+
+// Update mocks base method
+func (m *MockWebhook) Update(arg0 context.Context, arg1 string, arg2 flaggio.UpdateWebhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockWebhookMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhook)(nil).Update), arg0, arg1, arg2)
+}
+
+// MockWebhookDelivery is a mock of WebhookDelivery interface
+type MockWebhookDelivery struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveryMockRecorder
+}
+
+// MockWebhookDeliveryMockRecorder is the mock recorder for MockWebhookDelivery
+type MockWebhookDeliveryMockRecorder struct {
+	mock *MockWebhookDelivery
+}
+
+// NewMockWebhookDelivery creates a new mock instance
+func NewMockWebhookDelivery(ctrl *gomock.Controller) *MockWebhookDelivery {
+	mock := &MockWebhookDelivery{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookDelivery) EXPECT() *MockWebhookDeliveryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockWebhookDelivery) Create(arg0 context.Context, arg1 *flaggio.WebhookDelivery) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create
+func (mr *MockWebhookDeliveryMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookDelivery)(nil).Create), arg0, arg1)
+}
+
+// DeleteAllByWebhookID mocks base method
+func (m *MockWebhookDelivery) DeleteAllByWebhookID(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllByWebhookID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllByWebhookID indicates an expected call of DeleteAllByWebhookID
+func (mr *MockWebhookDeliveryMockRecorder) DeleteAllByWebhookID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllByWebhookID", reflect.TypeOf((*MockWebhookDelivery)(nil).DeleteAllByWebhookID), arg0, arg1)
+}
+
+// FindAllByWebhookID mocks base method
+func (m *MockWebhookDelivery) FindAllByWebhookID(arg0 context.Context, arg1 string, arg2, arg3 *int64) (*flaggio.WebhookDeliveryResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByWebhookID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*flaggio.WebhookDeliveryResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByWebhookID indicates an expected call of FindAllByWebhookID
+func (mr *MockWebhookDeliveryMockRecorder) FindAllByWebhookID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByWebhookID", reflect.TypeOf((*MockWebhookDelivery)(nil).FindAllByWebhookID), arg0, arg1, arg2, arg3)
+}
+
+// UpdateAttempt mocks base method
+func (m *MockWebhookDelivery) UpdateAttempt(arg0 context.Context, arg1 string, arg2 *int, arg3 *string, arg4 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttempt", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttempt indicates an expected call of UpdateAttempt
+func (mr *MockWebhookDeliveryMockRecorder) UpdateAttempt(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttempt", reflect.TypeOf((*MockWebhookDelivery)(nil).UpdateAttempt), arg0, arg1, arg2, arg3, arg4)
+}
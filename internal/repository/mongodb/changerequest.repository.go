@@ -0,0 +1,254 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uw-labs/flaggio/internal/errors"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var _ repository.ChangeRequest = (*ChangeRequestRepository)(nil)
+
+// ChangeRequestRepository implements repository.ChangeRequest interface using mongodb.
+// Change requests are stored in the flag document, so that approving the last
+// change request also applies the change in a single atomic update.
+type ChangeRequestRepository struct {
+	flagRepo *FlagRepository
+}
+
+// FindByID returns a change request that has a given ID.
+func (r *ChangeRequestRepository) FindByID(ctx context.Context, flagIDHex, idHex string) (*flaggio.ChangeRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoChangeRequestRepository.FindByID")
+	defer span.Finish()
+
+	flagID, err := primitive.ObjectIDFromHex(flagIDHex)
+	if err != nil {
+		return nil, err
+	}
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"_id": flagID, "changeRequests._id": id}
+	projection := bson.M{"changeRequests.$": 1}
+	opts := options.FindOne().SetProjection(projection)
+
+	var f flagModel
+	if err := r.flagRepo.col.FindOne(ctx, filter, opts).Decode(&f); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.NotFound("change request")
+		}
+		return nil, err
+	}
+	if len(f.ChangeRequests) != 1 {
+		return nil, errors.NotFound("change request")
+	}
+	return f.ChangeRequests[0].asChangeRequest(), nil
+}
+
+// Create creates a new pending change request under a flag.
+func (r *ChangeRequestRepository) Create(ctx context.Context, flagIDHex string, cr *flaggio.ChangeRequest) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoChangeRequestRepository.Create")
+	defer span.Finish()
+
+	flagID, err := primitive.ObjectIDFromHex(flagIDHex)
+	if err != nil {
+		return "", err
+	}
+	entityID, err := primitive.ObjectIDFromHex(cr.EntityID)
+	if err != nil {
+		return "", err
+	}
+	diff := make([]fieldChangeModel, len(cr.Diff))
+	for idx, fc := range cr.Diff {
+		diff[idx] = fieldChangeModel{Field: fc.Field, OldValue: fc.OldValue, NewValue: fc.NewValue}
+	}
+	crModel := &changeRequestModel{
+		ID:                primitive.NewObjectID(),
+		EntityType:        string(cr.EntityType),
+		EntityID:          entityID,
+		FlagVersion:       cr.FlagVersion,
+		Diff:              diff,
+		Status:            string(flaggio.ChangeRequestStatusPending),
+		Author:            cr.Author,
+		RequiredApprovals: cr.RequiredApprovals,
+		Approvals:         []approvalModel{},
+		FlagInput:         cr.FlagInput,
+		VariantInput:      cr.VariantInput,
+		FlagRuleInput:     cr.FlagRuleInput,
+		CreatedAt:         time.Now(),
+	}
+	// change requests don't change the flag configuration, so the
+	// version is kept as is
+	res, err := r.flagRepo.col.UpdateOne(ctx, bson.M{"_id": flagID}, bson.M{
+		"$push": bson.M{"changeRequests": crModel},
+	})
+	if err != nil {
+		return "", err
+	}
+	if res.ModifiedCount == 0 {
+		return "", errors.NotFound("flag")
+	}
+	return crModel.ID.Hex(), nil
+}
+
+// Approve adds the approval of a user to a change request. Once the change
+// request has enough approvals, the proposed change is applied to the flag in
+// the same operation, and true is returned.
+func (r *ChangeRequestRepository) Approve(ctx context.Context, flagIDHex, idHex, user string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoChangeRequestRepository.Approve")
+	defer span.Finish()
+
+	flagID, err := primitive.ObjectIDFromHex(flagIDHex)
+	if err != nil {
+		return false, err
+	}
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return false, err
+	}
+	f, crModel, err := r.findChangeRequest(ctx, flagID, id)
+	if err != nil {
+		return false, err
+	}
+	cr := crModel.asChangeRequest()
+	if err := cr.CheckReviewer(user); err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	// the approvals size guards against concurrent reviews
+	filter := bson.M{
+		"_id": flagID,
+		"changeRequests": bson.M{"$elemMatch": bson.M{
+			"_id":       id,
+			"status":    string(flaggio.ChangeRequestStatusPending),
+			"approvals": bson.M{"$size": len(cr.Approvals)},
+		}},
+	}
+	arrayFilters := []interface{}{bson.M{"cr._id": id}}
+	mods := bson.M{"changeRequests.$[cr].updatedAt": now}
+	update := bson.M{
+		"$push": bson.M{"changeRequests.$[cr].approvals": approvalModel{User: user, CreatedAt: now}},
+		"$set":  mods,
+	}
+
+	final := cr.IsFinalApproval()
+	if final {
+		// the change was reviewed against a version of the flag, so it can't
+		// be applied if the flag changed since
+		if f.Version != cr.FlagVersion {
+			return false, errors.BadRequest("flag was changed after the change request was created")
+		}
+		changeMods, err := changeRequestMods(crModel, filter, &arrayFilters)
+		if err != nil {
+			return false, err
+		}
+		for key, value := range changeMods {
+			mods[key] = value
+		}
+		mods["changeRequests.$[cr].status"] = string(flaggio.ChangeRequestStatusApplied)
+		mods["updatedAt"] = now
+		filter["version"] = cr.FlagVersion
+		update["$inc"] = bson.M{"version": 1}
+	}
+
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{Filters: arrayFilters})
+	res, err := r.flagRepo.col.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return false, err
+	}
+	if res.ModifiedCount == 0 {
+		return false, errors.BadRequest("change request was modified concurrently, please try again")
+	}
+	return final, nil
+}
+
+// Reject rejects a change request.
+func (r *ChangeRequestRepository) Reject(ctx context.Context, flagIDHex, idHex, user string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoChangeRequestRepository.Reject")
+	defer span.Finish()
+
+	flagID, err := primitive.ObjectIDFromHex(flagIDHex)
+	if err != nil {
+		return err
+	}
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	if _, _, err := r.findChangeRequest(ctx, flagID, id); err != nil {
+		return err
+	}
+	filter := bson.M{
+		"_id": flagID,
+		"changeRequests": bson.M{"$elemMatch": bson.M{
+			"_id":    id,
+			"status": string(flaggio.ChangeRequestStatusPending),
+		}},
+	}
+	update := bson.M{"$set": bson.M{
+		"changeRequests.$.status":     string(flaggio.ChangeRequestStatusRejected),
+		"changeRequests.$.rejectedBy": user,
+		"changeRequests.$.updatedAt":  time.Now(),
+	}}
+	res, err := r.flagRepo.col.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.ModifiedCount == 0 {
+		return errors.BadRequest("change request is not pending")
+	}
+	return nil
+}
+
+func (r *ChangeRequestRepository) findChangeRequest(ctx context.Context, flagID, id primitive.ObjectID) (*flagModel, *changeRequestModel, error) {
+	var f flagModel
+	if err := r.flagRepo.col.FindOne(ctx, bson.M{"_id": flagID}).Decode(&f); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil, errors.NotFound("flag")
+		}
+		return nil, nil, err
+	}
+	for idx := range f.ChangeRequests {
+		if f.ChangeRequests[idx].ID == id {
+			return &f, &f.ChangeRequests[idx], nil
+		}
+	}
+	return nil, nil, errors.NotFound("change request")
+}
+
+// changeRequestMods returns the fields of the flag document modified by the
+// change request. The filter and array filters are updated to match the
+// changed entity.
+func changeRequestMods(cr *changeRequestModel, filter bson.M, arrayFilters *[]interface{}) (bson.M, error) {
+	switch {
+	case cr.FlagInput != nil:
+		return flagUpdateMods(*cr.FlagInput)
+	case cr.VariantInput != nil:
+		filter["variants._id"] = cr.EntityID
+		*arrayFilters = append(*arrayFilters, bson.M{"vrnt._id": cr.EntityID})
+		return variantUpdateMods("variants.$[vrnt]", *cr.VariantInput), nil
+	case cr.FlagRuleInput != nil:
+		filter["rules._id"] = cr.EntityID
+		*arrayFilters = append(*arrayFilters, bson.M{"rl._id": cr.EntityID})
+		return flagRuleUpdateMods("rules.$[rl]", *cr.FlagRuleInput)
+	default:
+		return nil, errors.BadRequest("change request has no proposed change")
+	}
+}
+
+// NewChangeRequestRepository returns a new change request repository that uses mongodb
+// as underlying storage.
+func NewChangeRequestRepository(flagRepo *FlagRepository) repository.ChangeRequest {
+	return &ChangeRequestRepository{
+		flagRepo: flagRepo,
+	}
+}
@@ -0,0 +1,210 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	mongo_repo "github.com/uw-labs/flaggio/internal/repository/mongodb"
+)
+
+func TestChangeRequestRepository(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	// drop database first
+	if err := mongoDB.Drop(ctx); err != nil {
+		t.Fatalf("failed drop database: %s", err)
+	}
+
+	// create new repos
+	flgRepo, err := mongo_repo.NewFlagRepository(ctx, mongoDB)
+	assert.NoError(t, err, "failed to create flag repository")
+	sgmntRepo, err := mongo_repo.NewSegmentRepository(ctx, mongoDB)
+	assert.NoError(t, err, "failed to create segment repository")
+	vrntRepo := mongo_repo.NewVariantRepository(flgRepo.(*mongo_repo.FlagRepository))
+	rlRepo := mongo_repo.NewRuleRepository(flgRepo.(*mongo_repo.FlagRepository), sgmntRepo.(*mongo_repo.SegmentRepository))
+	repo := mongo_repo.NewChangeRequestRepository(flgRepo.(*mongo_repo.FlagRepository))
+
+	// create a protected flag, with a variant and a rule
+	flgID, err := flgRepo.Create(ctx, flaggio.NewFlag{Key: "test", Name: "Test"})
+	assert.NoError(t, err, "failed to create flag")
+	vrntID, err := vrntRepo.Create(ctx, flgID, flaggio.NewVariant{Value: 1})
+	assert.NoError(t, err, "failed to create variant")
+	rlID, err := rlRepo.CreateFlagRule(ctx, flgID, flaggio.NewFlagRule{})
+	assert.NoError(t, err, "failed to create rule")
+	err = flgRepo.Update(ctx, flgID, flaggio.UpdateFlag{Protected: boolPtr(true), RequiredApprovals: intPtr(2)})
+	assert.NoError(t, err, "failed to protect flag")
+
+	var cr1ID, cr2ID, cr3ID, cr4ID string
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T)
+	}{
+		{
+			name: "create a change request for the flag",
+			run: func(t *testing.T) {
+				cr1ID, err = repo.Create(ctx, flgID, &flaggio.ChangeRequest{
+					EntityType:        flaggio.EntityTypeFlag,
+					EntityID:          flgID,
+					FlagVersion:       4,
+					Diff:              []*flaggio.FieldChange{{Field: "name", OldValue: "Test", NewValue: "New"}},
+					Author:            "john",
+					RequiredApprovals: 2,
+					FlagInput:         &flaggio.UpdateFlag{Name: stringPtr("New")},
+				})
+				assert.NoError(t, err, "failed to create change request")
+			},
+		},
+		{
+			name: "checks the change request was created",
+			run: func(t *testing.T) {
+				cr, err := repo.FindByID(ctx, flgID, cr1ID)
+				assert.NoError(t, err, "failed to find change request")
+				assert.Equal(t, flaggio.ChangeRequestStatusPending, cr.Status)
+				assert.Equal(t, flaggio.EntityTypeFlag, cr.EntityType)
+				assert.Equal(t, "john", cr.Author)
+				assert.Equal(t, []*flaggio.FieldChange{{Field: "name", OldValue: "Test", NewValue: "New"}}, cr.Diff)
+				assert.Empty(t, cr.Approvals)
+
+				// creating a change request doesn't change the flag version
+				flg, err := flgRepo.FindByID(ctx, flgID)
+				assert.NoError(t, err, "failed to find flag")
+				assert.Equal(t, 4, flg.Version)
+				assert.Len(t, flg.ChangeRequests, 1)
+			},
+		},
+		{
+			name: "fails to approve own change request",
+			run: func(t *testing.T) {
+				_, err := repo.Approve(ctx, flgID, cr1ID, "john")
+				assert.EqualError(t, err, "bad request: change request must be reviewed by another user")
+			},
+		},
+		{
+			name: "approves the change request without applying it",
+			run: func(t *testing.T) {
+				applied, err := repo.Approve(ctx, flgID, cr1ID, "jane")
+				assert.NoError(t, err, "failed to approve change request")
+				assert.False(t, applied)
+
+				flg, err := flgRepo.FindByID(ctx, flgID)
+				assert.NoError(t, err, "failed to find flag")
+				assert.Equal(t, "Test", flg.Name)
+				assert.Equal(t, 4, flg.Version)
+			},
+		},
+		{
+			name: "fails to approve the change request twice",
+			run: func(t *testing.T) {
+				_, err := repo.Approve(ctx, flgID, cr1ID, "jane")
+				assert.EqualError(t, err, "bad request: change request was already approved by this user")
+			},
+		},
+		{
+			name: "applies the change request on the last approval",
+			run: func(t *testing.T) {
+				applied, err := repo.Approve(ctx, flgID, cr1ID, "mary")
+				assert.NoError(t, err, "failed to approve change request")
+				assert.True(t, applied)
+
+				flg, err := flgRepo.FindByID(ctx, flgID)
+				assert.NoError(t, err, "failed to find flag")
+				assert.Equal(t, "New", flg.Name)
+				assert.Equal(t, 5, flg.Version)
+
+				cr, err := repo.FindByID(ctx, flgID, cr1ID)
+				assert.NoError(t, err, "failed to find change request")
+				assert.Equal(t, flaggio.ChangeRequestStatusApplied, cr.Status)
+				assert.Len(t, cr.Approvals, 2)
+				assert.Equal(t, "jane", cr.Approvals[0].User)
+				assert.Equal(t, "mary", cr.Approvals[1].User)
+			},
+		},
+		{
+			name: "applies a change request for a variant",
+			run: func(t *testing.T) {
+				cr2ID, err = repo.Create(ctx, flgID, &flaggio.ChangeRequest{
+					EntityType:        flaggio.EntityTypeVariant,
+					EntityID:          vrntID,
+					FlagVersion:       5,
+					Author:            "john",
+					RequiredApprovals: 1,
+					VariantInput:      &flaggio.UpdateVariant{Value: 2},
+				})
+				assert.NoError(t, err, "failed to create change request")
+				applied, err := repo.Approve(ctx, flgID, cr2ID, "jane")
+				assert.NoError(t, err, "failed to approve change request")
+				assert.True(t, applied)
+
+				vrnt, err := vrntRepo.FindByID(ctx, flgID, vrntID)
+				assert.NoError(t, err, "failed to find variant")
+				assert.EqualValues(t, 2, vrnt.Value)
+			},
+		},
+		{
+			name: "applies a change request for a rule",
+			run: func(t *testing.T) {
+				cr3ID, err = repo.Create(ctx, flgID, &flaggio.ChangeRequest{
+					EntityType:        flaggio.EntityTypeRule,
+					EntityID:          rlID,
+					FlagVersion:       6,
+					Author:            "john",
+					RequiredApprovals: 1,
+					FlagRuleInput: &flaggio.UpdateFlagRule{
+						Constraints:   []*flaggio.NewConstraint{{Property: stringPtr("name"), Operation: flaggio.OperationOneOf, Values: []interface{}{"john"}}},
+						Distributions: []*flaggio.NewDistribution{{VariantID: vrntID, Percentage: 100}},
+					},
+				})
+				assert.NoError(t, err, "failed to create change request")
+				applied, err := repo.Approve(ctx, flgID, cr3ID, "jane")
+				assert.NoError(t, err, "failed to approve change request")
+				assert.True(t, applied)
+
+				rl, err := rlRepo.FindFlagRuleByID(ctx, flgID, rlID)
+				assert.NoError(t, err, "failed to find rule")
+				assert.Len(t, rl.Constraints, 1)
+				assert.Len(t, rl.Distributions, 1)
+			},
+		},
+		{
+			name: "fails to apply a change request when the flag changed",
+			run: func(t *testing.T) {
+				cr4ID, err = repo.Create(ctx, flgID, &flaggio.ChangeRequest{
+					EntityType:        flaggio.EntityTypeFlag,
+					EntityID:          flgID,
+					FlagVersion:       6,
+					Author:            "john",
+					RequiredApprovals: 1,
+					FlagInput:         &flaggio.UpdateFlag{Enabled: boolPtr(true)},
+				})
+				assert.NoError(t, err, "failed to create change request")
+				_, err := repo.Approve(ctx, flgID, cr4ID, "jane")
+				assert.EqualError(t, err, "bad request: flag was changed after the change request was created")
+			},
+		},
+		{
+			name: "rejects the change request",
+			run: func(t *testing.T) {
+				err := repo.Reject(ctx, flgID, cr4ID, "jane")
+				assert.NoError(t, err, "failed to reject change request")
+
+				cr, err := repo.FindByID(ctx, flgID, cr4ID)
+				assert.NoError(t, err, "failed to find change request")
+				assert.Equal(t, flaggio.ChangeRequestStatusRejected, cr.Status)
+				assert.Equal(t, stringPtr("jane"), cr.RejectedBy)
+
+				err = repo.Reject(ctx, flgID, cr4ID, "jane")
+				assert.EqualError(t, err, "bad request: change request is not pending")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, tt.run)
+	}
+
+}
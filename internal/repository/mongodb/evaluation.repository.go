@@ -161,6 +161,9 @@ func (r *EvaluationRepository) ReplaceOne(ctx context.Context, userID string, ev
 		FlagVersion: eval.FlagVersion,
 		RequestHash: eval.RequestHash,
 		UserID:      userID,
+		VariantID:   eval.VariantID,
+		Reason:      string(eval.Reason),
+		RuleName:    eval.RuleName,
 		Value:       eval.Value,
 		CreatedAt:   time.Now(),
 	})
@@ -201,6 +204,9 @@ func (r *EvaluationRepository) ReplaceAll(ctx context.Context, userID, reqHash s
 			FlagVersion: eval.FlagVersion,
 			RequestHash: eval.RequestHash,
 			UserID:      userID,
+			VariantID:   eval.VariantID,
+			Reason:      string(eval.Reason),
+			RuleName:    eval.RuleName,
 			Value:       eval.Value,
 			CreatedAt:   time.Now(),
 		}
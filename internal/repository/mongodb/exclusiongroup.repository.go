@@ -0,0 +1,237 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uw-labs/flaggio/internal/errors"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var _ repository.ExclusionGroup = (*ExclusionGroupRepository)(nil)
+
+// ExclusionGroupRepository implements repository.ExclusionGroup interface using mongodb.
+type ExclusionGroupRepository struct {
+	db       *mongo.Database
+	col      *mongo.Collection
+	flagsCol *mongo.Collection
+}
+
+// FindAll returns all exclusion groups.
+func (r *ExclusionGroupRepository) FindAll(ctx context.Context) ([]*flaggio.ExclusionGroup, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoExclusionGroupRepository.FindAll")
+	defer span.Finish()
+
+	cursor, err := r.col.Find(ctx, bson.M{}, &options.FindOptions{
+		Sort:      bson.M{"name": 1},
+		Collation: &options.Collation{Locale: "en"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []*flaggio.ExclusionGroup
+	for cursor.Next(ctx) {
+		var g exclusionGroupModel
+		// decode the document
+		if err := cursor.Decode(&g); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g.asExclusionGroup())
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// FindByID returns an exclusion group that has a given ID.
+func (r *ExclusionGroupRepository) FindByID(ctx context.Context, idHex string) (*flaggio.ExclusionGroup, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoExclusionGroupRepository.FindByID")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return nil, err
+	}
+
+	var g exclusionGroupModel
+	if err := r.col.FindOne(ctx, bson.M{"_id": id}).Decode(&g); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.NotFound("exclusion group")
+		}
+		return nil, err
+	}
+	return g.asExclusionGroup(), nil
+}
+
+// Create creates a new exclusion group.
+func (r *ExclusionGroupRepository) Create(ctx context.Context, g flaggio.NewExclusionGroup) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoExclusionGroupRepository.Create")
+	defer span.Finish()
+
+	allocs, allocModels, err := newSlotAllocationModels(g.Allocations)
+	if err != nil {
+		return "", err
+	}
+	if err := r.checkFlagsExist(ctx, allocModels); err != nil {
+		return "", err
+	}
+	id := primitive.NewObjectID()
+	_, err = r.col.InsertOne(ctx, &exclusionGroupModel{
+		ID:          id,
+		Name:        g.Name,
+		Description: g.Description,
+		Allocations: allocModels,
+		CreatedAt:   time.Now(),
+	})
+	if err != nil {
+		return "", allocationErr(err)
+	}
+	return id.Hex(), r.incFlagVersions(ctx, flaggio.ChangedSlotAllocations(nil, allocs))
+}
+
+// Update updates an exclusion group.
+func (r *ExclusionGroupRepository) Update(ctx context.Context, idHex string, g flaggio.UpdateExclusionGroup) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoExclusionGroupRepository.Update")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	mods := bson.M{
+		"updatedAt": time.Now(),
+	}
+	if g.Name != nil {
+		mods["name"] = *g.Name
+	}
+	if g.Description != nil {
+		mods["description"] = *g.Description
+	}
+	var changed []string
+	if g.Allocations != nil {
+		allocs, allocModels, err := newSlotAllocationModels(g.Allocations)
+		if err != nil {
+			return err
+		}
+		if err := r.checkFlagsExist(ctx, allocModels); err != nil {
+			return err
+		}
+		current, err := r.FindByID(ctx, idHex)
+		if err != nil {
+			return err
+		}
+		changed = flaggio.ChangedSlotAllocations(current.Allocations, allocs)
+		mods["allocations"] = allocModels
+	}
+	res, err := r.col.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": mods})
+	if err != nil {
+		return allocationErr(err)
+	}
+	if res.MatchedCount == 0 {
+		return errors.NotFound("exclusion group")
+	}
+	return r.incFlagVersions(ctx, changed)
+}
+
+// Delete deletes an exclusion group.
+func (r *ExclusionGroupRepository) Delete(ctx context.Context, idHex string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoExclusionGroupRepository.Delete")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	var g exclusionGroupModel
+	if err := r.col.FindOneAndDelete(ctx, bson.M{"_id": id}).Decode(&g); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return errors.NotFound("exclusion group")
+		}
+		return err
+	}
+	return r.incFlagVersions(ctx, flaggio.ChangedSlotAllocations(g.asExclusionGroup().Allocations, nil))
+}
+
+// checkFlagsExist returns an error if any of the flags given a slice of
+// the group doesn't exist.
+func (r *ExclusionGroupRepository) checkFlagsExist(ctx context.Context, allocs []slotAllocationModel) error {
+	if len(allocs) == 0 {
+		return nil
+	}
+	flagIDs := make([]primitive.ObjectID, len(allocs))
+	for idx, alloc := range allocs {
+		flagIDs[idx] = alloc.FlagID
+	}
+	count, err := r.flagsCol.CountDocuments(ctx, bson.M{"_id": bson.M{"$in": flagIDs}})
+	if err != nil {
+		return err
+	}
+	if count != int64(len(flagIDs)) {
+		return errors.NotFound("flag")
+	}
+	return nil
+}
+
+// incFlagVersions increases the version of the flags, since the users they
+// are served to changed, so previous evaluations are discarded.
+func (r *ExclusionGroupRepository) incFlagVersions(ctx context.Context, flagIDHexes []string) error {
+	if len(flagIDHexes) == 0 {
+		return nil
+	}
+	flagIDs := make([]primitive.ObjectID, len(flagIDHexes))
+	for idx, flagIDHex := range flagIDHexes {
+		flagID, err := primitive.ObjectIDFromHex(flagIDHex)
+		if err != nil {
+			return err
+		}
+		flagIDs[idx] = flagID
+	}
+	_, err := r.flagsCol.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": flagIDs}}, bson.M{
+		"$set": bson.M{"updatedAt": time.Now()},
+		"$inc": bson.M{"version": 1},
+	})
+	return err
+}
+
+// allocationErr returns a bad request error if the group gives a slice to
+// a flag that's already in another group.
+func allocationErr(err error) error {
+	if mongo.IsDuplicateKeyError(err) {
+		return errors.BadRequest("flag is already in another exclusion group")
+	}
+	return err
+}
+
+// NewExclusionGroupRepository returns a new exclusion group repository that uses mongodb as
+// underlying storage. It also creates all needed indexes, if they don't yet exist.
+func NewExclusionGroupRepository(ctx context.Context, db *mongo.Database) (repository.ExclusionGroup, error) {
+	col := db.Collection("exclusionGroups")
+	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			// a flag can only be in one group. groups without flags are
+			// left out of the index, since empty lists would be duplicates
+			Keys: bson.D{{Key: "allocations.flagId", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{
+				"allocations.flagId": bson.M{"$exists": true},
+			}),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ExclusionGroupRepository{
+		db:       db,
+		col:      col,
+		flagsCol: db.Collection("flags"),
+	}, nil
+}
@@ -0,0 +1,173 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	mongo_repo "github.com/uw-labs/flaggio/internal/repository/mongodb"
+)
+
+func TestExclusionGroupRepository(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	// drop database first
+	if err := mongoDB.Drop(ctx); err != nil {
+		t.Fatalf("failed drop database: %s", err)
+	}
+
+	// create new repos
+	flagRepo, err := mongo_repo.NewFlagRepository(ctx, mongoDB)
+	if err != nil {
+		t.Fatalf("failed to create flag repository: %s", err)
+	}
+	repo, err := mongo_repo.NewExclusionGroupRepository(ctx, mongoDB)
+	if err != nil {
+		t.Fatalf("failed to create exclusion group repository: %s", err)
+	}
+	flg1ID, err := flagRepo.Create(ctx, flaggio.NewFlag{Key: "a", Name: "a"})
+	if err != nil {
+		t.Fatalf("failed to create flag: %s", err)
+	}
+	flg2ID, err := flagRepo.Create(ctx, flaggio.NewFlag{Key: "b", Name: "b"})
+	if err != nil {
+		t.Fatalf("failed to create flag: %s", err)
+	}
+	flagVersion := func(t *testing.T, id string) int {
+		flg, err := flagRepo.FindByID(ctx, id)
+		assert.NoError(t, err, "failed to find flag")
+		return flg.Version
+	}
+
+	var grp1ID, grp2ID string
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T)
+	}{
+		{
+			name: "fails to create a group with overlapping slots",
+			run: func(t *testing.T) {
+				_, err := repo.Create(ctx, flaggio.NewExclusionGroup{Name: "checkout", Allocations: []*flaggio.NewSlotAllocation{
+					{FlagID: flg1ID, Start: 0, End: 500},
+					{FlagID: flg2ID, Start: 499, End: 1000},
+				}})
+				assert.EqualError(t, err, "bad request: slots of flags "+flg1ID+" and "+flg2ID+" overlap")
+			},
+		},
+		{
+			name: "fails to create a group with an unknown flag",
+			run: func(t *testing.T) {
+				_, err := repo.Create(ctx, flaggio.NewExclusionGroup{Name: "checkout", Allocations: []*flaggio.NewSlotAllocation{
+					{FlagID: "5e5e3b1fa0c1b2a6e0d6e3b1", Start: 0, End: 500},
+				}})
+				assert.EqualError(t, err, "flag: not found")
+			},
+		},
+		{
+			name: "create the first group",
+			run: func(t *testing.T) {
+				grp1ID, err = repo.Create(ctx, flaggio.NewExclusionGroup{Name: "checkout", Allocations: []*flaggio.NewSlotAllocation{
+					{FlagID: flg1ID, Start: 0, End: 500},
+				}})
+				assert.NoError(t, err, "failed to create first group")
+				assert.Equal(t, 2, flagVersion(t, flg1ID))
+				assert.Equal(t, 1, flagVersion(t, flg2ID))
+			},
+		},
+		{
+			name: "create the second group",
+			run: func(t *testing.T) {
+				grp2ID, err = repo.Create(ctx, flaggio.NewExclusionGroup{Name: "pricing"})
+				assert.NoError(t, err, "failed to create second group")
+			},
+		},
+		{
+			name: "fails to give a flag a slice of another group",
+			run: func(t *testing.T) {
+				err := repo.Update(ctx, grp2ID, flaggio.UpdateExclusionGroup{Allocations: []*flaggio.NewSlotAllocation{
+					{FlagID: flg1ID, Start: 0, End: 100},
+				}})
+				assert.EqualError(t, err, "bad request: flag is already in another exclusion group")
+			},
+		},
+		{
+			name: "checks the groups were created",
+			run: func(t *testing.T) {
+				grps, err := repo.FindAll(ctx)
+				assert.NoError(t, err, "failed to find groups")
+				assert.Len(t, grps, 2)
+				assert.Equal(t, grp1ID, grps[0].ID)
+				assert.Equal(t, "checkout", grps[0].Name)
+				assert.Equal(t, []*flaggio.SlotAllocation{{FlagID: flg1ID, Start: 0, End: 500}}, grps[0].Allocations)
+				assert.Equal(t, grp2ID, grps[1].ID)
+				assert.Empty(t, grps[1].Allocations)
+			},
+		},
+		{
+			name: "updates the name without changing the flags",
+			run: func(t *testing.T) {
+				err := repo.Update(ctx, grp1ID, flaggio.UpdateExclusionGroup{Name: stringPtr("new checkout")})
+				assert.NoError(t, err, "failed to update group")
+				grp, err := repo.FindByID(ctx, grp1ID)
+				assert.NoError(t, err, "failed to find group")
+				assert.Equal(t, "new checkout", grp.Name)
+				assert.NotNil(t, grp.UpdatedAt)
+				assert.Equal(t, 2, flagVersion(t, flg1ID))
+			},
+		},
+		{
+			name: "updates the slots of the changed flags only",
+			run: func(t *testing.T) {
+				err := repo.Update(ctx, grp1ID, flaggio.UpdateExclusionGroup{Allocations: []*flaggio.NewSlotAllocation{
+					{FlagID: flg1ID, Start: 0, End: 500},
+					{FlagID: flg2ID, Start: 500, End: 1000},
+				}})
+				assert.NoError(t, err, "failed to update group")
+				grp, err := repo.FindByID(ctx, grp1ID)
+				assert.NoError(t, err, "failed to find group")
+				assert.Len(t, grp.Allocations, 2)
+				assert.Equal(t, 2, flagVersion(t, flg1ID))
+				assert.Equal(t, 2, flagVersion(t, flg2ID))
+			},
+		},
+		{
+			name: "deletes the group and changes its flags",
+			run: func(t *testing.T) {
+				err := repo.Delete(ctx, grp1ID)
+				assert.NoError(t, err, "failed to delete group")
+				_, err = repo.FindByID(ctx, grp1ID)
+				assert.EqualError(t, err, "exclusion group: not found")
+				assert.Equal(t, 3, flagVersion(t, flg1ID))
+				assert.Equal(t, 3, flagVersion(t, flg2ID))
+			},
+		},
+		{
+			name: "fails to delete a group that doesn't exist",
+			run: func(t *testing.T) {
+				err := repo.Delete(ctx, grp1ID)
+				assert.EqualError(t, err, "exclusion group: not found")
+			},
+		},
+		{
+			name: "removes deleted flags from their group",
+			run: func(t *testing.T) {
+				err := repo.Update(ctx, grp2ID, flaggio.UpdateExclusionGroup{Allocations: []*flaggio.NewSlotAllocation{
+					{FlagID: flg1ID, Start: 0, End: 500},
+					{FlagID: flg2ID, Start: 500, End: 1000},
+				}})
+				assert.NoError(t, err, "failed to update group")
+				assert.NoError(t, flagRepo.Archive(ctx, flg2ID), "failed to archive flag")
+				assert.NoError(t, flagRepo.Delete(ctx, flg2ID), "failed to delete flag")
+				grp, err := repo.FindByID(ctx, grp2ID)
+				assert.NoError(t, err, "failed to find group")
+				assert.Equal(t, []*flaggio.SlotAllocation{{FlagID: flg1ID, Start: 0, End: 500}}, grp.Allocations)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, tt.run)
+	}
+}
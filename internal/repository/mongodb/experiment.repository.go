@@ -0,0 +1,175 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uw-labs/flaggio/internal/exposure"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var _ repository.Experiment = (*ExperimentRepository)(nil)
+
+// ExperimentRepository implements repository.Experiment interface using mongodb.
+type ExperimentRepository struct {
+	db             *mongo.Database
+	exposuresCol   *mongo.Collection
+	conversionsCol *mongo.Collection
+}
+
+// CreateExposures records the variant each user was first exposed to, per flag.
+// Exposures of users that were already exposed to the flag are ignored.
+func (r *ExperimentRepository) CreateExposures(ctx context.Context, events []exposure.Event) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoExperimentRepository.CreateExposures")
+	defer span.Finish()
+
+	var mdls []mongo.WriteModel
+	for _, evt := range events {
+		if evt.VariantID == "" {
+			continue
+		}
+		mdls = append(mdls, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"flagKey": evt.FlagKey, "userId": evt.UserID}).
+			SetUpdate(bson.M{"$setOnInsert": &exposureModel{
+				ID:          primitive.NewObjectID(),
+				FlagKey:     evt.FlagKey,
+				FlagVersion: evt.FlagVersion,
+				VariantID:   evt.VariantID,
+				UserID:      evt.UserID,
+				CreatedAt:   evt.Timestamp,
+			}}).
+			SetUpsert(true))
+	}
+	if len(mdls) == 0 {
+		return nil
+	}
+	_, err := r.exposuresCol.BulkWrite(ctx, mdls, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// CreateConversion records a conversion of a user on a metric.
+func (r *ExperimentRepository) CreateConversion(ctx context.Context, conversion flaggio.Conversion) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoExperimentRepository.CreateConversion")
+	defer span.Finish()
+
+	_, err := r.conversionsCol.InsertOne(ctx, &conversionModel{
+		ID:        primitive.NewObjectID(),
+		UserID:    conversion.UserID,
+		MetricKey: conversion.MetricKey,
+		CreatedAt: conversion.CreatedAt,
+	})
+	return err
+}
+
+// CountByVariant returns how many users were exposed to each variant of the flag and,
+// for each metric, how many of them converted after the exposure.
+func (r *ExperimentRepository) CountByVariant(ctx context.Context, flagKey string, metricKeys []string) ([]*flaggio.VariantCount, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoExperimentRepository.CountByVariant")
+	defer span.Finish()
+
+	// count the exposed users per variant
+	cursor, err := r.exposuresCol.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"flagKey": flagKey}}},
+		{{Key: "$group", Value: bson.M{"_id": "$variantId", "count": bson.M{"$sum": 1}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var exposures []struct {
+		VariantID string `bson:"_id"`
+		Count     int    `bson:"count"`
+	}
+	if err := cursor.All(ctx, &exposures); err != nil {
+		return nil, err
+	}
+
+	// count the exposed users that converted per variant and metric
+	cursor, err = r.exposuresCol.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"flagKey": flagKey}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from": r.conversionsCol.Name(),
+			"let":  bson.M{"userId": "$userId", "exposedAt": "$createdAt"},
+			"pipeline": mongo.Pipeline{
+				{{Key: "$match", Value: bson.M{
+					"metric": bson.M{"$in": metricKeys},
+					"$expr": bson.M{"$and": bson.A{
+						bson.M{"$eq": bson.A{"$userId", "$$userId"}},
+						bson.M{"$gte": bson.A{"$createdAt", "$$exposedAt"}},
+					}},
+				}}},
+				{{Key: "$group", Value: bson.M{"_id": "$metric"}}},
+			},
+			"as": "converted",
+		}}},
+		{{Key: "$unwind", Value: "$converted"}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"variantId": "$variantId", "metric": "$converted._id"},
+			"count": bson.M{"$sum": 1},
+		}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var conversions []struct {
+		ID struct {
+			VariantID string `bson:"variantId"`
+			MetricKey string `bson:"metric"`
+		} `bson:"_id"`
+		Count int `bson:"count"`
+	}
+	if err := cursor.All(ctx, &conversions); err != nil {
+		return nil, err
+	}
+
+	type countKey struct{ variantID, metricKey string }
+	conversionsMap := make(map[countKey]int, len(conversions))
+	for _, c := range conversions {
+		conversionsMap[countKey{c.ID.VariantID, c.ID.MetricKey}] = c.Count
+	}
+	counts := make([]*flaggio.VariantCount, 0, len(exposures)*len(metricKeys))
+	for _, e := range exposures {
+		for _, metricKey := range metricKeys {
+			counts = append(counts, &flaggio.VariantCount{
+				VariantID:   e.VariantID,
+				MetricKey:   metricKey,
+				Exposures:   e.Count,
+				Conversions: conversionsMap[countKey{e.VariantID, metricKey}],
+			})
+		}
+	}
+	return counts, nil
+}
+
+// NewExperimentRepository returns a new experiment repository that uses mongodb as
+// underlying storage. It also creates all needed indexes, if they don't yet exist.
+func NewExperimentRepository(ctx context.Context, db *mongo.Database) (repository.Experiment, error) {
+	exposuresCol := db.Collection("exposures")
+	_, err := exposuresCol.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "flagKey", Value: 1}, {Key: "userId", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	conversionsCol := db.Collection("conversions")
+	_, err = conversionsCol.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "userId", Value: 1}, {Key: "metric", Value: 1}, {Key: "createdAt", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ExperimentRepository{
+		db:             db,
+		exposuresCol:   exposuresCol,
+		conversionsCol: conversionsCol,
+	}, nil
+}
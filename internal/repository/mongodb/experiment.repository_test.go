@@ -0,0 +1,90 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/exposure"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	mongo_repo "github.com/uw-labs/flaggio/internal/repository/mongodb"
+)
+
+func TestExperimentRepository(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	// drop database first
+	if err := mongoDB.Drop(ctx); err != nil {
+		t.Fatalf("failed drop database: %s", err)
+	}
+
+	// create new repo
+	repo, err := mongo_repo.NewExperimentRepository(ctx, mongoDB)
+	assert.NoError(t, err, "failed to create experiment repository")
+
+	now := time.Now().Truncate(time.Millisecond)
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T)
+	}{
+		{
+			name: "create exposures",
+			run: func(t *testing.T) {
+				err := repo.CreateExposures(ctx, []exposure.Event{
+					{FlagKey: "a", FlagVersion: 1, VariantID: "1", UserID: "u1", Timestamp: now},
+					{FlagKey: "a", FlagVersion: 1, VariantID: "1", UserID: "u2", Timestamp: now},
+					{FlagKey: "a", FlagVersion: 1, VariantID: "2", UserID: "u3", Timestamp: now},
+					{FlagKey: "b", FlagVersion: 1, VariantID: "3", UserID: "u1", Timestamp: now},
+					// exposures without variant are ignored
+					{FlagKey: "a", FlagVersion: 1, UserID: "u4", Timestamp: now},
+				})
+				assert.NoError(t, err, "failed to create exposures")
+			},
+		},
+		{
+			name: "only the first exposure is kept",
+			run: func(t *testing.T) {
+				err := repo.CreateExposures(ctx, []exposure.Event{
+					{FlagKey: "a", FlagVersion: 2, VariantID: "2", UserID: "u1", Timestamp: now.Add(time.Minute)},
+				})
+				assert.NoError(t, err, "failed to create exposures")
+			},
+		},
+		{
+			name: "create conversions",
+			run: func(t *testing.T) {
+				conversions := []flaggio.Conversion{
+					{UserID: "u1", MetricKey: "purchase", CreatedAt: now.Add(time.Second)},
+					{UserID: "u1", MetricKey: "purchase", CreatedAt: now.Add(2 * time.Second)},
+					{UserID: "u3", MetricKey: "purchase", CreatedAt: now.Add(time.Second)},
+					{UserID: "u3", MetricKey: "signup", CreatedAt: now.Add(time.Second)},
+					// conversions before the exposure are ignored
+					{UserID: "u2", MetricKey: "purchase", CreatedAt: now.Add(-time.Second)},
+				}
+				for _, c := range conversions {
+					assert.NoError(t, repo.CreateConversion(ctx, c), "failed to create conversion")
+				}
+			},
+		},
+		{
+			name: "count by variant",
+			run: func(t *testing.T) {
+				counts, err := repo.CountByVariant(ctx, "a", []string{"purchase", "signup"})
+				assert.NoError(t, err, "failed to count by variant")
+				assert.ElementsMatch(t, []*flaggio.VariantCount{
+					{VariantID: "1", MetricKey: "purchase", Exposures: 2, Conversions: 1},
+					{VariantID: "1", MetricKey: "signup", Exposures: 2, Conversions: 0},
+					{VariantID: "2", MetricKey: "purchase", Exposures: 1, Conversions: 1},
+					{VariantID: "2", MetricKey: "signup", Exposures: 1, Conversions: 1},
+				}, counts)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, tt.run)
+	}
+}
@@ -2,6 +2,7 @@ package mongodb
 
 import (
 	"context"
+	"net/url"
 	"regexp"
 	"time"
 
@@ -17,24 +18,54 @@ import (
 
 var _ repository.Flag = (*FlagRepository)(nil)
 
+const flagsTextIndex = "flags_text"
+
 // FlagRepository implements repository.Flag interface using mongodb.
 type FlagRepository struct {
 	db  *mongo.Database
 	col *mongo.Collection
 }
 
-// FindAll returns a list of flags, based on an optional offset and limit.
-func (r *FlagRepository) FindAll(ctx context.Context, search *string, offset, limit *int64) (*flaggio.FlagResults, error) {
+// FindAll returns a list of flags, based on an optional search, filter, offset and limit.
+func (r *FlagRepository) FindAll(ctx context.Context, search *string, flgFilter *flaggio.FlagFilter, offset, limit *int64) (*flaggio.FlagResults, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagRepository.FindAll")
 	defer span.Finish()
 
-	filter := bson.M{}
+	var conditions []bson.M
 	if search != nil {
-		filter["$or"] = []bson.M{
+		conditions = append(conditions, bson.M{"$or": []bson.M{
 			{"key": primitive.Regex{Pattern: regexp.QuoteMeta(*search), Options: "i"}},
 			{"$text": bson.M{"$search": *search}},
+		}})
+	}
+	if flgFilter != nil {
+		if len(flgFilter.Keys) > 0 {
+			conditions = append(conditions, bson.M{"key": bson.M{"$in": flgFilter.Keys}})
+		}
+		if len(flgFilter.Tags) > 0 {
+			conditions = append(conditions, bson.M{"tags": bson.M{"$all": flgFilter.Tags}})
+		}
+		if flgFilter.Owner != nil {
+			// the owner is either the owning team or the maintainer
+			conditions = append(conditions, bson.M{"$or": []bson.M{
+				{"ownerTeam": *flgFilter.Owner},
+				{"maintainer": *flgFilter.Owner},
+			}})
+		}
+		if flgFilter.Enabled != nil {
+			conditions = append(conditions, bson.M{"enabled": *flgFilter.Enabled})
 		}
+		if flgFilter.ClientExposed != nil {
+			conditions = append(conditions, bson.M{"clientExposed": *flgFilter.ClientExposed})
+		}
+	}
+	// archived flags are hidden, unless asked for
+	if flgFilter != nil && flgFilter.Archived != nil && *flgFilter.Archived {
+		conditions = append(conditions, bson.M{"archived": true})
+	} else {
+		conditions = append(conditions, bson.M{"archived": bson.M{"$ne": true}})
 	}
+	filter := bson.M{"$and": conditions}
 	cursor, err := r.col.Find(ctx, filter, &options.FindOptions{
 		Skip:      offset,
 		Limit:     limit,
@@ -116,6 +147,13 @@ func (r *FlagRepository) Create(ctx context.Context, f flaggio.NewFlag) (string,
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagRepository.Create")
 	defer span.Finish()
 
+	if err := validateFlagLinks(f.Links); err != nil {
+		return "", err
+	}
+	kind := flaggio.FlagKindTemporary
+	if f.Kind != nil {
+		kind = *f.Kind
+	}
 	id := primitive.NewObjectID()
 	_, err := r.col.InsertOne(ctx, &flagModel{
 		ID:          id,
@@ -123,10 +161,21 @@ func (r *FlagRepository) Create(ctx context.Context, f flaggio.NewFlag) (string,
 		Key:         f.Key,
 		Name:        f.Name,
 		Description: f.Description,
+		Kind:        kind,
+		Tags:        nonNilStrings(f.Tags),
+		OwnerTeam:   f.OwnerTeam,
+		Maintainer:  f.Maintainer,
+		Links:       nonNilStrings(f.Links),
+		ExpiresAt:   f.ExpiresAt,
 		Enabled:     false,
 		Version:     1,
 		Variants:    []variantModel{},
 		Rules:       []flagRuleModel{},
+		Metrics:     []metricModel{},
+		// a single approval is required by default once the flag is protected
+		RequiredApprovals: 1,
+		ChangeRequests:    []changeRequestModel{},
+		ClientExposed:     f.ClientExposed != nil && *f.ClientExposed,
 	})
 	if err != nil {
 		return "", err
@@ -134,6 +183,35 @@ func (r *FlagRepository) Create(ctx context.Context, f flaggio.NewFlag) (string,
 	return id.Hex(), nil
 }
 
+// Clone creates a copy of a flag, including its variants and rules, with a new key and name.
+// All copied entities get new IDs, so the copy is disabled and independent of the original flag.
+func (r *FlagRepository) Clone(ctx context.Context, idHex, key, name string) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagRepository.Clone")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return "", err
+	}
+
+	var f flagModel
+	if err := r.col.FindOne(ctx, bson.M{"_id": id}).Decode(&f); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return "", errors.NotFound("flag")
+		}
+		return "", err
+	}
+
+	clone := cloneFlagModel(&f, key, name)
+	if _, err := r.col.InsertOne(ctx, clone); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return "", errors.BadRequest("flag key already in use")
+		}
+		return "", err
+	}
+	return clone.ID.Hex(), nil
+}
+
 // Update updates a flag.
 func (r *FlagRepository) Update(ctx context.Context, idHex string, f flaggio.UpdateFlag) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagRepository.Update")
@@ -143,9 +221,188 @@ func (r *FlagRepository) Update(ctx context.Context, idHex string, f flaggio.Upd
 	if err != nil {
 		return err
 	}
-	mods := bson.M{
-		"updatedAt": time.Now(),
+	mods, err := flagUpdateMods(f)
+	if err != nil {
+		return err
+	}
+	mods["updatedAt"] = time.Now()
+	if len(mods) == 0 {
+		return errors.BadRequest("nothing to update")
+	}
+	filter := bson.M{"_id": id}
+	update := bson.M{
+		"$set": mods,
+		"$inc": bson.M{"version": 1},
+	}
+	res, err := r.col.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.ModifiedCount == 0 {
+		return errors.NotFound("flag")
+	}
+	return nil
+}
+
+// Archive archives a flag, hiding it from the list of flags.
+func (r *FlagRepository) Archive(ctx context.Context, idHex string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagRepository.Archive")
+	defer span.Finish()
+
+	return r.setArchived(ctx, idHex, true)
+}
+
+// Restore restores an archived flag.
+func (r *FlagRepository) Restore(ctx context.Context, idHex string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagRepository.Restore")
+	defer span.Finish()
+
+	return r.setArchived(ctx, idHex, false)
+}
+
+// Delete deletes an archived flag.
+func (r *FlagRepository) Delete(ctx context.Context, idHex string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagRepository.Delete")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	res, err := r.col.DeleteOne(ctx, bson.M{"_id": id, "archived": true})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		// check if the flag exists, but is not archived
+		if _, err := r.FindByID(ctx, idHex); err != nil {
+			return err
+		}
+		return errors.BadRequest("flag must be archived before being deleted")
+	}
+	// free the slots of the flag in its exclusion group
+	_, err = r.db.Collection("exclusionGroups").UpdateMany(ctx,
+		bson.M{"allocations.flagId": id},
+		bson.M{"$pull": bson.M{"allocations": bson.M{"flagId": id}}},
+	)
+	return err
+}
+
+func (r *FlagRepository) setArchived(ctx context.Context, idHex string, archived bool) error {
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	// the version changes, so previous evaluations are not reused
+	update := bson.M{"$inc": bson.M{"version": 1}}
+	if archived {
+		now := time.Now()
+		update["$set"] = bson.M{"archived": true, "archivedAt": now, "updatedAt": now}
+	} else {
+		update["$set"] = bson.M{"archived": false, "archivedAt": nil, "updatedAt": time.Now()}
 	}
+	filter := bson.M{"_id": id, "archived": true}
+	if archived {
+		filter["archived"] = bson.M{"$ne": true}
+	}
+	res, err := r.col.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.ModifiedCount == 0 {
+		// check if the flag exists, but is already in the requested state
+		if _, err := r.FindByID(ctx, idHex); err != nil {
+			return err
+		}
+		if archived {
+			return errors.BadRequest("flag is already archived")
+		}
+		return errors.BadRequest("flag is not archived")
+	}
+	return nil
+}
+
+// cloneFlagModel deep copies a flag with new IDs. Variant references in the default
+// variants and distributions are remapped to the new variant IDs.
+func cloneFlagModel(f *flagModel, key, name string) *flagModel {
+	variantIDs := make(map[primitive.ObjectID]primitive.ObjectID, len(f.Variants))
+	variants := make([]variantModel, len(f.Variants))
+	for idx, vrnt := range f.Variants {
+		variantIDs[vrnt.ID] = primitive.NewObjectID()
+		variants[idx] = variantModel{
+			ID:          variantIDs[vrnt.ID],
+			Description: vrnt.Description,
+			Value:       vrnt.Value,
+		}
+	}
+	rules := make([]flagRuleModel, len(f.Rules))
+	for idx, rl := range f.Rules {
+		constraints := cloneConstraintModels(rl.Constraints)
+		distributions := make([]distributionModel, len(rl.Distributions))
+		for didx, dstrbtn := range rl.Distributions {
+			distributions[didx] = distributionModel{
+				ID:         primitive.NewObjectID(),
+				VariantID:  variantIDs[dstrbtn.VariantID],
+				Percentage: dstrbtn.Percentage,
+			}
+		}
+		rules[idx] = flagRuleModel{
+			ID:            primitive.NewObjectID(),
+			Name:          rl.Name,
+			Description:   rl.Description,
+			Disabled:      rl.Disabled,
+			Constraints:   constraints,
+			Distributions: distributions,
+		}
+	}
+	metrics := make([]metricModel, len(f.Metrics))
+	for idx, mtrc := range f.Metrics {
+		mtrc.ID = primitive.NewObjectID()
+		metrics[idx] = mtrc
+	}
+	return &flagModel{
+		ID:                    primitive.NewObjectID(),
+		Key:                   key,
+		Name:                  name,
+		Description:           f.Description,
+		Kind:                  f.Kind,
+		Tags:                  nonNilStrings(f.Tags),
+		OwnerTeam:             f.OwnerTeam,
+		Maintainer:            f.Maintainer,
+		Links:                 nonNilStrings(f.Links),
+		ExpiresAt:             f.ExpiresAt,
+		ClientExposed:         f.ClientExposed,
+		Enabled:               false,
+		Version:               1,
+		Variants:              variants,
+		Rules:                 rules,
+		DefaultVariantWhenOn:  variantIDs[f.DefaultVariantWhenOn],
+		DefaultVariantWhenOff: variantIDs[f.DefaultVariantWhenOff],
+		Experiment:            f.Experiment,
+		Metrics:               metrics,
+		RequiredApprovals:     1,
+		ChangeRequests:        []changeRequestModel{},
+		CreatedAt:             time.Now(),
+	}
+}
+
+// cloneConstraintModels returns a copy of the constraints, including nested
+// ones, with new IDs.
+func cloneConstraintModels(cnstrnts []constraintModel) []constraintModel {
+	constraints := make([]constraintModel, len(cnstrnts))
+	for idx, cnstrnt := range cnstrnts {
+		cnstrnt.ID = primitive.NewObjectID()
+		if len(cnstrnt.Constraints) > 0 {
+			cnstrnt.Constraints = cloneConstraintModels(cnstrnt.Constraints)
+		}
+		constraints[idx] = cnstrnt
+	}
+	return constraints
+}
+
+// flagUpdateMods returns the fields of the flag document modified by the input.
+func flagUpdateMods(f flaggio.UpdateFlag) (bson.M, error) {
+	mods := bson.M{}
 	if f.Key != nil {
 		mods["key"] = *f.Key
 	}
@@ -155,56 +412,88 @@ func (r *FlagRepository) Update(ctx context.Context, idHex string, f flaggio.Upd
 	if f.Description != nil {
 		mods["description"] = *f.Description
 	}
+	if f.Kind != nil {
+		mods["kind"] = *f.Kind
+	}
+	if f.Tags != nil {
+		mods["tags"] = f.Tags
+	}
+	if f.OwnerTeam != nil {
+		mods["ownerTeam"] = *f.OwnerTeam
+	}
+	if f.Maintainer != nil {
+		mods["maintainer"] = *f.Maintainer
+	}
+	if f.Links != nil {
+		if err := validateFlagLinks(f.Links); err != nil {
+			return nil, err
+		}
+		mods["links"] = f.Links
+	}
+	if f.ExpiresAt != nil {
+		mods["expiresAt"] = *f.ExpiresAt
+	}
+	if f.ClientExposed != nil {
+		mods["clientExposed"] = *f.ClientExposed
+	}
 	if f.Enabled != nil {
 		mods["enabled"] = *f.Enabled
 	}
 	if f.DefaultVariantWhenOn != nil {
 		oid, err := primitive.ObjectIDFromHex(*f.DefaultVariantWhenOn)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		mods["defaultVariantWhenOn"] = oid
 	}
 	if f.DefaultVariantWhenOff != nil {
 		oid, err := primitive.ObjectIDFromHex(*f.DefaultVariantWhenOff)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		mods["defaultVariantWhenOff"] = oid
 	}
-	if len(mods) == 0 {
-		return errors.BadRequest("nothing to update")
+	if f.Experiment != nil {
+		mods["experiment"] = *f.Experiment
 	}
-	filter := bson.M{"_id": id}
-	update := bson.M{
-		"$set": mods,
-		"$inc": bson.M{"version": 1},
+	if f.Protected != nil {
+		mods["protected"] = *f.Protected
 	}
-	res, err := r.col.UpdateOne(ctx, filter, update)
-	if err != nil {
-		return err
+	if f.RequiredApprovals != nil {
+		if *f.RequiredApprovals < 1 {
+			return nil, errors.BadRequest("required approvals must be at least 1")
+		}
+		mods["requiredApprovals"] = *f.RequiredApprovals
 	}
-	if res.ModifiedCount == 0 {
-		return errors.NotFound("flag")
+	return mods, nil
+}
+
+func validateFlagLinks(links []string) error {
+	for _, link := range links {
+		u, err := url.Parse(link)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.BadRequest("invalid flag link")
+		}
 	}
 	return nil
 }
 
-// Delete deletes a flag.
-func (r *FlagRepository) Delete(ctx context.Context, idHex string) error {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagRepository.Delete")
-	defer span.Finish()
-
-	id, err := primitive.ObjectIDFromHex(idHex)
-	if err != nil {
-		return err
-	}
-	res, err := r.col.DeleteOne(ctx, bson.M{"_id": id})
+// dropIndexIfExists drops the index with the given name from the collection,
+// if both exist.
+func dropIndexIfExists(ctx context.Context, col *mongo.Collection, name string) error {
+	specs, err := col.Indexes().ListSpecifications(ctx)
 	if err != nil {
+		// the collection doesn't exist yet
+		if cmdErr, ok := err.(mongo.CommandError); ok && cmdErr.Code == 26 {
+			return nil
+		}
 		return err
 	}
-	if res.DeletedCount == 0 {
-		return errors.NotFound("flag")
+	for _, spec := range specs {
+		if spec.Name == name {
+			_, err := col.Indexes().DropOne(ctx, name)
+			return err
+		}
 	}
 	return nil
 }
@@ -213,6 +502,11 @@ func (r *FlagRepository) Delete(ctx context.Context, idHex string) error {
 // It also creates all needed indexes, if they don't yet exist.
 func NewFlagRepository(ctx context.Context, db *mongo.Database) (repository.Flag, error) {
 	col := db.Collection("flags")
+	// a collection can only have one text index, so the one
+	// that only covered the flag name has to be dropped first
+	if err := dropIndexIfExists(ctx, col, "name_text"); err != nil {
+		return nil, err
+	}
 	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
 		{
 			Keys:    bson.D{{Key: "key", Value: 1}},
@@ -239,7 +533,26 @@ func NewFlagRepository(ctx context.Context, db *mongo.Database) (repository.Flag
 			Options: options.Index().SetUnique(true).SetSparse(true),
 		},
 		{
-			Keys: bson.D{{Key: "name", Value: "text"}},
+			Keys:    bson.D{{Key: "metrics._id", Value: 1}},
+			Options: options.Index().SetUnique(true).SetSparse(true),
+		},
+		{
+			Keys:    bson.D{{Key: "changeRequests._id", Value: 1}},
+			Options: options.Index().SetUnique(true).SetSparse(true),
+		},
+		{
+			Keys:    bson.D{{Key: "tags", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "name", Value: "text"},
+				{Key: "description", Value: "text"},
+				{Key: "tags", Value: "text"},
+				{Key: "ownerTeam", Value: "text"},
+				{Key: "maintainer", Value: "text"},
+			},
+			Options: options.Index().SetName(flagsTextIndex),
 		},
 	})
 	if err != nil {
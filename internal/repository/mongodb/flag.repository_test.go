@@ -22,8 +22,8 @@ func TestFlagRepository(t *testing.T) {
 	repo, err := mongo_repo.NewFlagRepository(ctx, mongoDB)
 	assert.NoError(t, err, "failed to create flag repository")
 
-	var flg1ID, flg2ID string
-	var flg1, flg2 *flaggio.Flag
+	var flg1ID, flg2ID, flg3ID string
+	var flg1, flg2, flg3 *flaggio.Flag
 
 	tests := []struct {
 		name string
@@ -71,7 +71,7 @@ func TestFlagRepository(t *testing.T) {
 		{
 			name: "find all flags",
 			run: func(t *testing.T) {
-				flgs, err := repo.FindAll(ctx, nil, nil, nil)
+				flgs, err := repo.FindAll(ctx, nil, nil, nil, nil)
 				assert.NoError(t, err, "failed to find all flags")
 				expectedFlags := &flaggio.FlagResults{
 					Flags: []*flaggio.Flag{flg2, flg1}, // sorted by key
@@ -83,7 +83,7 @@ func TestFlagRepository(t *testing.T) {
 		{
 			name: "search flags",
 			run: func(t *testing.T) {
-				flgs, err := repo.FindAll(ctx, stringPtr("height"), nil, nil)
+				flgs, err := repo.FindAll(ctx, stringPtr("height"), nil, nil, nil)
 				assert.NoError(t, err, "failed to search flags")
 				expectedFlags := &flaggio.FlagResults{Flags: []*flaggio.Flag{flg2}, Total: 1}
 				assert.Equal(t, expectedFlags, flgs)
@@ -92,7 +92,7 @@ func TestFlagRepository(t *testing.T) {
 		{
 			name: "limit flag results",
 			run: func(t *testing.T) {
-				flgs, err := repo.FindAll(ctx, nil, nil, int64Ptr(1))
+				flgs, err := repo.FindAll(ctx, nil, nil, nil, int64Ptr(1))
 				assert.NoError(t, err, "failed to limit flag results")
 				expectedFlags := &flaggio.FlagResults{Flags: []*flaggio.Flag{flg2}, Total: 2}
 				assert.Equal(t, expectedFlags, flgs)
@@ -101,7 +101,7 @@ func TestFlagRepository(t *testing.T) {
 		{
 			name: "limit flag results with offset",
 			run: func(t *testing.T) {
-				flgs, err := repo.FindAll(ctx, nil, int64Ptr(1), int64Ptr(1))
+				flgs, err := repo.FindAll(ctx, nil, nil, int64Ptr(1), int64Ptr(1))
 				assert.NoError(t, err, "failed to limit flag results with offset")
 				expectedFlags := &flaggio.FlagResults{Flags: []*flaggio.Flag{flg1}, Total: 2}
 				assert.Equal(t, expectedFlags, flgs)
@@ -135,6 +135,78 @@ func TestFlagRepository(t *testing.T) {
 				assert.NotNil(t, flg2.UpdatedAt)
 			},
 		},
+		{
+			name: "fails to delete a flag that isn't archived",
+			run: func(t *testing.T) {
+				err := repo.Delete(ctx, flg1ID)
+				assert.EqualError(t, err, "bad request: flag must be archived before being deleted")
+			},
+		},
+		{
+			name: "archive the first flag",
+			run: func(t *testing.T) {
+				err := repo.Archive(ctx, flg1ID)
+				assert.NoError(t, err, "failed to archive first flag")
+			},
+		},
+		{
+			name: "fails to archive an archived flag",
+			run: func(t *testing.T) {
+				err := repo.Archive(ctx, flg1ID)
+				assert.EqualError(t, err, "bad request: flag is already archived")
+			},
+		},
+		{
+			name: "check first flag was archived",
+			run: func(t *testing.T) {
+				flg1, err = repo.FindByID(ctx, flg1ID)
+				assert.NoError(t, err, "failed to find archived flag")
+				assert.True(t, flg1.Archived)
+				assert.NotNil(t, flg1.ArchivedAt)
+				assert.Equal(t, 2, flg1.Version)
+			},
+		},
+		{
+			name: "archived flags are hidden",
+			run: func(t *testing.T) {
+				flgs, err := repo.FindAll(ctx, nil, nil, nil, nil)
+				assert.NoError(t, err, "failed to find all flags")
+				assert.Equal(t, &flaggio.FlagResults{Flags: []*flaggio.Flag{flg2}, Total: 1}, flgs)
+			},
+		},
+		{
+			name: "find archived flags",
+			run: func(t *testing.T) {
+				flgs, err := repo.FindAll(ctx, nil, &flaggio.FlagFilter{Archived: boolPtr(true)}, nil, nil)
+				assert.NoError(t, err, "failed to find archived flags")
+				assert.Equal(t, &flaggio.FlagResults{Flags: []*flaggio.Flag{flg1}, Total: 1}, flgs)
+			},
+		},
+		{
+			name: "restore the first flag",
+			run: func(t *testing.T) {
+				err := repo.Restore(ctx, flg1ID)
+				assert.NoError(t, err, "failed to restore first flag")
+				flg1, err = repo.FindByID(ctx, flg1ID)
+				assert.NoError(t, err, "failed to find restored flag")
+				assert.False(t, flg1.Archived)
+				assert.Nil(t, flg1.ArchivedAt)
+			},
+		},
+		{
+			name: "fails to restore a flag that isn't archived",
+			run: func(t *testing.T) {
+				err := repo.Restore(ctx, flg1ID)
+				assert.EqualError(t, err, "bad request: flag is not archived")
+			},
+		},
+		{
+			name: "archive the first flag again",
+			run: func(t *testing.T) {
+				err := repo.Archive(ctx, flg1ID)
+				assert.NoError(t, err, "failed to archive first flag again")
+			},
+		},
 		{
 			name: "delete the first flag",
 			run: func(t *testing.T) {
@@ -150,6 +222,228 @@ func TestFlagRepository(t *testing.T) {
 				assert.Nil(t, flg1)
 			},
 		},
+		{
+			name: "fails to create a flag with an invalid link",
+			run: func(t *testing.T) {
+				_, err := repo.Create(ctx, flaggio.NewFlag{Key: "invalid", Name: "invalid", Links: []string{"jira/FLAG-1"}})
+				assert.EqualError(t, err, "bad request: invalid flag link")
+			},
+		},
+		{
+			name: "create a flag with metadata",
+			run: func(t *testing.T) {
+				kind := flaggio.FlagKindPermanent
+				flg3ID, err = repo.Create(ctx, flaggio.NewFlag{
+					Key:           "checkout",
+					Name:          "new checkout",
+					Kind:          &kind,
+					Tags:          []string{"checkout", "payments"},
+					OwnerTeam:     stringPtr("payments"),
+					Maintainer:    stringPtr("jane"),
+					Links:         []string{"https://jira.example.com/browse/FLAG-1"},
+					ClientExposed: boolPtr(true),
+				})
+				assert.NoError(t, err, "failed to create flag with metadata")
+			},
+		},
+		{
+			name: "checks the flag metadata",
+			run: func(t *testing.T) {
+				flg3, err = repo.FindByID(ctx, flg3ID)
+				assert.NoError(t, err, "failed to find flag with metadata")
+				expectedFlag := newFlag(flg3ID, "checkout", "new checkout", flg3.CreatedAt)
+				expectedFlag.Kind = flaggio.FlagKindPermanent
+				expectedFlag.Tags = []string{"checkout", "payments"}
+				expectedFlag.OwnerTeam = stringPtr("payments")
+				expectedFlag.Maintainer = stringPtr("jane")
+				expectedFlag.Links = []string{"https://jira.example.com/browse/FLAG-1"}
+				expectedFlag.ClientExposed = true
+				assert.Equal(t, expectedFlag, flg3)
+			},
+		},
+		{
+			name: "filter flags by tag",
+			run: func(t *testing.T) {
+				flgs, err := repo.FindAll(ctx, nil, &flaggio.FlagFilter{Tags: []string{"payments"}}, nil, nil)
+				assert.NoError(t, err, "failed to filter flags by tag")
+				assert.Equal(t, &flaggio.FlagResults{Flags: []*flaggio.Flag{flg3}, Total: 1}, flgs)
+			},
+		},
+		{
+			name: "filter flags by owner",
+			run: func(t *testing.T) {
+				flgs, err := repo.FindAll(ctx, nil, &flaggio.FlagFilter{Owner: stringPtr("jane")}, nil, nil)
+				assert.NoError(t, err, "failed to filter flags by owner")
+				assert.Equal(t, &flaggio.FlagResults{Flags: []*flaggio.Flag{flg3}, Total: 1}, flgs)
+			},
+		},
+		{
+			name: "filter flags by enabled state",
+			run: func(t *testing.T) {
+				flgs, err := repo.FindAll(ctx, nil, &flaggio.FlagFilter{Enabled: boolPtr(true)}, nil, nil)
+				assert.NoError(t, err, "failed to filter flags by enabled state")
+				assert.Equal(t, &flaggio.FlagResults{Flags: []*flaggio.Flag{flg2}, Total: 1}, flgs)
+			},
+		},
+		{
+			name: "filter flags by key",
+			run: func(t *testing.T) {
+				flgs, err := repo.FindAll(ctx, nil, &flaggio.FlagFilter{Keys: []string{"checkout", "unknown"}}, nil, nil)
+				assert.NoError(t, err, "failed to filter flags by key")
+				assert.Equal(t, &flaggio.FlagResults{Flags: []*flaggio.Flag{flg3}, Total: 1}, flgs)
+			},
+		},
+		{
+			name: "filter flags exposed to clients",
+			run: func(t *testing.T) {
+				flgs, err := repo.FindAll(ctx, nil, &flaggio.FlagFilter{ClientExposed: boolPtr(true)}, nil, nil)
+				assert.NoError(t, err, "failed to filter flags exposed to clients")
+				assert.Equal(t, &flaggio.FlagResults{Flags: []*flaggio.Flag{flg3}, Total: 1}, flgs)
+			},
+		},
+		{
+			name: "search and filter flags",
+			run: func(t *testing.T) {
+				flgs, err := repo.FindAll(ctx, stringPtr("payments"), &flaggio.FlagFilter{Enabled: boolPtr(false)}, nil, nil)
+				assert.NoError(t, err, "failed to search and filter flags")
+				assert.Equal(t, &flaggio.FlagResults{Flags: []*flaggio.Flag{flg3}, Total: 1}, flgs)
+			},
+		},
+		{
+			name: "update the flag metadata",
+			run: func(t *testing.T) {
+				err := repo.Update(ctx, flg3ID, flaggio.UpdateFlag{Tags: []string{}, OwnerTeam: stringPtr("growth")})
+				assert.NoError(t, err, "failed to update flag metadata")
+				flg3, err = repo.FindByID(ctx, flg3ID)
+				assert.NoError(t, err, "failed to find flag with metadata again")
+				assert.Equal(t, []string{}, flg3.Tags)
+				assert.Equal(t, stringPtr("growth"), flg3.OwnerTeam)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, tt.run)
+	}
+}
+
+func TestFlagRepository_Clone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	// drop database first
+	if err := mongoDB.Drop(ctx); err != nil {
+		t.Fatalf("failed drop database: %s", err)
+	}
+
+	// create new repo
+	repo, err := mongo_repo.NewFlagRepository(ctx, mongoDB)
+	assert.NoError(t, err, "failed to create flag repository")
+	vrntRepo := mongo_repo.NewVariantRepository(repo.(*mongo_repo.FlagRepository))
+	rlRepo := mongo_repo.NewRuleRepository(repo.(*mongo_repo.FlagRepository), nil)
+
+	// create a flag with variants and a rule
+	flgID, err := repo.Create(ctx, flaggio.NewFlag{Key: "test", Name: "testing", Tags: []string{"checkout"}})
+	assert.NoError(t, err, "failed to create flag")
+	vrnt1ID, err := vrntRepo.Create(ctx, flgID, flaggio.NewVariant{Value: true})
+	assert.NoError(t, err, "failed to create first variant")
+	vrnt2ID, err := vrntRepo.Create(ctx, flgID, flaggio.NewVariant{Value: false})
+	assert.NoError(t, err, "failed to create second variant")
+	err = repo.Update(ctx, flgID, flaggio.UpdateFlag{
+		Enabled:               boolPtr(true),
+		DefaultVariantWhenOn:  &vrnt1ID,
+		DefaultVariantWhenOff: &vrnt2ID,
+	})
+	assert.NoError(t, err, "failed to update flag")
+	_, err = rlRepo.CreateFlagRule(ctx, flgID, flaggio.NewFlagRule{
+		Constraints: []*flaggio.NewConstraint{
+			{Operation: flaggio.OperationOneOf, Property: stringPtr("name"), Values: []interface{}{"test"}},
+			{Operation: flaggio.OperationAny, Constraints: []*flaggio.NewConstraint{
+				{Operation: flaggio.OperationOneOf, Property: stringPtr("plan"), Values: []interface{}{"pro"}},
+			}},
+		},
+		Distributions: []*flaggio.NewDistribution{
+			{VariantID: vrnt1ID, Percentage: 30},
+			{VariantID: vrnt2ID, Percentage: 70},
+		},
+	})
+	assert.NoError(t, err, "failed to create rule")
+
+	var cloneID string
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "fails to clone a flag with a key in use",
+			run: func(t *testing.T) {
+				_, err := repo.Clone(ctx, flgID, "test", "copy")
+				assert.EqualError(t, err, "bad request: flag key already in use")
+			},
+		},
+		{
+			name: "fails to clone a flag that doesn't exist",
+			run: func(t *testing.T) {
+				_, err := repo.Clone(ctx, "5e5e3b1fa0c1b2a6e0d6e3b1", "copy", "copy")
+				assert.EqualError(t, err, "flag: not found")
+			},
+		},
+		{
+			name: "clone the flag",
+			run: func(t *testing.T) {
+				cloneID, err = repo.Clone(ctx, flgID, "copy", "copy of testing")
+				assert.NoError(t, err, "failed to clone flag")
+			},
+		},
+		{
+			name: "checks the flag was cloned with new ids",
+			run: func(t *testing.T) {
+				flg, err := repo.FindByID(ctx, flgID)
+				assert.NoError(t, err, "failed to find flag")
+				clone, err := repo.FindByID(ctx, cloneID)
+				assert.NoError(t, err, "failed to find clone")
+
+				assert.Equal(t, "copy", clone.Key)
+				assert.Equal(t, "copy of testing", clone.Name)
+				assert.Equal(t, []string{"checkout"}, clone.Tags)
+				assert.False(t, clone.Enabled)
+				assert.Equal(t, 1, clone.Version)
+
+				// variants are copied with new ids
+				assert.Len(t, clone.Variants, 2)
+				for idx, vrnt := range clone.Variants {
+					assert.NotEqual(t, flg.Variants[idx].ID, vrnt.ID)
+					assert.Equal(t, flg.Variants[idx].Value, vrnt.Value)
+				}
+				// default variants point to the copied variants
+				assert.Same(t, clone.Variants[0], clone.DefaultVariantWhenOn)
+				assert.Same(t, clone.Variants[1], clone.DefaultVariantWhenOff)
+
+				// rules are copied with new ids
+				assert.Len(t, clone.Rules, 1)
+				rl, clonedRl := flg.Rules[0], clone.Rules[0]
+				assert.NotEqual(t, rl.ID, clonedRl.ID)
+				assert.Len(t, clonedRl.Constraints, 2)
+				assert.NotEqual(t, rl.Constraints[0].ID, clonedRl.Constraints[0].ID)
+				assert.Equal(t, rl.Constraints[0].Property, clonedRl.Constraints[0].Property)
+				assert.Equal(t, rl.Constraints[0].Values, clonedRl.Constraints[0].Values)
+				// nested constraints are copied with new ids
+				assert.Len(t, clonedRl.Constraints[1].Constraints, 1)
+				nested, clonedNested := rl.Constraints[1].Constraints[0], clonedRl.Constraints[1].Constraints[0]
+				assert.NotEqual(t, rl.Constraints[1].ID, clonedRl.Constraints[1].ID)
+				assert.NotEqual(t, nested.ID, clonedNested.ID)
+				assert.Equal(t, nested.Property, clonedNested.Property)
+				// distributions point to the copied variants
+				assert.Len(t, clonedRl.Distributions, 2)
+				for idx, dstrbtn := range clonedRl.Distributions {
+					assert.NotEqual(t, rl.Distributions[idx].ID, dstrbtn.ID)
+					assert.Same(t, clone.Variants[idx], dstrbtn.Variant)
+					assert.Equal(t, rl.Distributions[idx].Percentage, dstrbtn.Percentage)
+				}
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -163,12 +457,18 @@ func newFlag(id, key, name string, createdAt time.Time) *flaggio.Flag {
 		ID:                    id,
 		Key:                   key,
 		Name:                  name,
+		Kind:                  flaggio.FlagKindTemporary,
+		Tags:                  []string{},
+		Links:                 []string{},
 		Enabled:               false,
 		Version:               1,
 		Variants:              []*flaggio.Variant{},
 		Rules:                 []*flaggio.FlagRule{},
 		DefaultVariantWhenOn:  nil,
 		DefaultVariantWhenOff: nil,
+		Metrics:               []*flaggio.Metric{},
+		RequiredApprovals:     1,
+		ChangeRequests:        []*flaggio.ChangeRequest{},
 		CreatedAt:             createdAt,
 		UpdatedAt:             nil,
 	}
@@ -0,0 +1,182 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uw-labs/flaggio/internal/errors"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var _ repository.Metric = (*MetricRepository)(nil)
+
+// MetricRepository implements repository.Metric interface using mongodb.
+type MetricRepository struct {
+	flagRepo *FlagRepository
+}
+
+// FindByID returns a metric that has a given ID.
+func (r *MetricRepository) FindByID(ctx context.Context, flagIDHex, idHex string) (*flaggio.Metric, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoMetricRepository.FindByID")
+	defer span.Finish()
+
+	flagID, err := primitive.ObjectIDFromHex(flagIDHex)
+	if err != nil {
+		return nil, err
+	}
+	metricID, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"_id": flagID, "metrics._id": metricID}
+	projection := bson.M{"metrics.$": 1}
+	opts := options.FindOne().SetProjection(projection)
+
+	var f flagModel
+	if err := r.flagRepo.col.FindOne(ctx, filter, opts).Decode(&f); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.NotFound("metric")
+		}
+		return nil, err
+	}
+	if len(f.Metrics) != 1 {
+		return nil, errors.NotFound("metric")
+	}
+	return f.Metrics[0].asMetric(), nil
+}
+
+// Create creates a new metric under a flag.
+func (r *MetricRepository) Create(ctx context.Context, flagIDHex string, m flaggio.NewMetric) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoMetricRepository.Create")
+	defer span.Finish()
+
+	mtrcModel := &metricModel{
+		ID:          primitive.NewObjectID(),
+		Key:         m.Key,
+		Name:        m.Name,
+		Description: m.Description,
+	}
+	flagID, err := primitive.ObjectIDFromHex(flagIDHex)
+	if err != nil {
+		return "", err
+	}
+	// metric keys are unique per flag
+	filter := bson.M{"_id": flagID, "metrics.key": bson.M{"$ne": m.Key}}
+	res, err := r.flagRepo.col.UpdateOne(ctx, filter, bson.M{
+		"$push": bson.M{"metrics": mtrcModel},
+		"$set":  bson.M{"updatedAt": time.Now()},
+		"$inc":  bson.M{"version": 1},
+	})
+	if err != nil {
+		return "", err
+	}
+	if res.ModifiedCount == 0 {
+		return "", r.metricKeyErr(ctx, flagID, nil)
+	}
+	return mtrcModel.ID.Hex(), nil
+}
+
+// Update updates a metric under a flag.
+func (r *MetricRepository) Update(ctx context.Context, flagIDHex, idHex string, m flaggio.UpdateMetric) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoMetricRepository.Update")
+	defer span.Finish()
+
+	flagID, err := primitive.ObjectIDFromHex(flagIDHex)
+	if err != nil {
+		return err
+	}
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	mods := bson.M{
+		"updatedAt": time.Now(),
+	}
+	filter := bson.M{"_id": flagID, "metrics._id": id}
+	if m.Key != nil {
+		mods["metrics.$.key"] = *m.Key
+		// metric keys are unique per flag
+		filter["metrics"] = bson.M{"$not": bson.M{"$elemMatch": bson.M{"_id": bson.M{"$ne": id}, "key": *m.Key}}}
+	}
+	if m.Name != nil {
+		mods["metrics.$.name"] = *m.Name
+	}
+	if m.Description != nil {
+		mods["metrics.$.description"] = *m.Description
+	}
+	res, err := r.flagRepo.col.UpdateOne(
+		ctx,
+		filter,
+		bson.M{"$set": mods, "$inc": bson.M{"version": 1}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.ModifiedCount == 0 {
+		if m.Key == nil {
+			return errors.NotFound("metric")
+		}
+		return r.metricKeyErr(ctx, flagID, &id)
+	}
+	return nil
+}
+
+// Delete deletes a metric under a flag.
+func (r *MetricRepository) Delete(ctx context.Context, flagIDHex, idHex string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoMetricRepository.Delete")
+	defer span.Finish()
+
+	flagID, err := primitive.ObjectIDFromHex(flagIDHex)
+	if err != nil {
+		return err
+	}
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	res, err := r.flagRepo.col.UpdateOne(ctx, bson.M{"_id": flagID}, bson.M{
+		"$pull": bson.M{"metrics": bson.M{"_id": id}},
+		"$set":  bson.M{"updatedAt": time.Now()},
+		"$inc":  bson.M{"version": 1},
+	})
+	if err != nil {
+		return err
+	}
+	if res.ModifiedCount == 0 {
+		return errors.NotFound("metric")
+	}
+	return nil
+}
+
+// metricKeyErr returns the reason a metric could not be saved with a
+// given key: either the flag or metric don't exist or the key is in use.
+func (r *MetricRepository) metricKeyErr(ctx context.Context, flagID primitive.ObjectID, metricID *primitive.ObjectID) error {
+	filter := bson.M{"_id": flagID}
+	entity := "flag"
+	if metricID != nil {
+		filter["metrics._id"] = *metricID
+		entity = "metric"
+	}
+	count, err := r.flagRepo.col.CountDocuments(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.NotFound(entity)
+	}
+	return errors.BadRequest("metric key already in use")
+}
+
+// NewMetricRepository returns a new metric repository that uses mongodb
+// as underlying storage.
+func NewMetricRepository(flagRepo *FlagRepository) repository.Metric {
+	return &MetricRepository{
+		flagRepo: flagRepo,
+	}
+}
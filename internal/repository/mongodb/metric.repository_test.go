@@ -0,0 +1,109 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	mongo_repo "github.com/uw-labs/flaggio/internal/repository/mongodb"
+)
+
+func TestMetricRepository(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	// drop database first
+	if err := mongoDB.Drop(ctx); err != nil {
+		t.Fatalf("failed drop database: %s", err)
+	}
+
+	// create new repo
+	flgRepo, err := mongo_repo.NewFlagRepository(ctx, mongoDB)
+	assert.NoError(t, err, "failed to create flag repository")
+	repo := mongo_repo.NewMetricRepository(flgRepo.(*mongo_repo.FlagRepository))
+
+	// create a flag
+	flgID, err := flgRepo.Create(ctx, flaggio.NewFlag{Key: "test"})
+	assert.NoError(t, err, "failed to create flag")
+
+	var mtrc1ID, mtrc2ID string
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T)
+	}{
+		{
+			name: "create the first metric",
+			run: func(t *testing.T) {
+				mtrc1ID, err = repo.Create(ctx, flgID, flaggio.NewMetric{Key: "purchase", Name: "Purchase"})
+				assert.NoError(t, err, "failed to create first metric")
+			},
+		},
+		{
+			name: "checks the metric was created",
+			run: func(t *testing.T) {
+				mtrc, err := repo.FindByID(ctx, flgID, mtrc1ID)
+				assert.NoError(t, err, "failed to find first metric")
+				assert.Equal(t, &flaggio.Metric{ID: mtrc1ID, Key: "purchase", Name: "Purchase"}, mtrc)
+			},
+		},
+		{
+			name: "fails to create a metric with the same key",
+			run: func(t *testing.T) {
+				_, err := repo.Create(ctx, flgID, flaggio.NewMetric{Key: "purchase", Name: "Other"})
+				assert.EqualError(t, err, "bad request: metric key already in use")
+			},
+		},
+		{
+			name: "create the second metric",
+			run: func(t *testing.T) {
+				mtrc2ID, err = repo.Create(ctx, flgID, flaggio.NewMetric{Key: "signup", Name: "Signup"})
+				assert.NoError(t, err, "failed to create second metric")
+			},
+		},
+		{
+			name: "fails to update a metric to a key in use",
+			run: func(t *testing.T) {
+				err := repo.Update(ctx, flgID, mtrc2ID, flaggio.UpdateMetric{Key: stringPtr("purchase")})
+				assert.EqualError(t, err, "bad request: metric key already in use")
+			},
+		},
+		{
+			name: "update the second metric",
+			run: func(t *testing.T) {
+				err := repo.Update(ctx, flgID, mtrc2ID, flaggio.UpdateMetric{Key: stringPtr("register"), Name: stringPtr("Register")})
+				assert.NoError(t, err, "failed to update second metric")
+			},
+		},
+		{
+			name: "find second metric",
+			run: func(t *testing.T) {
+				mtrc, err := repo.FindByID(ctx, flgID, mtrc2ID)
+				assert.NoError(t, err, "failed to find second metric again")
+				assert.Equal(t, &flaggio.Metric{ID: mtrc2ID, Key: "register", Name: "Register"}, mtrc)
+			},
+		},
+		{
+			name: "delete the first metric",
+			run: func(t *testing.T) {
+				err := repo.Delete(ctx, flgID, mtrc1ID)
+				assert.NoError(t, err, "failed to delete first metric")
+			},
+		},
+		{
+			name: "find deleted metric",
+			run: func(t *testing.T) {
+				mtrc, err := repo.FindByID(ctx, flgID, mtrc1ID)
+				assert.EqualError(t, err, "metric: not found")
+				assert.Nil(t, mtrc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, tt.run)
+	}
+
+}
@@ -1,6 +1,7 @@
 package mongodb
 
 import (
+	"sort"
 	"time"
 
 	"github.com/uw-labs/flaggio/internal/flaggio"
@@ -8,18 +9,32 @@ import (
 )
 
 type flagModel struct {
-	ID                    primitive.ObjectID `bson:"_id"`
-	Key                   string             `bson:"key"`
-	Name                  string             `bson:"name"`
-	Description           *string            `bson:"description"`
-	Enabled               bool               `bson:"enabled"`
-	Version               int                `bson:"version"`
-	Variants              []variantModel     `bson:"variants"`
-	Rules                 []flagRuleModel    `bson:"rules"`
-	DefaultVariantWhenOn  primitive.ObjectID `bson:"defaultVariantWhenOn"`
-	DefaultVariantWhenOff primitive.ObjectID `bson:"defaultVariantWhenOff"`
-	CreatedAt             time.Time          `bson:"createdAt"`
-	UpdatedAt             *time.Time         `bson:"updatedAt"`
+	ID                    primitive.ObjectID   `bson:"_id"`
+	Key                   string               `bson:"key"`
+	Name                  string               `bson:"name"`
+	Description           *string              `bson:"description"`
+	Kind                  flaggio.FlagKind     `bson:"kind"`
+	Tags                  []string             `bson:"tags"`
+	OwnerTeam             *string              `bson:"ownerTeam"`
+	Maintainer            *string              `bson:"maintainer"`
+	Links                 []string             `bson:"links"`
+	ExpiresAt             *time.Time           `bson:"expiresAt"`
+	ClientExposed         bool                 `bson:"clientExposed"`
+	Archived              bool                 `bson:"archived"`
+	ArchivedAt            *time.Time           `bson:"archivedAt"`
+	Enabled               bool                 `bson:"enabled"`
+	Version               int                  `bson:"version"`
+	Variants              []variantModel       `bson:"variants"`
+	Rules                 []flagRuleModel      `bson:"rules"`
+	DefaultVariantWhenOn  primitive.ObjectID   `bson:"defaultVariantWhenOn"`
+	DefaultVariantWhenOff primitive.ObjectID   `bson:"defaultVariantWhenOff"`
+	Experiment            bool                 `bson:"experiment"`
+	Metrics               []metricModel        `bson:"metrics"`
+	Protected             bool                 `bson:"protected"`
+	RequiredApprovals     int                  `bson:"requiredApprovals"`
+	ChangeRequests        []changeRequestModel `bson:"changeRequests"`
+	CreatedAt             time.Time            `bson:"createdAt"`
+	UpdatedAt             *time.Time           `bson:"updatedAt"`
 }
 
 func (f *flagModel) asFlag() *flaggio.Flag {
@@ -34,17 +49,49 @@ func (f *flagModel) asFlag() *flaggio.Flag {
 	for idx, rl := range f.Rules {
 		rules[idx] = rl.asRule(variantsMap)
 	}
+	metrics := make([]*flaggio.Metric, len(f.Metrics))
+	for idx, mtrc := range f.Metrics {
+		metrics[idx] = mtrc.asMetric()
+	}
+	// flags created before change requests existed require a single approval
+	requiredApprovals := f.RequiredApprovals
+	if requiredApprovals < 1 {
+		requiredApprovals = 1
+	}
+	// flags created before flag kinds existed are temporary
+	kind := f.Kind
+	if kind == "" {
+		kind = flaggio.FlagKindTemporary
+	}
+	changeRequests := make([]*flaggio.ChangeRequest, len(f.ChangeRequests))
+	for idx, cr := range f.ChangeRequests {
+		changeRequests[idx] = cr.asChangeRequest()
+	}
 	return &flaggio.Flag{
 		ID:                    f.ID.Hex(),
 		Key:                   f.Key,
 		Name:                  f.Name,
 		Description:           f.Description,
+		Kind:                  kind,
+		Tags:                  nonNilStrings(f.Tags),
+		OwnerTeam:             f.OwnerTeam,
+		Maintainer:            f.Maintainer,
+		Links:                 nonNilStrings(f.Links),
+		ExpiresAt:             f.ExpiresAt,
+		ClientExposed:         f.ClientExposed,
+		Archived:              f.Archived,
+		ArchivedAt:            f.ArchivedAt,
 		Enabled:               f.Enabled,
 		Version:               f.Version,
 		Variants:              variants,
 		Rules:                 rules,
 		DefaultVariantWhenOn:  variantsMap[f.DefaultVariantWhenOn.Hex()],
 		DefaultVariantWhenOff: variantsMap[f.DefaultVariantWhenOff.Hex()],
+		Experiment:            f.Experiment,
+		Metrics:               metrics,
+		Protected:             f.Protected,
+		RequiredApprovals:     requiredApprovals,
+		ChangeRequests:        changeRequests,
 		CreatedAt:             f.CreatedAt,
 		UpdatedAt:             f.UpdatedAt,
 	}
@@ -64,8 +111,88 @@ func (v variantModel) asVariant() *flaggio.Variant {
 	}
 }
 
+type metricModel struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	Key         string             `bson:"key"`
+	Name        string             `bson:"name"`
+	Description *string            `bson:"description"`
+}
+
+func (m metricModel) asMetric() *flaggio.Metric {
+	return &flaggio.Metric{
+		ID:          m.ID.Hex(),
+		Key:         m.Key,
+		Name:        m.Name,
+		Description: m.Description,
+	}
+}
+
+type changeRequestModel struct {
+	ID                primitive.ObjectID      `bson:"_id"`
+	EntityType        string                  `bson:"entityType"`
+	EntityID          primitive.ObjectID      `bson:"entityId"`
+	FlagVersion       int                     `bson:"flagVersion"`
+	Diff              []fieldChangeModel      `bson:"diff"`
+	Status            string                  `bson:"status"`
+	Author            string                  `bson:"author"`
+	RequiredApprovals int                     `bson:"requiredApprovals"`
+	Approvals         []approvalModel         `bson:"approvals"`
+	RejectedBy        *string                 `bson:"rejectedBy"`
+	FlagInput         *flaggio.UpdateFlag     `bson:"flagInput,omitempty"`
+	VariantInput      *flaggio.UpdateVariant  `bson:"variantInput,omitempty"`
+	FlagRuleInput     *flaggio.UpdateFlagRule `bson:"flagRuleInput,omitempty"`
+	CreatedAt         time.Time               `bson:"createdAt"`
+	UpdatedAt         *time.Time              `bson:"updatedAt"`
+}
+
+func (cr changeRequestModel) asChangeRequest() *flaggio.ChangeRequest {
+	diff := make([]*flaggio.FieldChange, len(cr.Diff))
+	for idx, fc := range cr.Diff {
+		diff[idx] = &flaggio.FieldChange{
+			Field:    fc.Field,
+			OldValue: fromBSONValue(fc.OldValue),
+			NewValue: fromBSONValue(fc.NewValue),
+		}
+	}
+	approvals := make([]*flaggio.Approval, len(cr.Approvals))
+	for idx, apprvl := range cr.Approvals {
+		approvals[idx] = &flaggio.Approval{User: apprvl.User, CreatedAt: apprvl.CreatedAt}
+	}
+	return &flaggio.ChangeRequest{
+		ID:                cr.ID.Hex(),
+		EntityType:        flaggio.EntityType(cr.EntityType),
+		EntityID:          cr.EntityID.Hex(),
+		FlagVersion:       cr.FlagVersion,
+		Diff:              diff,
+		Status:            flaggio.ChangeRequestStatus(cr.Status),
+		Author:            cr.Author,
+		RequiredApprovals: cr.RequiredApprovals,
+		Approvals:         approvals,
+		RejectedBy:        cr.RejectedBy,
+		FlagInput:         cr.FlagInput,
+		VariantInput:      cr.VariantInput,
+		FlagRuleInput:     cr.FlagRuleInput,
+		CreatedAt:         cr.CreatedAt,
+		UpdatedAt:         cr.UpdatedAt,
+	}
+}
+
+type fieldChangeModel struct {
+	Field    string      `bson:"field"`
+	OldValue interface{} `bson:"oldValue"`
+	NewValue interface{} `bson:"newValue"`
+}
+
+type approvalModel struct {
+	User      string    `bson:"user"`
+	CreatedAt time.Time `bson:"createdAt"`
+}
+
 type flagRuleModel struct {
 	ID            primitive.ObjectID  `bson:"_id"`
+	Name          *string             `bson:"name,omitempty"`
+	Description   *string             `bson:"description,omitempty"`
+	Disabled      bool                `bson:"disabled,omitempty"`
 	Constraints   []constraintModel   `bson:"constraints"`
 	Distributions []distributionModel `bson:"distributions"`
 }
@@ -82,6 +209,9 @@ func (r flagRuleModel) asRule(vrnts map[string]*flaggio.Variant) *flaggio.FlagRu
 	return &flaggio.FlagRule{
 		Rule: flaggio.Rule{
 			ID:          r.ID.Hex(),
+			Name:        r.Name,
+			Description: r.Description,
+			Disabled:    r.Disabled,
 			Constraints: constraints,
 		},
 		Distributions: distributions,
@@ -89,21 +219,64 @@ func (r flagRuleModel) asRule(vrnts map[string]*flaggio.Variant) *flaggio.FlagRu
 }
 
 type constraintModel struct {
-	ID        primitive.ObjectID `bson:"_id"`
-	Property  string             `bson:"property"`
-	Operation string             `bson:"operation"`
-	Values    []interface{}      `bson:"values"`
+	ID          primitive.ObjectID `bson:"_id"`
+	Property    string             `bson:"property"`
+	Operation   string             `bson:"operation"`
+	Values      []interface{}      `bson:"values"`
+	Constraints []constraintModel  `bson:"constraints,omitempty"`
 }
 
 func (c constraintModel) asConstraint() *flaggio.Constraint {
+	var constraints []*flaggio.Constraint
+	if len(c.Constraints) > 0 {
+		constraints = make([]*flaggio.Constraint, len(c.Constraints))
+		for idx, cnstrnt := range c.Constraints {
+			constraints[idx] = cnstrnt.asConstraint()
+		}
+	}
 	return &flaggio.Constraint{
-		ID:        c.ID.Hex(),
-		Property:  c.Property,
-		Operation: flaggio.Operation(c.Operation),
-		Values:    c.Values,
+		ID:          c.ID.Hex(),
+		Property:    c.Property,
+		Operation:   flaggio.Operation(c.Operation),
+		Values:      c.Values,
+		Constraints: constraints,
 	}
 }
 
+// newConstraintModelsFrom returns the models of existing constraints, so
+// they can be saved again with the same IDs.
+func newConstraintModelsFrom(cnstrnts []*flaggio.Constraint) ([]constraintModel, error) {
+	constraints := make([]constraintModel, len(cnstrnts))
+	for idx, c := range cnstrnts {
+		id, err := primitive.ObjectIDFromHex(c.ID)
+		if err != nil {
+			return nil, err
+		}
+		nested, err := newConstraintModelsFrom(c.Constraints)
+		if err != nil {
+			return nil, err
+		}
+		if len(nested) == 0 {
+			nested = nil
+		}
+		values := make([]interface{}, len(c.Values))
+		for vIdx, v := range c.Values {
+			if ider, ok := v.(flaggio.Identifier); ok {
+				v = ider.GetID()
+			}
+			values[vIdx] = v
+		}
+		constraints[idx] = constraintModel{
+			ID:          id,
+			Property:    c.Property,
+			Operation:   string(c.Operation),
+			Values:      values,
+			Constraints: nested,
+		}
+	}
+	return constraints, nil
+}
+
 type distributionModel struct {
 	ID         primitive.ObjectID `bson:"_id"`
 	VariantID  primitive.ObjectID `bson:"variantId"`
@@ -120,6 +293,9 @@ func (d distributionModel) asDistribution(vrnts map[string]*flaggio.Variant) *fl
 
 type segmentRuleModel struct {
 	ID          primitive.ObjectID `bson:"_id"`
+	Name        *string            `bson:"name,omitempty"`
+	Description *string            `bson:"description,omitempty"`
+	Disabled    bool               `bson:"disabled,omitempty"`
 	Constraints []constraintModel  `bson:"constraints"`
 }
 
@@ -131,6 +307,9 @@ func (r segmentRuleModel) asRule() *flaggio.SegmentRule {
 	return &flaggio.SegmentRule{
 		Rule: flaggio.Rule{
 			ID:          r.ID.Hex(),
+			Name:        r.Name,
+			Description: r.Description,
+			Disabled:    r.Disabled,
 			Constraints: constraints,
 		},
 	}
@@ -160,6 +339,58 @@ func (f *segmentModel) asSegment() *flaggio.Segment {
 	}
 }
 
+type slotAllocationModel struct {
+	FlagID primitive.ObjectID `bson:"flagId"`
+	Start  int                `bson:"start"`
+	End    int                `bson:"end"`
+}
+
+type exclusionGroupModel struct {
+	ID          primitive.ObjectID    `bson:"_id"`
+	Name        string                `bson:"name"`
+	Description *string               `bson:"description"`
+	Allocations []slotAllocationModel `bson:"allocations"`
+	CreatedAt   time.Time             `bson:"createdAt"`
+	UpdatedAt   *time.Time            `bson:"updatedAt"`
+}
+
+func (g *exclusionGroupModel) asExclusionGroup() *flaggio.ExclusionGroup {
+	allocs := make([]*flaggio.SlotAllocation, len(g.Allocations))
+	for idx, alloc := range g.Allocations {
+		allocs[idx] = &flaggio.SlotAllocation{
+			FlagID: alloc.FlagID.Hex(),
+			Start:  alloc.Start,
+			End:    alloc.End,
+		}
+	}
+	return &flaggio.ExclusionGroup{
+		ID:          g.ID.Hex(),
+		Name:        g.Name,
+		Description: g.Description,
+		Allocations: allocs,
+		CreatedAt:   g.CreatedAt,
+		UpdatedAt:   g.UpdatedAt,
+	}
+}
+
+// newSlotAllocationModels validates the slot allocations and converts them
+// to the models stored in the database.
+func newSlotAllocationModels(input []*flaggio.NewSlotAllocation) ([]*flaggio.SlotAllocation, []slotAllocationModel, error) {
+	allocs, err := flaggio.NewSlotAllocations(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	models := make([]slotAllocationModel, len(allocs))
+	for idx, alloc := range allocs {
+		flagID, err := primitive.ObjectIDFromHex(alloc.FlagID)
+		if err != nil {
+			return nil, nil, err
+		}
+		models[idx] = slotAllocationModel{FlagID: flagID, Start: alloc.Start, End: alloc.End}
+	}
+	return allocs, models, nil
+}
+
 type evaluationModel struct {
 	ID          primitive.ObjectID `bson:"_id"`
 	FlagID      primitive.ObjectID `bson:"flagId"`
@@ -167,6 +398,9 @@ type evaluationModel struct {
 	FlagVersion int                `bson:"flagVersion"`
 	RequestHash string             `bson:"requestHash"`
 	UserID      string             `bson:"userId"`
+	VariantID   string             `bson:"variantId"`
+	Reason      string             `bson:"reason"`
+	RuleName    string             `bson:"ruleName,omitempty"`
 	Value       interface{}        `bson:"value"`
 	CreatedAt   time.Time          `bson:"createdAt"`
 }
@@ -179,6 +413,9 @@ func (f *evaluationModel) asEvaluation() *flaggio.Evaluation {
 		FlagKey:     f.FlagKey,
 		FlagVersion: f.FlagVersion,
 		RequestHash: f.RequestHash,
+		VariantID:   f.VariantID,
+		Reason:      flaggio.Reason(f.Reason),
+		RuleName:    f.RuleName,
 		Value:       f.Value,
 	}
 }
@@ -190,9 +427,144 @@ type userModel struct {
 }
 
 func (f *userModel) asUser() *flaggio.User {
+	usrCtx := make(map[string]interface{}, len(f.Context))
+	for key, value := range f.Context {
+		usrCtx[key] = fromBSONValue(value)
+	}
 	return &flaggio.User{
 		ID:        f.ID,
-		Context:   f.Context,
+		Context:   usrCtx,
 		UpdatedAt: f.UpdatedAt,
 	}
 }
+
+// fromBSONValue converts the bson lists and documents from a decoded
+// value to the types used on the user context, recursively.
+func fromBSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case primitive.A:
+		list := make([]interface{}, len(v))
+		for idx, elem := range v {
+			list[idx] = fromBSONValue(elem)
+		}
+		return list
+	case primitive.D:
+		return fromBSONValue(v.Map())
+	case primitive.M:
+		return fromBSONValue(map[string]interface{}(v))
+	case map[string]interface{}:
+		obj := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			obj[key] = fromBSONValue(elem)
+		}
+		return obj
+	default:
+		return v
+	}
+}
+
+type exposureModel struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	FlagKey     string             `bson:"flagKey"`
+	FlagVersion int                `bson:"flagVersion"`
+	VariantID   string             `bson:"variantId"`
+	UserID      string             `bson:"userId"`
+	CreatedAt   time.Time          `bson:"createdAt"`
+}
+
+type conversionModel struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	UserID    string             `bson:"userId"`
+	MetricKey string             `bson:"metric"`
+	CreatedAt time.Time          `bson:"createdAt"`
+}
+
+type webhookModel struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	URL         string             `bson:"url"`
+	Secret      string             `bson:"secret"`
+	EntityTypes []string           `bson:"entityTypes"`
+	FlagKeys    []string           `bson:"flagKeys"`
+	Enabled     bool               `bson:"enabled"`
+	CreatedAt   time.Time          `bson:"createdAt"`
+	UpdatedAt   *time.Time         `bson:"updatedAt"`
+}
+
+func (f *webhookModel) asWebhook() *flaggio.Webhook {
+	entityTypes := make([]flaggio.EntityType, len(f.EntityTypes))
+	for idx, et := range f.EntityTypes {
+		entityTypes[idx] = flaggio.EntityType(et)
+	}
+	flagKeys := f.FlagKeys
+	if flagKeys == nil {
+		flagKeys = []string{}
+	}
+	return &flaggio.Webhook{
+		ID:          f.ID.Hex(),
+		URL:         f.URL,
+		Secret:      f.Secret,
+		EntityTypes: entityTypes,
+		FlagKeys:    flagKeys,
+		Enabled:     f.Enabled,
+		CreatedAt:   f.CreatedAt,
+		UpdatedAt:   f.UpdatedAt,
+	}
+}
+
+type webhookDeliveryModel struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	WebhookID  primitive.ObjectID `bson:"webhookId"`
+	EntityType string             `bson:"entityType"`
+	EntityID   string             `bson:"entityId"`
+	Action     string             `bson:"action"`
+	Payload    string             `bson:"payload"`
+	Attempts   int                `bson:"attempts"`
+	StatusCode *int               `bson:"statusCode"`
+	Error      *string            `bson:"error"`
+	Delivered  bool               `bson:"delivered"`
+	CreatedAt  time.Time          `bson:"createdAt"`
+	UpdatedAt  *time.Time         `bson:"updatedAt"`
+}
+
+func (f *webhookDeliveryModel) asWebhookDelivery() *flaggio.WebhookDelivery {
+	return &flaggio.WebhookDelivery{
+		ID:         f.ID.Hex(),
+		WebhookID:  f.WebhookID.Hex(),
+		EntityType: flaggio.EntityType(f.EntityType),
+		EntityID:   f.EntityID,
+		Action:     flaggio.ChangeAction(f.Action),
+		Payload:    f.Payload,
+		Attempts:   f.Attempts,
+		StatusCode: f.StatusCode,
+		Error:      f.Error,
+		Delivered:  f.Delivered,
+		CreatedAt:  f.CreatedAt,
+		UpdatedAt:  f.UpdatedAt,
+	}
+}
+
+type flagUsageModel struct {
+	FlagKey          string               `bson:"_id"`
+	Evaluations      int                  `bson:"evaluations"`
+	FirstEvaluatedAt time.Time            `bson:"firstEvaluatedAt"`
+	LastEvaluatedAt  time.Time            `bson:"lastEvaluatedAt"`
+	Variants         map[string]time.Time `bson:"variants"`
+}
+
+func (u *flagUsageModel) asFlagUsage() *flaggio.FlagUsage {
+	variants := make([]*flaggio.VariantUsage, 0, len(u.Variants))
+	for variantID, lastServedAt := range u.Variants {
+		variants = append(variants, &flaggio.VariantUsage{VariantID: variantID, LastServedAt: lastServedAt})
+	}
+	// sort variants so results are stable
+	sort.Slice(variants, func(i, j int) bool {
+		return variants[i].VariantID < variants[j].VariantID
+	})
+	return &flaggio.FlagUsage{
+		FlagKey:          u.FlagKey,
+		Evaluations:      u.Evaluations,
+		FirstEvaluatedAt: u.FirstEvaluatedAt,
+		LastEvaluatedAt:  u.LastEvaluatedAt,
+		Variants:         variants,
+	}
+}
@@ -59,16 +59,11 @@ func (r *RuleRepository) CreateFlagRule(ctx context.Context, flagIDHex string, f
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoRuleRepository.CreateFlagRule")
 	defer span.Finish()
 
-	constraints := make([]constraintModel, len(fr.Constraints))
-	distributions := make([]distributionModel, len(fr.Distributions))
-	for idx, c := range fr.Constraints {
-		constraints[idx] = constraintModel{
-			ID:        primitive.NewObjectID(),
-			Property:  c.Property,
-			Operation: string(c.Operation),
-			Values:    c.Values,
-		}
+	constraints, err := newConstraintModels(fr.Constraints)
+	if err != nil {
+		return "", err
 	}
+	distributions := make([]distributionModel, len(fr.Distributions))
 	for idx, d := range fr.Distributions {
 		variantID, err := primitive.ObjectIDFromHex(d.VariantID)
 		if err != nil {
@@ -82,6 +77,9 @@ func (r *RuleRepository) CreateFlagRule(ctx context.Context, flagIDHex string, f
 	}
 	flgRuleModel := &flagRuleModel{
 		ID:            primitive.NewObjectID(),
+		Name:          fr.Name,
+		Description:   fr.Description,
+		Disabled:      fr.Enabled != nil && !*fr.Enabled,
 		Constraints:   constraints,
 		Distributions: distributions,
 	}
@@ -117,32 +115,11 @@ func (r *RuleRepository) UpdateFlagRule(ctx context.Context, flagIDHex, idHex st
 	if err != nil {
 		return err
 	}
-	constraints := make([]constraintModel, len(fr.Constraints))
-	distributions := make([]distributionModel, len(fr.Distributions))
-	for idx, c := range fr.Constraints {
-		constraints[idx] = constraintModel{
-			ID:        primitive.NewObjectID(),
-			Property:  c.Property,
-			Operation: string(c.Operation),
-			Values:    c.Values,
-		}
-	}
-	for idx, d := range fr.Distributions {
-		variantID, err := primitive.ObjectIDFromHex(d.VariantID)
-		if err != nil {
-			return errors.BadRequest(fmt.Sprintf("invalid variant ID for distribution[%d]", idx))
-		}
-		distributions[idx] = distributionModel{
-			ID:         primitive.NewObjectID(),
-			VariantID:  variantID,
-			Percentage: d.Percentage,
-		}
-	}
-	mods := bson.M{
-		"updatedAt":             time.Now(),
-		"rules.$.constraints":   constraints,
-		"rules.$.distributions": distributions,
+	mods, err := flagRuleUpdateMods("rules.$", fr)
+	if err != nil {
+		return err
 	}
+	mods["updatedAt"] = time.Now()
 	res, err := r.flagRepo.col.UpdateOne(
 		ctx,
 		bson.M{"_id": flagID, "rules._id": id},
@@ -184,6 +161,14 @@ func (r *RuleRepository) DeleteFlagRule(ctx context.Context, flagIDHex, idHex st
 	return nil
 }
 
+// MoveFlagRule moves a rule under a flag to the given position.
+func (r *RuleRepository) MoveFlagRule(ctx context.Context, flagIDHex, idHex string, position int) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoRuleRepository.MoveFlagRule")
+	defer span.Finish()
+
+	return moveRule(ctx, r.flagRepo.col, "flag rule", flagIDHex, idHex, position, bson.M{"$inc": bson.M{"version": 1}})
+}
+
 // FindSegmentRuleByID returns a segment rule that has a given ID.
 func (r *RuleRepository) FindSegmentRuleByID(ctx context.Context, segmentIDHex, idHex string) (*flaggio.SegmentRule, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoRuleRepository.FindSegmentRuleByID")
@@ -219,23 +204,24 @@ func (r *RuleRepository) CreateSegmentRule(ctx context.Context, segmentIDHex str
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoRuleRepository.CreateSegmentRule")
 	defer span.Finish()
 
-	constraints := make([]constraintModel, len(fr.Constraints))
-	for idx, c := range fr.Constraints {
-		constraints[idx] = constraintModel{
-			ID:        primitive.NewObjectID(),
-			Property:  c.Property,
-			Operation: string(c.Operation),
-			Values:    c.Values,
-		}
+	constraints, err := newConstraintModels(fr.Constraints)
+	if err != nil {
+		return "", err
 	}
 	sgmntRuleModel := &segmentRuleModel{
 		ID:          primitive.NewObjectID(),
+		Name:        fr.Name,
+		Description: fr.Description,
+		Disabled:    fr.Enabled != nil && !*fr.Enabled,
 		Constraints: constraints,
 	}
 	segmentID, err := primitive.ObjectIDFromHex(segmentIDHex)
 	if err != nil {
 		return "", err
 	}
+	if err := r.checkSegmentCycle(ctx, segmentIDHex, constraints); err != nil {
+		return "", err
+	}
 	filter := bson.M{"_id": segmentID}
 	res, err := r.segmentRepo.col.UpdateOne(ctx, filter, bson.M{
 		"$push": bson.M{"rules": sgmntRuleModel},
@@ -263,19 +249,16 @@ func (r *RuleRepository) UpdateSegmentRule(ctx context.Context, segmentIDHex, id
 	if err != nil {
 		return err
 	}
-	constraints := make([]constraintModel, len(fr.Constraints))
-	for idx, c := range fr.Constraints {
-		constraints[idx] = constraintModel{
-			ID:        primitive.NewObjectID(),
-			Property:  c.Property,
-			Operation: string(c.Operation),
-			Values:    c.Values,
-		}
+	constraints, err := newConstraintModels(fr.Constraints)
+	if err != nil {
+		return err
 	}
-	mods := bson.M{
-		"updatedAt":           time.Now(),
-		"rules.$.constraints": constraints,
+	if err := r.checkSegmentCycle(ctx, segmentIDHex, constraints); err != nil {
+		return err
 	}
+	mods := ruleDetailsMods("rules.$", fr.Name, fr.Description, fr.Enabled)
+	mods["updatedAt"] = time.Now()
+	mods["rules.$.constraints"] = constraints
 	res, err := r.segmentRepo.col.UpdateOne(
 		ctx,
 		bson.M{"_id": segmentID, "rules._id": id},
@@ -316,6 +299,174 @@ func (r *RuleRepository) DeleteSegmentRule(ctx context.Context, segmentIDHex, id
 	return nil
 }
 
+// MoveSegmentRule moves a rule under a segment to the given position.
+func (r *RuleRepository) MoveSegmentRule(ctx context.Context, segmentIDHex, idHex string, position int) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoRuleRepository.MoveSegmentRule")
+	defer span.Finish()
+
+	return moveRule(ctx, r.segmentRepo.col, "segment rule", segmentIDHex, idHex, position, bson.M{})
+}
+
+// moveRule moves the rule with the given ID to a position in the rules of a
+// document. The rules are only replaced if they didn't change since they were
+// read, so concurrent changes to the rules are never lost.
+func moveRule(ctx context.Context, col *mongo.Collection, entity, docIDHex, idHex string, position int, update bson.M) error {
+	docID, err := primitive.ObjectIDFromHex(docIDHex)
+	if err != nil {
+		return err
+	}
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	filter := bson.M{"_id": docID, "rules._id": id}
+	opts := options.FindOne().SetProjection(bson.M{"rules": 1})
+
+	// keep the rules raw, so they can be compared and written back as they are
+	var doc struct {
+		Rules []bson.Raw `bson:"rules"`
+	}
+	if err := col.FindOne(ctx, filter, opts).Decode(&doc); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return errors.NotFound(entity)
+		}
+		return err
+	}
+	if position < 0 || position >= len(doc.Rules) {
+		return errors.BadRequest(fmt.Sprintf("position must be between 0 and %d", len(doc.Rules)-1))
+	}
+	from := -1
+	for idx, rl := range doc.Rules {
+		if rlID, ok := rl.Lookup("_id").ObjectIDOK(); ok && rlID == id {
+			from = idx
+			break
+		}
+	}
+	if from == -1 {
+		return errors.NotFound(entity)
+	}
+
+	rules := make([]bson.Raw, 0, len(doc.Rules))
+	rules = append(rules, doc.Rules[:from]...)
+	rules = append(rules, doc.Rules[from+1:]...)
+	rules = append(rules[:position], append([]bson.Raw{doc.Rules[from]}, rules[position:]...)...)
+
+	update["$set"] = bson.M{"rules": rules, "updatedAt": time.Now()}
+	res, err := col.UpdateOne(ctx, bson.M{"_id": docID, "rules": doc.Rules}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errors.BadRequest("rules were modified concurrently, please try again")
+	}
+	return nil
+}
+
+// flagRuleUpdateMods returns the fields of the flag rule modified by the input,
+// where path is the path to the rule in the flag document.
+func flagRuleUpdateMods(path string, fr flaggio.UpdateFlagRule) (bson.M, error) {
+	constraints, err := newConstraintModels(fr.Constraints)
+	if err != nil {
+		return nil, err
+	}
+	distributions := make([]distributionModel, len(fr.Distributions))
+	for idx, d := range fr.Distributions {
+		variantID, err := primitive.ObjectIDFromHex(d.VariantID)
+		if err != nil {
+			return nil, errors.BadRequest(fmt.Sprintf("invalid variant ID for distribution[%d]", idx))
+		}
+		distributions[idx] = distributionModel{
+			ID:         primitive.NewObjectID(),
+			VariantID:  variantID,
+			Percentage: d.Percentage,
+		}
+	}
+	mods := ruleDetailsMods(path, fr.Name, fr.Description, fr.Enabled)
+	mods[path+".constraints"] = constraints
+	mods[path+".distributions"] = distributions
+	return mods, nil
+}
+
+// checkSegmentCycle returns an error if the constraints of a segment rule
+// reference segments that, directly or not, reference the segment back.
+func (r *RuleRepository) checkSegmentCycle(ctx context.Context, segmentIDHex string, constraints []constraintModel) error {
+	cnstrnts := make(flaggio.ConstraintList, len(constraints))
+	for idx, cnstrnt := range constraints {
+		cnstrnts[idx] = cnstrnt.asConstraint()
+	}
+	sgmntRefs := cnstrnts.SegmentIDs()
+	if len(sgmntRefs) == 0 {
+		return nil
+	}
+	refs, names, err := r.segmentRepo.segmentReferences(ctx)
+	if err != nil {
+		return err
+	}
+	// the segment already references its current rules, which are
+	// known not to create a cycle
+	refs[segmentIDHex] = append(refs[segmentIDHex], sgmntRefs...)
+	if cycle := flaggio.FindSegmentCycle(refs, segmentIDHex); cycle != nil {
+		return flaggio.SegmentCycleError(cycle, names)
+	}
+	return nil
+}
+
+// newConstraintModels returns the models for a tree of constraints. Constraint
+// groups must have nested constraints, and only groups can have them.
+func newConstraintModels(cnstrnts []*flaggio.NewConstraint) ([]constraintModel, error) {
+	constraints := make([]constraintModel, len(cnstrnts))
+	for idx, c := range cnstrnts {
+		if c.Operation.IsGroup() && len(c.Constraints) == 0 {
+			return nil, errors.BadRequest(fmt.Sprintf("constraint group %s must have nested constraints", c.Operation))
+		}
+		if !c.Operation.IsGroup() && len(c.Constraints) > 0 {
+			return nil, errors.BadRequest(fmt.Sprintf("operation %s can't have nested constraints", c.Operation))
+		}
+		if err := c.Operation.ValidateValues(c.Values); err != nil {
+			return nil, err
+		}
+		nested, err := newConstraintModels(c.Constraints)
+		if err != nil {
+			return nil, err
+		}
+		if len(nested) == 0 {
+			nested = nil
+		}
+		var property string
+		if c.Property != nil {
+			property = *c.Property
+		}
+		values := c.Values
+		if values == nil {
+			values = []interface{}{}
+		}
+		constraints[idx] = constraintModel{
+			ID:          primitive.NewObjectID(),
+			Property:    property,
+			Operation:   string(c.Operation),
+			Values:      values,
+			Constraints: nested,
+		}
+	}
+	return constraints, nil
+}
+
+// ruleDetailsMods returns the name, description and status of the rule
+// modified by the input, where path is the path to the rule in the document.
+func ruleDetailsMods(path string, name, description *string, enabled *bool) bson.M {
+	mods := bson.M{}
+	if name != nil {
+		mods[path+".name"] = *name
+	}
+	if description != nil {
+		mods[path+".description"] = *description
+	}
+	if enabled != nil {
+		mods[path+".disabled"] = !*enabled
+	}
+	return mods
+}
+
 // NewRuleRepository returns a new rule repository that uses mongodb as underlying storage.
 func NewRuleRepository(flagRepo *FlagRepository, segmentRepo *SegmentRepository) repository.Rule {
 	return &RuleRepository{
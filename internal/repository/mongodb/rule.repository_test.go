@@ -30,7 +30,7 @@ func TestFlagRuleRepository(t *testing.T) {
 	vrntID, err := vrntRepo.Create(ctx, flgID, flaggio.NewVariant{Value: "abc"})
 	assert.NoError(t, err, "failed to create variant")
 
-	var rl1ID string
+	var rl1ID, rl2ID string
 
 	tests := []struct {
 		name string
@@ -40,12 +40,48 @@ func TestFlagRuleRepository(t *testing.T) {
 			name: "create a rule",
 			run: func(t *testing.T) {
 				rl1ID, err = repo.CreateFlagRule(ctx, flgID, flaggio.NewFlagRule{
-					Constraints:   []*flaggio.NewConstraint{{Operation: flaggio.OperationOneOf, Property: "name", Values: []interface{}{"test"}}},
+					Name:          stringPtr("testers"),
+					Constraints:   []*flaggio.NewConstraint{{Operation: flaggio.OperationOneOf, Property: stringPtr("name"), Values: []interface{}{"test"}}},
 					Distributions: []*flaggio.NewDistribution{{VariantID: vrntID, Percentage: 100}},
 				})
 				assert.NoError(t, err, "failed to create rule")
 			},
 		},
+		{
+			name: "fails to create a rule with an empty constraint group",
+			run: func(t *testing.T) {
+				_, err := repo.CreateFlagRule(ctx, flgID, flaggio.NewFlagRule{
+					Constraints: []*flaggio.NewConstraint{{Operation: flaggio.OperationAny}},
+				})
+				assert.EqualError(t, err, "bad request: constraint group ANY must have nested constraints")
+			},
+		},
+		{
+			name: "fails to create a rule with constraints nested in an operation",
+			run: func(t *testing.T) {
+				_, err := repo.CreateFlagRule(ctx, flgID, flaggio.NewFlagRule{
+					Constraints: []*flaggio.NewConstraint{{
+						Operation:   flaggio.OperationExists,
+						Property:    stringPtr("name"),
+						Constraints: []*flaggio.NewConstraint{{Operation: flaggio.OperationExists, Property: stringPtr("age")}},
+					}},
+				})
+				assert.EqualError(t, err, "bad request: operation EXISTS can't have nested constraints")
+			},
+		},
+		{
+			name: "fails to create a rule with an invalid regex",
+			run: func(t *testing.T) {
+				_, err := repo.CreateFlagRule(ctx, flgID, flaggio.NewFlagRule{
+					Constraints: []*flaggio.NewConstraint{{
+						Operation: flaggio.OperationMatchesRegex,
+						Property:  stringPtr("email"),
+						Values:    []interface{}{"[a-z"},
+					}},
+				})
+				assert.EqualError(t, err, "invalid flag: MATCHES_REGEX has an invalid regex \"[a-z\": error parsing regexp: missing closing ]: `[a-z`")
+			},
+		},
 		{
 			name: "checks the rule was created",
 			run: func(t *testing.T) {
@@ -53,7 +89,8 @@ func TestFlagRuleRepository(t *testing.T) {
 				assert.NoError(t, err, "failed to find rule")
 				assert.Equal(t, &flaggio.FlagRule{
 					Rule: flaggio.Rule{
-						ID: rl.ID, // use the generated id
+						ID:   rl.ID, // use the generated id
+						Name: stringPtr("testers"),
 						Constraints: []*flaggio.Constraint{
 							{ID: rl.Constraints[0].ID, Operation: flaggio.OperationOneOf, Property: "name", Values: []interface{}{"test"}},
 						},
@@ -68,7 +105,9 @@ func TestFlagRuleRepository(t *testing.T) {
 			name: "update the rule",
 			run: func(t *testing.T) {
 				err := repo.UpdateFlagRule(ctx, flgID, rl1ID, flaggio.UpdateFlagRule{
-					Constraints:   []*flaggio.NewConstraint{{Operation: flaggio.OperationGreater, Property: "age", Values: []interface{}{18}}},
+					Description:   stringPtr("adults only"),
+					Enabled:       boolPtr(false),
+					Constraints:   []*flaggio.NewConstraint{{Operation: flaggio.OperationGreater, Property: stringPtr("age"), Values: []interface{}{18}}},
 					Distributions: []*flaggio.NewDistribution{{VariantID: vrntID, Percentage: 50}},
 				})
 				assert.NoError(t, err, "failed to update rule")
@@ -81,7 +120,10 @@ func TestFlagRuleRepository(t *testing.T) {
 				assert.NoError(t, err, "failed to find updated rule")
 				assert.Equal(t, &flaggio.FlagRule{
 					Rule: flaggio.Rule{
-						ID: rl.ID, // use the generated id
+						ID:          rl.ID, // use the generated id
+						Name:        stringPtr("testers"),
+						Description: stringPtr("adults only"),
+						Disabled:    true,
 						Constraints: []*flaggio.Constraint{
 							{ID: rl.Constraints[0].ID, Property: "age", Operation: flaggio.OperationGreater, Values: []interface{}{int32(18)}},
 						},
@@ -92,6 +134,49 @@ func TestFlagRuleRepository(t *testing.T) {
 				}, rl)
 			},
 		},
+		{
+			name: "create a second rule",
+			run: func(t *testing.T) {
+				rl2ID, err = repo.CreateFlagRule(ctx, flgID, flaggio.NewFlagRule{
+					Distributions: []*flaggio.NewDistribution{{VariantID: vrntID, Percentage: 100}},
+				})
+				assert.NoError(t, err, "failed to create rule")
+			},
+		},
+		{
+			name: "move the second rule first",
+			run: func(t *testing.T) {
+				err := repo.MoveFlagRule(ctx, flgID, rl2ID, 0)
+				assert.NoError(t, err, "failed to move rule")
+			},
+		},
+		{
+			name: "check rules were reordered",
+			run: func(t *testing.T) {
+				flg, err := flgRepo.FindByID(ctx, flgID)
+				assert.NoError(t, err, "failed to find flag")
+				assert.Len(t, flg.Rules, 2)
+				assert.Equal(t, rl2ID, flg.Rules[0].ID)
+				assert.Equal(t, rl1ID, flg.Rules[1].ID)
+				assert.Equal(t, 6, flg.Version)
+			},
+		},
+		{
+			name: "move the rule to an invalid position",
+			run: func(t *testing.T) {
+				err := repo.MoveFlagRule(ctx, flgID, rl2ID, 2)
+				assert.EqualError(t, err, "bad request: position must be between 0 and 1")
+			},
+		},
+		{
+			name: "move the second rule back",
+			run: func(t *testing.T) {
+				err := repo.MoveFlagRule(ctx, flgID, rl2ID, 1)
+				assert.NoError(t, err, "failed to move rule")
+				err = repo.DeleteFlagRule(ctx, flgID, rl2ID)
+				assert.NoError(t, err, "failed to delete rule")
+			},
+		},
 		{
 			name: "delete the rule",
 			run: func(t *testing.T) {
@@ -132,7 +217,7 @@ func TestSegmentRuleRepository(t *testing.T) {
 	sgmntID, err := sgmntRepo.Create(ctx, flaggio.NewSegment{Name: "beta testers"})
 	assert.NoError(t, err, "failed to create segment")
 
-	var rl1ID string
+	var rl1ID, rl2ID string
 
 	tests := []struct {
 		name string
@@ -142,7 +227,7 @@ func TestSegmentRuleRepository(t *testing.T) {
 			name: "create a rule",
 			run: func(t *testing.T) {
 				rl1ID, err = repo.CreateSegmentRule(ctx, sgmntID, flaggio.NewSegmentRule{
-					Constraints: []*flaggio.NewConstraint{{Operation: flaggio.OperationOneOf, Property: "name", Values: []interface{}{"test"}}},
+					Constraints: []*flaggio.NewConstraint{{Operation: flaggio.OperationOneOf, Property: stringPtr("name"), Values: []interface{}{"test"}}},
 				})
 				assert.NoError(t, err, "failed to create rule")
 			},
@@ -166,7 +251,12 @@ func TestSegmentRuleRepository(t *testing.T) {
 			name: "update the rule",
 			run: func(t *testing.T) {
 				err := repo.UpdateSegmentRule(ctx, sgmntID, rl1ID, flaggio.UpdateSegmentRule{
-					Constraints: []*flaggio.NewConstraint{{Operation: flaggio.OperationGreater, Property: "age", Values: []interface{}{18}}},
+					Constraints: []*flaggio.NewConstraint{
+						{Operation: flaggio.OperationGreater, Property: stringPtr("age"), Values: []interface{}{18}},
+						{Operation: flaggio.OperationNone, Constraints: []*flaggio.NewConstraint{
+							{Operation: flaggio.OperationOneOf, Property: stringPtr("country"), Values: []interface{}{"UK"}},
+						}},
+					},
 				})
 				assert.NoError(t, err, "failed to update rule")
 			},
@@ -181,11 +271,45 @@ func TestSegmentRuleRepository(t *testing.T) {
 						ID: rl.ID, // use the generated id
 						Constraints: []*flaggio.Constraint{
 							{ID: rl.Constraints[0].ID, Operation: flaggio.OperationGreater, Property: "age", Values: []interface{}{int32(18)}},
+							{ID: rl.Constraints[1].ID, Operation: flaggio.OperationNone, Values: []interface{}{}, Constraints: []*flaggio.Constraint{
+								{ID: rl.Constraints[1].Constraints[0].ID, Operation: flaggio.OperationOneOf, Property: "country", Values: []interface{}{"UK"}},
+							}},
 						},
 					},
 				}, rl)
 			},
 		},
+		{
+			name: "create a second rule",
+			run: func(t *testing.T) {
+				rl2ID, err = repo.CreateSegmentRule(ctx, sgmntID, flaggio.NewSegmentRule{})
+				assert.NoError(t, err, "failed to create rule")
+			},
+		},
+		{
+			name: "move the second rule first",
+			run: func(t *testing.T) {
+				err := repo.MoveSegmentRule(ctx, sgmntID, rl2ID, 0)
+				assert.NoError(t, err, "failed to move rule")
+			},
+		},
+		{
+			name: "check rules were reordered",
+			run: func(t *testing.T) {
+				sgmnt, err := sgmntRepo.FindByID(ctx, sgmntID)
+				assert.NoError(t, err, "failed to find segment")
+				assert.Len(t, sgmnt.Rules, 2)
+				assert.Equal(t, rl2ID, sgmnt.Rules[0].ID)
+				assert.Equal(t, rl1ID, sgmnt.Rules[1].ID)
+			},
+		},
+		{
+			name: "move an unknown rule",
+			run: func(t *testing.T) {
+				err := repo.MoveSegmentRule(ctx, sgmntID, sgmntID, 0)
+				assert.EqualError(t, err, "segment rule: not found")
+			},
+		},
 		{
 			name: "delete the rule",
 			run: func(t *testing.T) {
@@ -208,3 +332,130 @@ func TestSegmentRuleRepository(t *testing.T) {
 		t.Run(tt.name, tt.run)
 	}
 }
+
+func TestSegmentRuleRepository_References(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	// drop database first
+	if err := mongoDB.Drop(ctx); err != nil {
+		t.Fatalf("failed drop database: %s", err)
+	}
+
+	// create new repo
+	flgRepo, err := mongo_repo.NewFlagRepository(ctx, mongoDB)
+	assert.NoError(t, err, "failed to create flag repository")
+	sgmntRepo, err := mongo_repo.NewSegmentRepository(ctx, mongoDB)
+	assert.NoError(t, err, "failed to create segment repository")
+	repo := mongo_repo.NewRuleRepository(flgRepo.(*mongo_repo.FlagRepository), sgmntRepo.(*mongo_repo.SegmentRepository))
+
+	// create the segments
+	staffID, err := sgmntRepo.Create(ctx, flaggio.NewSegment{Name: "staff"})
+	assert.NoError(t, err, "failed to create segment")
+	betaID, err := sgmntRepo.Create(ctx, flaggio.NewSegment{Name: "beta"})
+	assert.NoError(t, err, "failed to create segment")
+	inSegment := func(id string) []*flaggio.NewConstraint {
+		return []*flaggio.NewConstraint{{Operation: flaggio.OperationIsInSegment, Values: []interface{}{id}}}
+	}
+
+	// create a flag
+	flgID, err := flgRepo.Create(ctx, flaggio.NewFlag{Key: "test"})
+	assert.NoError(t, err, "failed to create flag")
+
+	var rlID, flgRlID string
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "reference another segment",
+			run: func(t *testing.T) {
+				rlID, err = repo.CreateSegmentRule(ctx, betaID, flaggio.NewSegmentRule{Constraints: inSegment(staffID)})
+				assert.NoError(t, err, "failed to create rule")
+			},
+		},
+		{
+			name: "fails to reference the segment back",
+			run: func(t *testing.T) {
+				_, err := repo.CreateSegmentRule(ctx, staffID, flaggio.NewSegmentRule{Constraints: inSegment(betaID)})
+				assert.EqualError(t, err, "bad request: segments can't reference each other in a cycle: staff -> beta -> staff")
+			},
+		},
+		{
+			name: "fails to reference the segment itself",
+			run: func(t *testing.T) {
+				err := repo.UpdateSegmentRule(ctx, betaID, rlID, flaggio.UpdateSegmentRule{Constraints: inSegment(betaID)})
+				assert.EqualError(t, err, "bad request: segments can't reference each other in a cycle: beta -> beta")
+			},
+		},
+		{
+			name: "fails to delete a referenced segment",
+			run: func(t *testing.T) {
+				err := sgmntRepo.Delete(ctx, staffID, true)
+				assert.EqualError(t, err, "bad request: segment is referenced by other segments: beta")
+			},
+		},
+		{
+			name: "delete the segment once it's no longer referenced",
+			run: func(t *testing.T) {
+				err := repo.DeleteSegmentRule(ctx, betaID, rlID)
+				assert.NoError(t, err, "failed to delete rule")
+				err = sgmntRepo.Delete(ctx, staffID, false)
+				assert.NoError(t, err, "failed to delete segment")
+			},
+		},
+		{
+			name: "reference the segment from a flag rule",
+			run: func(t *testing.T) {
+				flgRlID, err = repo.CreateFlagRule(ctx, flgID, flaggio.NewFlagRule{Constraints: append(
+					inSegment(betaID),
+					&flaggio.NewConstraint{Property: stringPtr("plan"), Operation: flaggio.OperationOneOf, Values: []interface{}{"pro"}},
+					&flaggio.NewConstraint{Operation: flaggio.OperationNone, Constraints: inSegment(betaID)},
+				)})
+				assert.NoError(t, err, "failed to create rule")
+				_, err = repo.CreateFlagRule(ctx, flgID, flaggio.NewFlagRule{})
+				assert.NoError(t, err, "failed to create rule")
+			},
+		},
+		{
+			name: "find the flags using the segment",
+			run: func(t *testing.T) {
+				usages, err := sgmntRepo.UsedBy(ctx, betaID)
+				assert.NoError(t, err, "failed to find segment usages")
+				assert.Len(t, usages, 1)
+				assert.Equal(t, flgID, usages[0].Flag.ID)
+				assert.Len(t, usages[0].Rules, 1)
+				assert.Equal(t, flgRlID, usages[0].Rules[0].ID)
+			},
+		},
+		{
+			name: "fails to delete a segment used by flags",
+			run: func(t *testing.T) {
+				err := sgmntRepo.Delete(ctx, betaID, false)
+				assert.EqualError(t, err, "bad request: segment is used by flags: test")
+			},
+		},
+		{
+			name: "delete the segment along with its references",
+			run: func(t *testing.T) {
+				err := sgmntRepo.Delete(ctx, betaID, true)
+				assert.NoError(t, err, "failed to delete segment")
+				_, err = sgmntRepo.FindByID(ctx, betaID)
+				assert.EqualError(t, err, "segment: not found")
+				flg, err := flgRepo.FindByID(ctx, flgID)
+				assert.NoError(t, err, "failed to find flag")
+				assert.Len(t, flg.Rules, 2)
+				assert.Equal(t, flgRlID, flg.Rules[0].ID)
+				assert.Len(t, flg.Rules[0].Constraints, 1)
+				assert.Equal(t, "plan", flg.Rules[0].Constraints[0].Property)
+				assert.Equal(t, 4, flg.Version)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, tt.run)
+	}
+}
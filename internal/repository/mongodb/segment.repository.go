@@ -2,6 +2,9 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/opentracing/opentracing-go"
@@ -18,8 +21,9 @@ var _ repository.Segment = (*SegmentRepository)(nil)
 
 // SegmentRepository implements repository.Segment interface using mongodb.
 type SegmentRepository struct {
-	db  *mongo.Database
-	col *mongo.Collection
+	db       *mongo.Database
+	col      *mongo.Collection
+	flagsCol *mongo.Collection
 }
 
 // FindAll returns a list of segments, based on an optional offset and limit.
@@ -128,7 +132,7 @@ func (r *SegmentRepository) Update(ctx context.Context, idHex string, f flaggio.
 }
 
 // Delete deletes a segment.
-func (r *SegmentRepository) Delete(ctx context.Context, idHex string) error {
+func (r *SegmentRepository) Delete(ctx context.Context, idHex string, cascade bool) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoSegmentRepository.Delete")
 	defer span.Finish()
 
@@ -136,6 +140,43 @@ func (r *SegmentRepository) Delete(ctx context.Context, idHex string) error {
 	if err != nil {
 		return err
 	}
+	// segments can't be deleted while other segments reference them
+	refs, names, err := r.segmentReferences(ctx)
+	if err != nil {
+		return err
+	}
+	var referencedBy []string
+	for sgmntID, sgmntRefs := range refs {
+		for _, ref := range sgmntRefs {
+			if sgmntID != idHex && ref == idHex {
+				referencedBy = append(referencedBy, names[sgmntID])
+				break
+			}
+		}
+	}
+	if len(referencedBy) > 0 {
+		sort.Strings(referencedBy)
+		return errors.BadRequest("segment is referenced by other segments: " + strings.Join(referencedBy, ", "))
+	}
+	// references from flag rules are only removed when asked to
+	usages, err := r.UsedBy(ctx, idHex)
+	if err != nil {
+		return err
+	}
+	if len(usages) > 0 && !cascade {
+		usedBy := make([]string, len(usages))
+		for idx, usg := range usages {
+			usedBy[idx] = usg.Flag.Key
+		}
+		return errors.BadRequest("segment is used by flags: " + strings.Join(usedBy, ", "))
+	}
+	// the references are removed before the segment is deleted, so flags are
+	// never left referencing a segment that doesn't exist
+	for _, usg := range usages {
+		if err := r.removeFlagReferences(ctx, usg, idHex); err != nil {
+			return err
+		}
+	}
 	res, err := r.col.DeleteOne(ctx, bson.M{"_id": id})
 	if err != nil {
 		return err
@@ -146,6 +187,95 @@ func (r *SegmentRepository) Delete(ctx context.Context, idHex string) error {
 	return nil
 }
 
+// removeFlagReferences removes the constraints that reference a segment from
+// the rules of a flag. The flag is only updated if it wasn't changed since
+// its usage of the segment was found.
+func (r *SegmentRepository) removeFlagReferences(ctx context.Context, usg *flaggio.SegmentUsage, idHex string) error {
+	flgID, err := primitive.ObjectIDFromHex(usg.Flag.ID)
+	if err != nil {
+		return err
+	}
+	usedBy := make(map[string]bool, len(usg.Rules))
+	for _, rl := range usg.Rules {
+		usedBy[rl.ID] = true
+	}
+	mods := bson.M{"updatedAt": time.Now()}
+	for idx, rl := range usg.Flag.Rules {
+		if !usedBy[rl.ID] {
+			continue
+		}
+		constraints, err := newConstraintModelsFrom(flaggio.ConstraintList(rl.Constraints).WithoutSegment(idHex))
+		if err != nil {
+			return err
+		}
+		mods[fmt.Sprintf("rules.%d.constraints", idx)] = constraints
+	}
+	res, err := r.flagsCol.UpdateOne(ctx, bson.M{"_id": flgID, "version": usg.Flag.Version}, bson.M{
+		"$set": mods,
+		"$inc": bson.M{"version": 1},
+	})
+	if err != nil {
+		return err
+	}
+	if res.ModifiedCount == 0 {
+		return errors.BadRequest("flag " + usg.Flag.Key + " was modified concurrently, please try again")
+	}
+	return nil
+}
+
+// UsedBy returns the flags and flag rules that reference a segment.
+func (r *SegmentRepository) UsedBy(ctx context.Context, idHex string) ([]*flaggio.SegmentUsage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoSegmentRepository.UsedBy")
+	defer span.Finish()
+
+	opts := options.Find().SetSort(bson.M{"key": 1})
+	cursor, err := r.flagsCol.Find(ctx, bson.M{"rules.0": bson.M{"$exists": true}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var flgs []*flaggio.Flag
+	for cursor.Next(ctx) {
+		var f flagModel
+		if err := cursor.Decode(&f); err != nil {
+			return nil, err
+		}
+		flgs = append(flgs, f.asFlag())
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return flaggio.NewSegmentUsages(flgs, idHex), nil
+}
+
+// segmentReferences returns the IDs of the segments referenced by each
+// segment, and the name of each segment.
+func (r *SegmentRepository) segmentReferences(ctx context.Context) (map[string][]string, map[string]string, error) {
+	opts := options.Find().SetProjection(bson.M{"name": 1, "rules": 1})
+	cursor, err := r.col.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cursor.Close(ctx)
+
+	refs := map[string][]string{}
+	names := map[string]string{}
+	for cursor.Next(ctx) {
+		var s segmentModel
+		if err := cursor.Decode(&s); err != nil {
+			return nil, nil, err
+		}
+		sgmnt := s.asSegment()
+		refs[sgmnt.ID] = sgmnt.SegmentIDs()
+		names[sgmnt.ID] = sgmnt.Name
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, nil, err
+	}
+	return refs, names, nil
+}
+
 // NewSegmentRepository returns a new segment repository that uses mongodb as underlying storage.
 // It also creates all needed indexes, if they don't yet exist.
 func NewSegmentRepository(ctx context.Context, db *mongo.Database) (repository.Segment, error) {
@@ -164,7 +294,8 @@ func NewSegmentRepository(ctx context.Context, db *mongo.Database) (repository.S
 		return nil, err
 	}
 	return &SegmentRepository{
-		db:  db,
-		col: col,
+		db:       db,
+		col:      col,
+		flagsCol: db.Collection("flags"),
 	}, nil
 }
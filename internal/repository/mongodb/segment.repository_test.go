@@ -115,7 +115,7 @@ func TestSegmentRepository(t *testing.T) {
 		{
 			name: "delete the first segment",
 			run: func(t *testing.T) {
-				err = repo.Delete(ctx, sgmnt1ID)
+				err = repo.Delete(ctx, sgmnt1ID, false)
 				assert.NoError(t, err, "failed to delete first segment")
 			},
 		},
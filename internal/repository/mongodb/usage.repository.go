@@ -0,0 +1,100 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uw-labs/flaggio/internal/errors"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var _ repository.FlagUsage = (*FlagUsageRepository)(nil)
+
+// FlagUsageRepository implements repository.FlagUsage interface using mongodb.
+type FlagUsageRepository struct {
+	db  *mongo.Database
+	col *mongo.Collection
+}
+
+// FindAll returns the usage of all flags that were evaluated.
+func (r *FlagUsageRepository) FindAll(ctx context.Context) ([]*flaggio.FlagUsage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagUsageRepository.FindAll")
+	defer span.Finish()
+
+	cursor, err := r.col.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []*flaggio.FlagUsage
+	for cursor.Next(ctx) {
+		var u flagUsageModel
+		// decode the document
+		if err := cursor.Decode(&u); err != nil {
+			return nil, err
+		}
+		usages = append(usages, u.asFlagUsage())
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return usages, nil
+}
+
+// FindByFlagKey returns the usage of a flag.
+func (r *FlagUsageRepository) FindByFlagKey(ctx context.Context, flagKey string) (*flaggio.FlagUsage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagUsageRepository.FindByFlagKey")
+	defer span.Finish()
+
+	var u flagUsageModel
+	if err := r.col.FindOne(ctx, bson.M{"_id": flagKey}).Decode(&u); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.NotFound("flag usage")
+		}
+		return nil, err
+	}
+	return u.asFlagUsage(), nil
+}
+
+// RecordUsage adds the evaluation counts to the usage of each flag,
+// and moves the evaluation times forward.
+func (r *FlagUsageRepository) RecordUsage(ctx context.Context, usages []*flaggio.FlagUsage) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagUsageRepository.RecordUsage")
+	defer span.Finish()
+
+	if len(usages) == 0 {
+		return nil
+	}
+	mdls := make([]mongo.WriteModel, len(usages))
+	for idx, usg := range usages {
+		lastServed := bson.M{"lastEvaluatedAt": usg.LastEvaluatedAt}
+		for _, vrntUsg := range usg.Variants {
+			lastServed["variants."+vrntUsg.VariantID] = vrntUsg.LastServedAt
+		}
+		mdls[idx] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": usg.FlagKey}).
+			SetUpdate(bson.M{
+				"$inc": bson.M{"evaluations": usg.Evaluations},
+				"$min": bson.M{"firstEvaluatedAt": usg.FirstEvaluatedAt},
+				"$max": lastServed,
+			}).
+			SetUpsert(true)
+	}
+	_, err := r.col.BulkWrite(ctx, mdls, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// NewFlagUsageRepository returns a new flag usage repository that uses mongodb as underlying storage.
+func NewFlagUsageRepository(db *mongo.Database) repository.FlagUsage {
+	return &FlagUsageRepository{
+		db:  db,
+		col: db.Collection("flagUsage"),
+	}
+}
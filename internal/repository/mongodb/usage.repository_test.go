@@ -0,0 +1,96 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	mongo_repo "github.com/uw-labs/flaggio/internal/repository/mongodb"
+)
+
+func TestFlagUsageRepository(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	// drop database first
+	if err := mongoDB.Drop(ctx); err != nil {
+		t.Fatalf("failed drop database: %s", err)
+	}
+
+	// create new repo
+	repo := mongo_repo.NewFlagUsageRepository(mongoDB)
+	// mongo stores times with millisecond precision
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "find usage of a flag that was never evaluated",
+			run: func(t *testing.T) {
+				usg, err := repo.FindByFlagKey(ctx, "a")
+				assert.EqualError(t, err, "flag usage: not found")
+				assert.Nil(t, usg)
+			},
+		},
+		{
+			name: "record the usage of flags",
+			run: func(t *testing.T) {
+				err := repo.RecordUsage(ctx, []*flaggio.FlagUsage{
+					{
+						FlagKey: "a", Evaluations: 2, FirstEvaluatedAt: ts, LastEvaluatedAt: ts.Add(time.Minute),
+						Variants: []*flaggio.VariantUsage{{VariantID: "1", LastServedAt: ts.Add(time.Minute)}},
+					},
+					{FlagKey: "b", Evaluations: 1, FirstEvaluatedAt: ts, LastEvaluatedAt: ts},
+				})
+				assert.NoError(t, err, "failed to record usage")
+			},
+		},
+		{
+			name: "record the usage of flags again",
+			run: func(t *testing.T) {
+				err := repo.RecordUsage(ctx, []*flaggio.FlagUsage{
+					{
+						FlagKey: "a", Evaluations: 3, FirstEvaluatedAt: ts.Add(time.Hour), LastEvaluatedAt: ts.Add(time.Hour),
+						Variants: []*flaggio.VariantUsage{{VariantID: "2", LastServedAt: ts.Add(time.Hour)}},
+					},
+				})
+				assert.NoError(t, err, "failed to record usage again")
+			},
+		},
+		{
+			name: "find usage of a flag",
+			run: func(t *testing.T) {
+				usg, err := repo.FindByFlagKey(ctx, "a")
+				assert.NoError(t, err, "failed to find flag usage")
+				assert.Equal(t, &flaggio.FlagUsage{
+					FlagKey:          "a",
+					Evaluations:      5,
+					FirstEvaluatedAt: ts,
+					LastEvaluatedAt:  ts.Add(time.Hour),
+					Variants: []*flaggio.VariantUsage{
+						{VariantID: "1", LastServedAt: ts.Add(time.Minute)},
+						{VariantID: "2", LastServedAt: ts.Add(time.Hour)},
+					},
+				}, usg)
+			},
+		},
+		{
+			name: "find usage of all flags",
+			run: func(t *testing.T) {
+				usgs, err := repo.FindAll(ctx)
+				assert.NoError(t, err, "failed to find usage of all flags")
+				assert.Len(t, usgs, 2)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, tt.run)
+	}
+
+}
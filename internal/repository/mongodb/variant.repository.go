@@ -93,15 +93,8 @@ func (r *VariantRepository) Update(ctx context.Context, flagIDHex, idHex string,
 	if err != nil {
 		return err
 	}
-	mods := bson.M{
-		"updatedAt": time.Now(),
-	}
-	if v.Description != nil {
-		mods["variants.$.description"] = *v.Description
-	}
-	if v.Value != nil {
-		mods["variants.$.value"] = v.Value
-	}
+	mods := variantUpdateMods("variants.$", v)
+	mods["updatedAt"] = time.Now()
 	res, err := r.flagRepo.col.UpdateOne(
 		ctx,
 		bson.M{"_id": flagID, "variants._id": id},
@@ -143,6 +136,19 @@ func (r *VariantRepository) Delete(ctx context.Context, flagIDHex, idHex string)
 	return nil
 }
 
+// variantUpdateMods returns the fields of the variant modified by the input,
+// where path is the path to the variant in the flag document.
+func variantUpdateMods(path string, v flaggio.UpdateVariant) bson.M {
+	mods := bson.M{}
+	if v.Description != nil {
+		mods[path+".description"] = *v.Description
+	}
+	if v.Value != nil {
+		mods[path+".value"] = v.Value
+	}
+	return mods
+}
+
 // NewVariantRepository returns a new variant repository that uses mongodb
 // as underlying storage.
 func NewVariantRepository(flagRepo *FlagRepository) repository.Variant {
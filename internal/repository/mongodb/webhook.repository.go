@@ -0,0 +1,191 @@
+package mongodb
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uw-labs/flaggio/internal/errors"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var _ repository.Webhook = (*WebhookRepository)(nil)
+
+// WebhookRepository implements repository.Webhook interface using mongodb.
+type WebhookRepository struct {
+	db  *mongo.Database
+	col *mongo.Collection
+}
+
+// FindAll returns all webhooks.
+func (r *WebhookRepository) FindAll(ctx context.Context) ([]*flaggio.Webhook, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoWebhookRepository.FindAll")
+	defer span.Finish()
+
+	cursor, err := r.col.Find(ctx, bson.M{}, &options.FindOptions{
+		Sort: bson.M{"createdAt": 1},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var webhooks []*flaggio.Webhook
+	for cursor.Next(ctx) {
+		var w webhookModel
+		// decode the document
+		if err := cursor.Decode(&w); err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, w.asWebhook())
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// FindByID returns a webhook that has a given ID.
+func (r *WebhookRepository) FindByID(ctx context.Context, idHex string) (*flaggio.Webhook, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoWebhookRepository.FindByID")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return nil, err
+	}
+
+	var w webhookModel
+	if err := r.col.FindOne(ctx, bson.M{"_id": id}).Decode(&w); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.NotFound("webhook")
+		}
+		return nil, err
+	}
+	return w.asWebhook(), nil
+}
+
+// Create creates a new webhook.
+func (r *WebhookRepository) Create(ctx context.Context, w flaggio.NewWebhook) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoWebhookRepository.Create")
+	defer span.Finish()
+
+	if err := validateWebhookURL(w.URL); err != nil {
+		return "", err
+	}
+	enabled := true
+	if w.Enabled != nil {
+		enabled = *w.Enabled
+	}
+	id := primitive.NewObjectID()
+	_, err := r.col.InsertOne(ctx, &webhookModel{
+		ID:          id,
+		URL:         w.URL,
+		Secret:      w.Secret,
+		EntityTypes: entityTypeStrings(w.EntityTypes),
+		FlagKeys:    nonNilStrings(w.FlagKeys),
+		Enabled:     enabled,
+		CreatedAt:   time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return id.Hex(), nil
+}
+
+// Update updates a webhook.
+func (r *WebhookRepository) Update(ctx context.Context, idHex string, w flaggio.UpdateWebhook) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoWebhookRepository.Update")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	mods := bson.M{
+		"updatedAt": time.Now(),
+	}
+	if w.URL != nil {
+		if err := validateWebhookURL(*w.URL); err != nil {
+			return err
+		}
+		mods["url"] = *w.URL
+	}
+	if w.Secret != nil {
+		mods["secret"] = *w.Secret
+	}
+	if w.EntityTypes != nil {
+		mods["entityTypes"] = entityTypeStrings(w.EntityTypes)
+	}
+	if w.FlagKeys != nil {
+		mods["flagKeys"] = w.FlagKeys
+	}
+	if w.Enabled != nil {
+		mods["enabled"] = *w.Enabled
+	}
+	res, err := r.col.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": mods})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errors.NotFound("webhook")
+	}
+	return nil
+}
+
+// Delete deletes a webhook.
+func (r *WebhookRepository) Delete(ctx context.Context, idHex string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoWebhookRepository.Delete")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	res, err := r.col.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return errors.NotFound("webhook")
+	}
+	return nil
+}
+
+func validateWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.BadRequest("invalid webhook url")
+	}
+	return nil
+}
+
+func entityTypeStrings(entityTypes []flaggio.EntityType) []string {
+	list := make([]string, len(entityTypes))
+	for idx, et := range entityTypes {
+		list[idx] = string(et)
+	}
+	return list
+}
+
+func nonNilStrings(list []string) []string {
+	if list == nil {
+		return []string{}
+	}
+	return list
+}
+
+// NewWebhookRepository returns a new webhook repository that uses mongodb as underlying storage.
+func NewWebhookRepository(db *mongo.Database) repository.Webhook {
+	return &WebhookRepository{
+		db:  db,
+		col: db.Collection("webhooks"),
+	}
+}
@@ -0,0 +1,130 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	mongo_repo "github.com/uw-labs/flaggio/internal/repository/mongodb"
+)
+
+func TestWebhookRepository(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	// drop database first
+	if err := mongoDB.Drop(ctx); err != nil {
+		t.Fatalf("failed drop database: %s", err)
+	}
+
+	// create new repo
+	repo := mongo_repo.NewWebhookRepository(mongoDB)
+
+	var wh1ID, wh2ID string
+	var err error
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T)
+	}{
+		{
+			name: "fails to create a webhook with an invalid url",
+			run: func(t *testing.T) {
+				_, err := repo.Create(ctx, flaggio.NewWebhook{URL: "not a url", Secret: "s"})
+				assert.EqualError(t, err, "bad request: invalid webhook url")
+			},
+		},
+		{
+			name: "create the first webhook",
+			run: func(t *testing.T) {
+				wh1ID, err = repo.Create(ctx, flaggio.NewWebhook{
+					URL:    "https://example.com/hook",
+					Secret: "secret",
+				})
+				assert.NoError(t, err, "failed to create first webhook")
+			},
+		},
+		{
+			name: "create the second webhook",
+			run: func(t *testing.T) {
+				wh2ID, err = repo.Create(ctx, flaggio.NewWebhook{
+					URL:         "https://example.com/other",
+					Secret:      "other",
+					EntityTypes: []flaggio.EntityType{flaggio.EntityTypeFlag},
+					FlagKeys:    []string{"a"},
+					Enabled:     boolPtr(false),
+				})
+				assert.NoError(t, err, "failed to create second webhook")
+			},
+		},
+		{
+			name: "checks the webhooks were created",
+			run: func(t *testing.T) {
+				webhooks, err := repo.FindAll(ctx)
+				assert.NoError(t, err, "failed to find webhooks")
+				assert.Len(t, webhooks, 2)
+				assert.Equal(t, wh1ID, webhooks[0].ID)
+				assert.Equal(t, "https://example.com/hook", webhooks[0].URL)
+				assert.Equal(t, "secret", webhooks[0].Secret)
+				assert.Equal(t, []flaggio.EntityType{}, webhooks[0].EntityTypes)
+				assert.Equal(t, []string{}, webhooks[0].FlagKeys)
+				assert.True(t, webhooks[0].Enabled)
+				assert.Equal(t, wh2ID, webhooks[1].ID)
+				assert.Equal(t, []flaggio.EntityType{flaggio.EntityTypeFlag}, webhooks[1].EntityTypes)
+				assert.Equal(t, []string{"a"}, webhooks[1].FlagKeys)
+				assert.False(t, webhooks[1].Enabled)
+			},
+		},
+		{
+			name: "fails to update a webhook with an invalid url",
+			run: func(t *testing.T) {
+				err := repo.Update(ctx, wh1ID, flaggio.UpdateWebhook{URL: stringPtr("ftp://example.com")})
+				assert.EqualError(t, err, "bad request: invalid webhook url")
+			},
+		},
+		{
+			name: "update the first webhook",
+			run: func(t *testing.T) {
+				err := repo.Update(ctx, wh1ID, flaggio.UpdateWebhook{
+					URL:         stringPtr("https://example.com/new"),
+					EntityTypes: []flaggio.EntityType{flaggio.EntityTypeSegment},
+				})
+				assert.NoError(t, err, "failed to update first webhook")
+			},
+		},
+		{
+			name: "checks the webhook was updated",
+			run: func(t *testing.T) {
+				wh, err := repo.FindByID(ctx, wh1ID)
+				assert.NoError(t, err, "failed to find first webhook")
+				assert.Equal(t, "https://example.com/new", wh.URL)
+				assert.Equal(t, "secret", wh.Secret)
+				assert.Equal(t, []flaggio.EntityType{flaggio.EntityTypeSegment}, wh.EntityTypes)
+				assert.NotNil(t, wh.UpdatedAt)
+			},
+		},
+		{
+			name: "delete the second webhook",
+			run: func(t *testing.T) {
+				err := repo.Delete(ctx, wh2ID)
+				assert.NoError(t, err, "failed to delete second webhook")
+			},
+		},
+		{
+			name: "checks the webhook was deleted",
+			run: func(t *testing.T) {
+				_, err := repo.FindByID(ctx, wh2ID)
+				assert.EqualError(t, err, "webhook: not found")
+				err = repo.Delete(ctx, wh2ID)
+				assert.EqualError(t, err, "webhook: not found")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, tt.run)
+	}
+
+}
@@ -0,0 +1,154 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uw-labs/flaggio/internal/errors"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var _ repository.WebhookDelivery = (*WebhookDeliveryRepository)(nil)
+
+// WebhookDeliveryRepository implements repository.WebhookDelivery interface using mongodb.
+type WebhookDeliveryRepository struct {
+	db  *mongo.Database
+	col *mongo.Collection
+}
+
+// FindAllByWebhookID returns the deliveries of a webhook, most recent first.
+func (r *WebhookDeliveryRepository) FindAllByWebhookID(ctx context.Context, webhookIDHex string, offset, limit *int64) (*flaggio.WebhookDeliveryResults, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoWebhookDeliveryRepository.FindAllByWebhookID")
+	defer span.Finish()
+
+	webhookID, err := primitive.ObjectIDFromHex(webhookIDHex)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"webhookId": webhookID}
+
+	cursor, err := r.col.Find(ctx, filter, &options.FindOptions{
+		Skip:  offset,
+		Limit: limit,
+		Sort:  bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := []*flaggio.WebhookDelivery{}
+	for cursor.Next(ctx) {
+		var d webhookDeliveryModel
+		// decode the document
+		if err := cursor.Decode(&d); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d.asWebhookDelivery())
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	// get the total results
+	total, err := r.col.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &flaggio.WebhookDeliveryResults{
+		Deliveries: deliveries,
+		Total:      int(total),
+	}, nil
+}
+
+// Create creates a new delivery.
+func (r *WebhookDeliveryRepository) Create(ctx context.Context, dlvr *flaggio.WebhookDelivery) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoWebhookDeliveryRepository.Create")
+	defer span.Finish()
+
+	webhookID, err := primitive.ObjectIDFromHex(dlvr.WebhookID)
+	if err != nil {
+		return "", err
+	}
+	id := primitive.NewObjectID()
+	_, err = r.col.InsertOne(ctx, &webhookDeliveryModel{
+		ID:         id,
+		WebhookID:  webhookID,
+		EntityType: string(dlvr.EntityType),
+		EntityID:   dlvr.EntityID,
+		Action:     string(dlvr.Action),
+		Payload:    dlvr.Payload,
+		CreatedAt:  time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return id.Hex(), nil
+}
+
+// UpdateAttempt records the outcome of a delivery attempt.
+func (r *WebhookDeliveryRepository) UpdateAttempt(ctx context.Context, idHex string, statusCode *int, errMsg *string, delivered bool) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoWebhookDeliveryRepository.UpdateAttempt")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	update := bson.M{
+		"$inc": bson.M{"attempts": 1},
+		"$set": bson.M{
+			"statusCode": statusCode,
+			"error":      errMsg,
+			"delivered":  delivered,
+			"updatedAt":  time.Now(),
+		},
+	}
+	res, err := r.col.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errors.NotFound("webhook delivery")
+	}
+	return nil
+}
+
+// DeleteAllByWebhookID deletes the deliveries of a webhook.
+func (r *WebhookDeliveryRepository) DeleteAllByWebhookID(ctx context.Context, webhookIDHex string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoWebhookDeliveryRepository.DeleteAllByWebhookID")
+	defer span.Finish()
+
+	webhookID, err := primitive.ObjectIDFromHex(webhookIDHex)
+	if err != nil {
+		return err
+	}
+	_, err = r.col.DeleteMany(ctx, bson.M{"webhookId": webhookID})
+	return err
+}
+
+// NewWebhookDeliveryRepository returns a new webhook delivery repository that uses
+// mongodb as underlying storage. It also creates all needed indexes, if they don't yet exist.
+func NewWebhookDeliveryRepository(ctx context.Context, db *mongo.Database) (repository.WebhookDelivery, error) {
+	col := db.Collection("webhookDeliveries")
+	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "webhookId", Value: 1}, {Key: "createdAt", Value: -1}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookDeliveryRepository{
+		db:  db,
+		col: col,
+	}, nil
+}
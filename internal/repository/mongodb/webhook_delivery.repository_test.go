@@ -0,0 +1,124 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	mongo_repo "github.com/uw-labs/flaggio/internal/repository/mongodb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestWebhookDeliveryRepository(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	// drop database first
+	if err := mongoDB.Drop(ctx); err != nil {
+		t.Fatalf("failed drop database: %s", err)
+	}
+
+	// create new repo
+	repo, err := mongo_repo.NewWebhookDeliveryRepository(ctx, mongoDB)
+	assert.NoError(t, err, "failed to create webhook delivery repository")
+
+	whID := primitive.NewObjectID().Hex()
+	var dlvr1ID, dlvr2ID string
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T)
+	}{
+		{
+			name: "create deliveries",
+			run: func(t *testing.T) {
+				dlvr1ID, err = repo.Create(ctx, &flaggio.WebhookDelivery{
+					WebhookID:  whID,
+					EntityType: flaggio.EntityTypeFlag,
+					EntityID:   "1",
+					Action:     flaggio.ChangeActionCreated,
+					Payload:    `{"entityType":"FLAG"}`,
+				})
+				assert.NoError(t, err, "failed to create first delivery")
+				dlvr2ID, err = repo.Create(ctx, &flaggio.WebhookDelivery{
+					WebhookID:  whID,
+					EntityType: flaggio.EntityTypeSegment,
+					EntityID:   "2",
+					Action:     flaggio.ChangeActionDeleted,
+					Payload:    `{"entityType":"SEGMENT"}`,
+				})
+				assert.NoError(t, err, "failed to create second delivery")
+			},
+		},
+		{
+			name: "record delivery attempts",
+			run: func(t *testing.T) {
+				errMsg := "unexpected response status: 500 Internal Server Error"
+				err := repo.UpdateAttempt(ctx, dlvr1ID, intPtr(500), &errMsg, false)
+				assert.NoError(t, err, "failed to record first attempt")
+				err = repo.UpdateAttempt(ctx, dlvr1ID, intPtr(200), nil, true)
+				assert.NoError(t, err, "failed to record second attempt")
+			},
+		},
+		{
+			name: "fails to record attempts of unknown deliveries",
+			run: func(t *testing.T) {
+				err := repo.UpdateAttempt(ctx, primitive.NewObjectID().Hex(), nil, nil, true)
+				assert.EqualError(t, err, "webhook delivery: not found")
+			},
+		},
+		{
+			name: "find deliveries, most recent first",
+			run: func(t *testing.T) {
+				res, err := repo.FindAllByWebhookID(ctx, whID, nil, nil)
+				assert.NoError(t, err, "failed to find deliveries")
+				assert.Equal(t, 2, res.Total)
+				assert.Len(t, res.Deliveries, 2)
+				assert.Equal(t, dlvr2ID, res.Deliveries[0].ID)
+				assert.Equal(t, 0, res.Deliveries[0].Attempts)
+				assert.False(t, res.Deliveries[0].Delivered)
+				assert.Equal(t, dlvr1ID, res.Deliveries[1].ID)
+				assert.Equal(t, whID, res.Deliveries[1].WebhookID)
+				assert.Equal(t, flaggio.EntityTypeFlag, res.Deliveries[1].EntityType)
+				assert.Equal(t, flaggio.ChangeActionCreated, res.Deliveries[1].Action)
+				assert.Equal(t, `{"entityType":"FLAG"}`, res.Deliveries[1].Payload)
+				assert.Equal(t, 2, res.Deliveries[1].Attempts)
+				assert.Equal(t, intPtr(200), res.Deliveries[1].StatusCode)
+				assert.Nil(t, res.Deliveries[1].Error)
+				assert.True(t, res.Deliveries[1].Delivered)
+			},
+		},
+		{
+			name: "paginates deliveries",
+			run: func(t *testing.T) {
+				res, err := repo.FindAllByWebhookID(ctx, whID, int64Ptr(1), int64Ptr(1))
+				assert.NoError(t, err, "failed to find deliveries")
+				assert.Equal(t, 2, res.Total)
+				assert.Len(t, res.Deliveries, 1)
+				assert.Equal(t, dlvr1ID, res.Deliveries[0].ID)
+			},
+		},
+		{
+			name: "delete all deliveries of the webhook",
+			run: func(t *testing.T) {
+				err := repo.DeleteAllByWebhookID(ctx, whID)
+				assert.NoError(t, err, "failed to delete deliveries")
+				res, err := repo.FindAllByWebhookID(ctx, whID, nil, nil)
+				assert.NoError(t, err, "failed to find deliveries")
+				assert.Equal(t, 0, res.Total)
+				assert.Empty(t, res.Deliveries)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, tt.run)
+	}
+
+}
+
+func intPtr(i int) *int {
+	return &i
+}
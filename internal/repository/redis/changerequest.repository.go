@@ -0,0 +1,104 @@
+package redis
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v7"
+	"github.com/opentracing/opentracing-go"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/repository"
+)
+
+var _ repository.ChangeRequest = (*ChangeRequestRepository)(nil)
+
+// ChangeRequestRepository implements repository.ChangeRequest interface using redis.
+type ChangeRequestRepository struct {
+	redis     *redis.Client
+	store     repository.ChangeRequest
+	flagStore repository.Flag
+}
+
+// FindByID returns a change request that has a given ID.
+func (r *ChangeRequestRepository) FindByID(ctx context.Context, flagID, id string) (*flaggio.ChangeRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisChangeRequestRepository.FindByID")
+	defer span.Finish()
+
+	// no caching for change requests
+	return r.store.FindByID(ctx, flagID, id)
+}
+
+// Create creates a new pending change request under a flag.
+func (r *ChangeRequestRepository) Create(ctx context.Context, flagID string, cr *flaggio.ChangeRequest) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisChangeRequestRepository.Create")
+	defer span.Finish()
+
+	id, err := r.store.Create(ctx, flagID, cr)
+	if err != nil {
+		return "", err
+	}
+
+	// invalidate all relevant keys
+	return id, r.invalidateRelevantCacheKeys(ctx, flagID)
+}
+
+// Approve adds the approval of a user to a change request, applying it once
+// it has enough approvals.
+func (r *ChangeRequestRepository) Approve(ctx context.Context, flagID, id, user string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisChangeRequestRepository.Approve")
+	defer span.Finish()
+
+	// find the flag before approving, as the key may change when applied
+	f, err := r.flagStore.FindByID(ctx, flagID)
+	if err != nil {
+		return false, err
+	}
+	applied, err := r.store.Approve(ctx, flagID, id, user)
+	if err != nil {
+		return false, err
+	}
+
+	// invalidate all relevant keys
+	return applied, r.redis.WithContext(ctx).Del(
+		flaggio.FlagCacheKey("*"),
+		flaggio.FlagCacheKey(flagID),
+		flaggio.FlagCacheKey("key", f.Key),
+	).Err()
+}
+
+// Reject rejects a change request.
+func (r *ChangeRequestRepository) Reject(ctx context.Context, flagID, id, user string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisChangeRequestRepository.Reject")
+	defer span.Finish()
+
+	if err := r.store.Reject(ctx, flagID, id, user); err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.invalidateRelevantCacheKeys(ctx, flagID)
+}
+
+func (r *ChangeRequestRepository) invalidateRelevantCacheKeys(ctx context.Context, flagID string) error {
+	// find the flag so we can get the flag key
+	f, err := r.flagStore.FindByID(ctx, flagID)
+	if err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.redis.WithContext(ctx).Del(
+		flaggio.FlagCacheKey("*"),
+		flaggio.FlagCacheKey(flagID),
+		flaggio.FlagCacheKey("key", f.Key),
+	).Err()
+}
+
+// NewChangeRequestRepository returns a new change request repository that uses redis
+// as underlying storage.
+func NewChangeRequestRepository(redisClient *redis.Client, store repository.ChangeRequest, flagStore repository.Flag) repository.ChangeRequest {
+	return &ChangeRequestRepository{
+		redis:     redisClient,
+		store:     store,
+		flagStore: flagStore,
+	}
+}
@@ -0,0 +1,90 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	repository_mock "github.com/uw-labs/flaggio/internal/repository/mocks"
+	redis_repo "github.com/uw-labs/flaggio/internal/repository/redis"
+)
+
+func TestChangeRequestRepository_Create(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+	crStoreRepo := repository_mock.NewMockChangeRequest(mockCtrl)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	redisCtx := redisClient.WithContext(ctx)
+
+	// cache a flag
+	err := redisCtx.Set(flaggio.FlagCacheKey("key", "f1"), "whatever", 10*time.Minute).Err()
+	assert.NoError(t, err)
+
+	// prepare repository mock
+	flg := flagResults.Flags[0]
+	cr := &flaggio.ChangeRequest{EntityType: flaggio.EntityTypeFlag, EntityID: "2"}
+	crRedisRepo := redis_repo.NewChangeRequestRepository(redisClient, crStoreRepo, flagStoreRepo)
+	crStoreRepo.EXPECT().Create(gomock.AssignableToTypeOf(ctxInterface), "2", cr).
+		Times(1).Return("3", nil)
+	flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
+		Times(1).Return(flg, nil)
+
+	// call redis repository
+	id, err := crRedisRepo.Create(ctx, "2", cr)
+	assert.NoError(t, err)
+	assert.Equal(t, "3", id)
+
+	// check cached keys are cleared
+	cachedKeys, err := redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+	assert.NoError(t, err)
+	assert.Len(t, cachedKeys, 0)
+}
+
+func TestChangeRequestRepository_Approve(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+	crStoreRepo := repository_mock.NewMockChangeRequest(mockCtrl)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	redisCtx := redisClient.WithContext(ctx)
+
+	// cache a flag
+	err := redisCtx.Set(flaggio.FlagCacheKey("key", "f1"), "whatever", 10*time.Minute).Err()
+	assert.NoError(t, err)
+
+	// prepare repository mock
+	flg := flagResults.Flags[0]
+	crRedisRepo := redis_repo.NewChangeRequestRepository(redisClient, crStoreRepo, flagStoreRepo)
+	flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
+		Times(1).Return(flg, nil)
+	crStoreRepo.EXPECT().Approve(gomock.AssignableToTypeOf(ctxInterface), "2", "3", "jane").
+		Times(1).Return(true, nil)
+
+	// call redis repository
+	applied, err := crRedisRepo.Approve(ctx, "2", "3", "jane")
+	assert.NoError(t, err)
+	assert.True(t, applied)
+
+	// check cached keys are cleared
+	cachedKeys, err := redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+	assert.NoError(t, err)
+	assert.Len(t, cachedKeys, 0)
+}
@@ -0,0 +1,164 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"github.com/opentracing/opentracing-go"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/repository"
+	"github.com/vmihailenco/msgpack/v4"
+)
+
+var _ repository.ExclusionGroup = (*ExclusionGroupRepository)(nil)
+
+// ExclusionGroupRepository implements repository.ExclusionGroup interface using redis.
+type ExclusionGroupRepository struct {
+	redis     *redis.Client
+	store     repository.ExclusionGroup
+	flagStore repository.Flag
+	ttl       time.Duration
+}
+
+// FindAll returns all exclusion groups.
+func (r *ExclusionGroupRepository) FindAll(ctx context.Context) ([]*flaggio.ExclusionGroup, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisExclusionGroupRepository.FindAll")
+	defer span.Finish()
+
+	cacheKey := flaggio.ExclusionGroupCacheKey("*")
+
+	// fetch results from cache
+	cached, err := r.redis.WithContext(ctx).Get(cacheKey).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		// an unexpected error occurred, return it
+		return nil, err
+	}
+	if cached != "" {
+		// cache hit, unmarshal and return result
+		var g []*flaggio.ExclusionGroup
+		if err := msgpack.Unmarshal([]byte(cached), &g); err == nil {
+			// return if no errors, otherwise defer to the store
+			return g, nil
+		}
+	}
+
+	// cache miss, fetch from store
+	res, err := r.store.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// marshal and save result
+	b, err := msgpack.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.redis.WithContext(ctx).Set(cacheKey, b, r.ttl).Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// FindByID returns an exclusion group that has a given ID.
+func (r *ExclusionGroupRepository) FindByID(ctx context.Context, id string) (*flaggio.ExclusionGroup, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisExclusionGroupRepository.FindByID")
+	defer span.Finish()
+
+	// no caching for single groups, only the list is used to evaluate flags
+	return r.store.FindByID(ctx, id)
+}
+
+// Create creates a new exclusion group.
+func (r *ExclusionGroupRepository) Create(ctx context.Context, input flaggio.NewExclusionGroup) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisExclusionGroupRepository.Create")
+	defer span.Finish()
+
+	id, err := r.store.Create(ctx, input)
+	if err != nil {
+		return "", err
+	}
+	grp, err := r.store.FindByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	// invalidate all relevant keys
+	return id, r.invalidateRelevantCacheKeys(ctx, flaggio.ChangedSlotAllocations(nil, grp.Allocations))
+}
+
+// Update updates an exclusion group.
+func (r *ExclusionGroupRepository) Update(ctx context.Context, id string, input flaggio.UpdateExclusionGroup) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisExclusionGroupRepository.Update")
+	defer span.Finish()
+
+	before, err := r.store.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := r.store.Update(ctx, id, input); err != nil {
+		return err
+	}
+	after, err := r.store.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.invalidateRelevantCacheKeys(ctx, flaggio.ChangedSlotAllocations(before.Allocations, after.Allocations))
+}
+
+// Delete deletes an exclusion group.
+func (r *ExclusionGroupRepository) Delete(ctx context.Context, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisExclusionGroupRepository.Delete")
+	defer span.Finish()
+
+	grp, err := r.store.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := r.store.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.invalidateRelevantCacheKeys(ctx, flaggio.ChangedSlotAllocations(grp.Allocations, nil))
+}
+
+// invalidateRelevantCacheKeys invalidates the cached groups, and the flags
+// that changed along with them.
+func (r *ExclusionGroupRepository) invalidateRelevantCacheKeys(ctx context.Context, flagIDs []string) error {
+	keysToInvalidate := []string{flaggio.ExclusionGroupCacheKey("*")}
+	if len(flagIDs) > 0 {
+		keysToInvalidate = append(keysToInvalidate, flaggio.FlagCacheKey("*"))
+	}
+	for _, flagID := range flagIDs {
+		// find the flag so we can get the flag key
+		f, err := r.flagStore.FindByID(ctx, flagID)
+		if err != nil {
+			return err
+		}
+		keysToInvalidate = append(keysToInvalidate,
+			flaggio.FlagCacheKey(flagID),
+			flaggio.FlagCacheKey("key", f.Key),
+		)
+	}
+	return r.redis.WithContext(ctx).Del(keysToInvalidate...).Err()
+}
+
+// NewExclusionGroupRepository returns a new exclusion group repository that uses redis
+// as underlying storage.
+func NewExclusionGroupRepository(
+	redisClient *redis.Client,
+	store repository.ExclusionGroup,
+	flagStore repository.Flag,
+) repository.ExclusionGroup {
+	return &ExclusionGroupRepository{
+		redis:     redisClient,
+		store:     store,
+		flagStore: flagStore,
+		ttl:       1 * time.Hour,
+	}
+}
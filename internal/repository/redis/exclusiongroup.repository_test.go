@@ -0,0 +1,148 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	repository_mock "github.com/uw-labs/flaggio/internal/repository/mocks"
+	redis_repo "github.com/uw-labs/flaggio/internal/repository/redis"
+)
+
+var (
+	grps = []*flaggio.ExclusionGroup{
+		{ID: "1", Name: "checkout", Allocations: []*flaggio.SlotAllocation{{FlagID: "1", Start: 0, End: 500}}},
+	}
+)
+
+func TestExclusionGroupRepository_FindAll(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockExclusionGroup, *repository_mock.MockFlag)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "cache miss",
+			run: func(t *testing.T, groupStoreRepo *repository_mock.MockExclusionGroup, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				groupRedisRepo := redis_repo.NewExclusionGroupRepository(redisClient, groupStoreRepo, flagStoreRepo)
+				groupStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface)).
+					Times(1).Return(grps, nil)
+
+				res, err := groupRedisRepo.FindAll(ctx)
+				assert.NoError(t, err)
+				assert.Equal(t, grps, res)
+			},
+		},
+		{
+			name: "cache hit",
+			run: func(t *testing.T, groupStoreRepo *repository_mock.MockExclusionGroup, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				groupRedisRepo := redis_repo.NewExclusionGroupRepository(redisClient, groupStoreRepo, flagStoreRepo)
+				groupStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface)).Times(0)
+
+				res, err := groupRedisRepo.FindAll(ctx)
+				assert.NoError(t, err)
+				assert.Equal(t, grps, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+			groupStoreRepo := repository_mock.NewMockExclusionGroup(mockCtrl)
+
+			tt.run(t, groupStoreRepo, flagStoreRepo)
+		})
+	}
+}
+
+func TestExclusionGroupRepository_Update(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockExclusionGroup, *repository_mock.MockFlag)
+	}{
+		{
+			name: "clears cached groups and the flags that changed",
+			run: func(t *testing.T, groupStoreRepo *repository_mock.MockExclusionGroup, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache the groups and flags
+				for _, key := range []string{
+					flaggio.ExclusionGroupCacheKey("*"),
+					flaggio.FlagCacheKey("key", "f1"),
+					flaggio.FlagCacheKey("key", "f2"),
+				} {
+					err := redisCtx.Set(key, "whatever", 10*time.Minute).Err()
+					assert.NoError(t, err)
+				}
+
+				// prepare repository mock
+				input := flaggio.UpdateExclusionGroup{Allocations: []*flaggio.NewSlotAllocation{
+					{FlagID: "1", Start: 0, End: 500},
+					{FlagID: "2", Start: 500, End: 1000},
+				}}
+				updated := &flaggio.ExclusionGroup{ID: "1", Allocations: []*flaggio.SlotAllocation{
+					{FlagID: "1", Start: 0, End: 500},
+					{FlagID: "2", Start: 500, End: 1000},
+				}}
+				groupRedisRepo := redis_repo.NewExclusionGroupRepository(redisClient, groupStoreRepo, flagStoreRepo)
+				gomock.InOrder(
+					groupStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+						Times(1).Return(grps[0], nil),
+					groupStoreRepo.EXPECT().Update(gomock.AssignableToTypeOf(ctxInterface), "1", input).
+						Times(1).Return(nil),
+					groupStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+						Times(1).Return(updated, nil),
+				)
+				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
+					Times(1).Return(flagResults.Flags[1], nil)
+
+				// call redis repository
+				err := groupRedisRepo.Update(ctx, "1", input)
+				assert.NoError(t, err)
+
+				// only the flag that changed is cleared
+				cachedKeys, err := redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Equal(t, []string{flaggio.FlagCacheKey("key", "f1")}, cachedKeys)
+				cachedKeys, err = redisCtx.Keys(flaggio.ExclusionGroupCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Empty(t, cachedKeys)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+			groupStoreRepo := repository_mock.NewMockExclusionGroup(mockCtrl)
+
+			tt.run(t, groupStoreRepo, flagStoreRepo)
+		})
+	}
+}
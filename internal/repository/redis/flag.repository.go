@@ -21,12 +21,12 @@ type FlagRepository struct {
 	ttl   time.Duration
 }
 
-// FindAll returns a list of flags, based on an optional offset and limit.
-func (r *FlagRepository) FindAll(ctx context.Context, search *string, offset, limit *int64) (*flaggio.FlagResults, error) {
+// FindAll returns a list of flags, based on an optional search, filter, offset and limit.
+func (r *FlagRepository) FindAll(ctx context.Context, search *string, filter *flaggio.FlagFilter, offset, limit *int64) (*flaggio.FlagResults, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisFlagRepository.FindAll")
 	defer span.Finish()
 
-	shouldCache := shouldCacheFindAll(search, offset, limit)
+	shouldCache := filter == nil && shouldCacheFindAll(search, offset, limit)
 	cacheKey := flaggio.FlagCacheKey("*")
 
 	if shouldCache {
@@ -47,7 +47,7 @@ func (r *FlagRepository) FindAll(ctx context.Context, search *string, offset, li
 	}
 
 	// cache miss or disabled, fetch from store
-	res, err := r.store.FindAll(ctx, search, offset, limit)
+	res, err := r.store.FindAll(ctx, search, filter, offset, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -160,6 +160,20 @@ func (r *FlagRepository) Create(ctx context.Context, input flaggio.NewFlag) (str
 	return id, r.invalidateRelevantCacheKeys(ctx, id, input.Key)
 }
 
+// Clone creates a copy of a flag, including its variants and rules, with a new key and name.
+func (r *FlagRepository) Clone(ctx context.Context, id, key, name string) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisFlagRepository.Clone")
+	defer span.Finish()
+
+	cloneID, err := r.store.Clone(ctx, id, key, name)
+	if err != nil {
+		return "", err
+	}
+
+	// invalidate all relevant keys
+	return cloneID, r.invalidateRelevantCacheKeys(ctx, cloneID, key)
+}
+
 // Update updates a flag.
 func (r *FlagRepository) Update(ctx context.Context, id string, input flaggio.UpdateFlag) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisFlagRepository.Update")
@@ -184,7 +198,29 @@ func (r *FlagRepository) Update(ctx context.Context, id string, input flaggio.Up
 	return r.invalidateRelevantCacheKeys(ctx, id, flagKey)
 }
 
-// Delete deletes a flag.
+// Archive archives a flag, hiding it from the list of flags.
+func (r *FlagRepository) Archive(ctx context.Context, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisFlagRepository.Archive")
+	defer span.Finish()
+
+	if err := r.store.Archive(ctx, id); err != nil {
+		return err
+	}
+	return r.invalidateFlagCacheKeys(ctx, id)
+}
+
+// Restore restores an archived flag.
+func (r *FlagRepository) Restore(ctx context.Context, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisFlagRepository.Restore")
+	defer span.Finish()
+
+	if err := r.store.Restore(ctx, id); err != nil {
+		return err
+	}
+	return r.invalidateFlagCacheKeys(ctx, id)
+}
+
+// Delete deletes an archived flag.
 func (r *FlagRepository) Delete(ctx context.Context, id string) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisFlagRepository.Delete")
 	defer span.Finish()
@@ -197,11 +233,26 @@ func (r *FlagRepository) Delete(ctx context.Context, id string) error {
 
 	// delete the flag
 	if err := r.store.Delete(ctx, id); err != nil {
-		return nil
+		return err
+	}
+
+	// invalidate all relevant keys. the flag is also removed from its
+	// exclusion group
+	if err := r.invalidateRelevantCacheKeys(ctx, id, f.Key); err != nil {
+		return err
+	}
+	return r.redis.WithContext(ctx).Del(flaggio.ExclusionGroupCacheKey("*")).Err()
+}
+
+func (r *FlagRepository) invalidateFlagCacheKeys(ctx context.Context, flagID string) error {
+	// find the flag so we can get the flag key
+	f, err := r.FindByID(ctx, flagID)
+	if err != nil {
+		return err
 	}
 
 	// invalidate all relevant keys
-	return r.invalidateRelevantCacheKeys(ctx, id, f.Key)
+	return r.invalidateRelevantCacheKeys(ctx, flagID, f.Key)
 }
 
 func (r *FlagRepository) invalidateRelevantCacheKeys(ctx context.Context, flagID, flagKey string) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/uw-labs/flaggio/internal/flaggio"
 	repository_mock "github.com/uw-labs/flaggio/internal/repository/mocks"
 	redis_repo "github.com/uw-labs/flaggio/internal/repository/redis"
+	"github.com/uw-labs/flaggio/internal/service"
 )
 
 var (
@@ -41,10 +42,10 @@ func TestFlagRepository_FindAll(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
-				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil).
+				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
 					Times(1).Return(flagResults, nil)
 
-				res, err := flagRedisRepo.FindAll(ctx, nil, nil, nil)
+				res, err := flagRedisRepo.FindAll(ctx, nil, nil, nil, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, flagResults, res)
 			},
@@ -55,10 +56,10 @@ func TestFlagRepository_FindAll(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
-				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil).
+				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
 					Times(0)
 
-				res, err := flagRedisRepo.FindAll(ctx, nil, nil, nil)
+				res, err := flagRedisRepo.FindAll(ctx, nil, nil, nil, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, flagResults, res)
 			},
@@ -69,10 +70,25 @@ func TestFlagRepository_FindAll(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
-				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), stringPtr("my search"), nil, nil).
+				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), stringPtr("my search"), nil, nil, nil).
 					Times(1).Return(flagResults, nil)
 
-				res, err := flagRedisRepo.FindAll(ctx, stringPtr("my search"), nil, nil)
+				res, err := flagRedisRepo.FindAll(ctx, stringPtr("my search"), nil, nil, nil)
+				assert.NoError(t, err)
+				assert.Equal(t, flagResults, res)
+			},
+		},
+		{
+			name: "always calls underlying repository when filtering",
+			run: func(t *testing.T, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
+				filter := &flaggio.FlagFilter{Tags: []string{"checkout"}}
+				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, filter, nil, nil).
+					Times(1).Return(flagResults, nil)
+
+				res, err := flagRedisRepo.FindAll(ctx, nil, filter, nil, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, flagResults, res)
 			},
@@ -83,10 +99,10 @@ func TestFlagRepository_FindAll(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
-				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, int64Ptr(1), nil).
+				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, int64Ptr(1), nil).
 					Times(1).Return(flagResults, nil)
 
-				res, err := flagRedisRepo.FindAll(ctx, nil, int64Ptr(1), nil)
+				res, err := flagRedisRepo.FindAll(ctx, nil, nil, int64Ptr(1), nil)
 				assert.NoError(t, err)
 				assert.Equal(t, flagResults, res)
 			},
@@ -97,10 +113,10 @@ func TestFlagRepository_FindAll(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
-				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, int64Ptr(10)).
+				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, int64Ptr(10)).
 					Times(1).Return(flagResults, nil)
 
-				res, err := flagRedisRepo.FindAll(ctx, nil, nil, int64Ptr(10))
+				res, err := flagRedisRepo.FindAll(ctx, nil, nil, nil, int64Ptr(10))
 				assert.NoError(t, err)
 				assert.Equal(t, flagResults, res)
 			},
@@ -119,6 +135,46 @@ func TestFlagRepository_FindAll(t *testing.T) {
 	}
 }
 
+func TestFlagRepository_FilteredEvaluation(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+	segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+	groupRepo := repository_mock.NewMockExclusionGroup(mockCtrl)
+	evalRepo := repository_mock.NewMockEvaluation(mockCtrl)
+	userRepo := repository_mock.NewMockUser(mockCtrl)
+	flagService := service.NewFlagService(redis_repo.NewFlagRepository(redisClient, flagStoreRepo),
+		segmentRepo, groupRepo, evalRepo, userRepo, nil, service.ArchivedFlagResponseError)
+
+	// the flags are only fetched from the store once, and the filtered
+	// evaluations are served from the cached flags
+	flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
+		Times(1).Return(flagResults, nil)
+	segmentRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil).
+		Times(2).Return(nil, nil)
+	groupRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface)).
+		Times(2).Return(nil, nil)
+	evalRepo.EXPECT().FindAllByReqHash(gomock.AssignableToTypeOf(ctxInterface), gomock.Any()).
+		Times(2).Return(nil, nil)
+
+	for _, key := range []string{"f1", "f2"} {
+		res, err := flagService.EvaluateAll(ctx, &service.EvaluationRequest{
+			UserID:   "user1",
+			FlagKeys: []string{key},
+			Debug:    boolPtr(true),
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.Evaluations, 1)
+		assert.Equal(t, key, res.Evaluations[0].FlagKey)
+	}
+}
+
 func TestFlagRepository_FindByID(t *testing.T) {
 	// flush cache first
 	if err := redisClient.FlushAll().Err(); err != nil {
@@ -412,6 +468,40 @@ func TestFlagRepository_Update(t *testing.T) {
 	}
 }
 
+func TestFlagRepository_Archive(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	redisCtx := redisClient.WithContext(ctx)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+
+	// cache a flag
+	err := redisCtx.Set(flaggio.FlagCacheKey("key", "f1"), "whatever", 10*time.Minute).Err()
+	assert.NoError(t, err)
+
+	// prepare repository mock
+	flg := flagResults.Flags[0]
+	flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
+	flagStoreRepo.EXPECT().Archive(gomock.AssignableToTypeOf(ctxInterface), "1").
+		Times(1).Return(nil)
+	flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+		Times(1).Return(flg, nil)
+
+	// call redis repository
+	err = flagRedisRepo.Archive(ctx, "1")
+	assert.NoError(t, err)
+
+	// check cached keys are cleared
+	cachedKeys, err := redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+	assert.NoError(t, err)
+	assert.Len(t, cachedKeys, 0)
+}
+
 func TestFlagRepository_Delete(t *testing.T) {
 	// flush cache first
 	if err := redisClient.FlushAll().Err(); err != nil {
@@ -491,6 +581,35 @@ func TestFlagRepository_Delete(t *testing.T) {
 				assert.Len(t, cachedKeys, 0)
 			},
 		},
+		{
+			name: "clears cached exclusion groups",
+			run: func(t *testing.T, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache the exclusion groups
+				err := redisCtx.Set(flaggio.ExclusionGroupCacheKey("*"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// prepare repository mock
+				flg := flagResults.Flags[0]
+				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
+				flagStoreRepo.EXPECT().Delete(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(nil)
+				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(flg, nil)
+
+				// call redis repository
+				err = flagRedisRepo.Delete(ctx, "1")
+				assert.NoError(t, err)
+
+				// check the cached groups are cleared
+				cached, err := redisCtx.Exists(flaggio.ExclusionGroupCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Equal(t, int64(0), cached)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -512,3 +631,7 @@ func stringPtr(s string) *string {
 func int64Ptr(i int64) *int64 {
 	return &i
 }
+
+func boolPtr(b bool) *bool {
+	return &b
+}
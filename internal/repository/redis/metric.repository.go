@@ -0,0 +1,97 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"github.com/opentracing/opentracing-go"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/repository"
+)
+
+var _ repository.Metric = (*MetricRepository)(nil)
+
+// MetricRepository implements repository.Metric interface using redis.
+type MetricRepository struct {
+	redis     *redis.Client
+	store     repository.Metric
+	flagStore repository.Flag
+	ttl       time.Duration
+}
+
+// FindByID returns a metric that has a given ID.
+func (r *MetricRepository) FindByID(ctx context.Context, flagIDHex, idHex string) (*flaggio.Metric, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisMetricRepository.FindByID")
+	defer span.Finish()
+
+	// no caching for metrics
+	return r.store.FindByID(ctx, flagIDHex, idHex)
+}
+
+// Create creates a new metric.
+func (r *MetricRepository) Create(ctx context.Context, flagID string, input flaggio.NewMetric) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisMetricRepository.Create")
+	defer span.Finish()
+
+	id, err := r.store.Create(ctx, flagID, input)
+	if err != nil {
+		return "", err
+	}
+
+	// invalidate all relevant keys
+	return id, r.invalidateRelevantCacheKeys(ctx, flagID)
+}
+
+// Update updates a metric.
+func (r *MetricRepository) Update(ctx context.Context, flagID, id string, input flaggio.UpdateMetric) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisMetricRepository.Update")
+	defer span.Finish()
+
+	if err := r.store.Update(ctx, flagID, id, input); err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.invalidateRelevantCacheKeys(ctx, flagID)
+}
+
+// Delete deletes a metric.
+func (r *MetricRepository) Delete(ctx context.Context, flagID, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisMetricRepository.Delete")
+	defer span.Finish()
+
+	// delete the metric
+	if err := r.store.Delete(ctx, flagID, id); err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.invalidateRelevantCacheKeys(ctx, flagID)
+}
+
+func (r *MetricRepository) invalidateRelevantCacheKeys(ctx context.Context, flagID string) error {
+	// find the flag so we can get the flag key
+	f, err := r.flagStore.FindByID(ctx, flagID)
+	if err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.redis.WithContext(ctx).Del(
+		flaggio.FlagCacheKey("*"),
+		flaggio.FlagCacheKey(flagID),
+		flaggio.FlagCacheKey("key", f.Key),
+	).Err()
+}
+
+// NewMetricRepository returns a new metric repository that uses redis
+// as underlying storage.
+func NewMetricRepository(redisClient *redis.Client, store repository.Metric, flagStore repository.Flag) repository.Metric {
+	return &MetricRepository{
+		redis:     redisClient,
+		store:     store,
+		flagStore: flagStore,
+		ttl:       1 * time.Hour,
+	}
+}
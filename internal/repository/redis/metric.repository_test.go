@@ -0,0 +1,347 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	repository_mock "github.com/uw-labs/flaggio/internal/repository/mocks"
+	redis_repo "github.com/uw-labs/flaggio/internal/repository/redis"
+)
+
+var (
+	mtrc = &flaggio.Metric{
+		ID: "1", Key: "purchase", Name: "Purchase",
+	}
+)
+
+func TestMetricRepository_FindByID(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockMetric, *repository_mock.MockFlag)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "calls underlying repository",
+			run: func(t *testing.T, metricStoreRepo *repository_mock.MockMetric, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				metricRedisRepo := redis_repo.NewMetricRepository(redisClient, metricStoreRepo, flagStoreRepo)
+				metricStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2", "1").
+					Times(2).Return(mtrc, nil)
+
+				res, err := metricRedisRepo.FindByID(ctx, "2", "1")
+				assert.NoError(t, err)
+				assert.Equal(t, mtrc, res)
+
+				res2, err2 := metricRedisRepo.FindByID(ctx, "2", "1")
+				assert.NoError(t, err2)
+				assert.Equal(t, mtrc, res2)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+			metricStoreRepo := repository_mock.NewMockMetric(mockCtrl)
+
+			tt.run(t, metricStoreRepo, flagStoreRepo)
+		})
+	}
+}
+
+func TestMetricRepository_Create(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockMetric, *repository_mock.MockFlag)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "doesn't clear cached evaluations",
+			run: func(t *testing.T, metricStoreRepo *repository_mock.MockMetric, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache an evaluation
+				err := redisCtx.Set(flaggio.EvalCacheKey("test"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// verify there is a cached evaluation key
+				cachedKeys, err := redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 1)
+
+				// prepare repository mock
+				flg := flagResults.Flags[0]
+				metricRedisRepo := redis_repo.NewMetricRepository(redisClient, metricStoreRepo, flagStoreRepo)
+				metricStoreRepo.EXPECT().Create(gomock.AssignableToTypeOf(ctxInterface), "2", flaggio.NewMetric{Key: "purchase", Name: "Purchase"}).
+					Times(1).Return(mtrc.ID, nil)
+				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
+					Times(1).Return(flg, nil)
+
+				// call redis repository
+				id, err := metricRedisRepo.Create(ctx, "2", flaggio.NewMetric{Key: "purchase", Name: "Purchase"})
+				assert.NoError(t, err)
+				assert.Equal(t, mtrc.ID, id)
+
+				// check cached keys are cleared
+				cachedKeys, err = redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 1)
+			},
+		},
+		{
+			name: "clears relevant cached flags",
+			run: func(t *testing.T, metricStoreRepo *repository_mock.MockMetric, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache a flag
+				err := redisCtx.Set(flaggio.FlagCacheKey("key", "f1"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// verify there is a cached flag key
+				cachedKeys, err := redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 1)
+
+				// prepare repository mock
+				flg := flagResults.Flags[0]
+				metricRedisRepo := redis_repo.NewMetricRepository(redisClient, metricStoreRepo, flagStoreRepo)
+				metricStoreRepo.EXPECT().Create(gomock.AssignableToTypeOf(ctxInterface), "2", flaggio.NewMetric{Key: "signup", Name: "Signup"}).
+					Times(1).Return(mtrc.ID, nil)
+				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
+					Times(1).Return(flg, nil)
+
+				// call redis repository
+				id, err := metricRedisRepo.Create(ctx, "2", flaggio.NewMetric{Key: "signup", Name: "Signup"})
+				assert.NoError(t, err)
+				assert.Equal(t, mtrc.ID, id)
+
+				// check cached keys are cleared
+				cachedKeys, err = redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+			metricStoreRepo := repository_mock.NewMockMetric(mockCtrl)
+
+			tt.run(t, metricStoreRepo, flagStoreRepo)
+		})
+	}
+}
+
+func TestMetricRepository_Update(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockMetric, *repository_mock.MockFlag)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "doesn't clear cached evaluations",
+			run: func(t *testing.T, metricStoreRepo *repository_mock.MockMetric, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache an evaluation
+				err := redisCtx.Set(flaggio.EvalCacheKey("test"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// verify there is a cached evaluation key
+				cachedKeys, err := redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 1)
+
+				// prepare repository mock
+				flg := flagResults.Flags[0]
+				metricRedisRepo := redis_repo.NewMetricRepository(redisClient, metricStoreRepo, flagStoreRepo)
+				metricStoreRepo.EXPECT().Update(gomock.AssignableToTypeOf(ctxInterface), "2", "1", flaggio.UpdateMetric{Name: stringPtr("abc")}).
+					Times(1).Return(nil)
+				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
+					Times(1).Return(flg, nil)
+
+				// call redis repository
+				err = metricRedisRepo.Update(ctx, "2", "1", flaggio.UpdateMetric{Name: stringPtr("abc")})
+				assert.NoError(t, err)
+
+				// check cached keys are cleared
+				cachedKeys, err = redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 1)
+			},
+		},
+		{
+			name: "clears relevant cached flags",
+			run: func(t *testing.T, metricStoreRepo *repository_mock.MockMetric, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache a flag
+				err := redisCtx.Set(flaggio.FlagCacheKey("key", "f1"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// verify there is a cached flag key
+				cachedKeys, err := redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 1)
+
+				// prepare repository mock
+				flg := flagResults.Flags[0]
+				metricRedisRepo := redis_repo.NewMetricRepository(redisClient, metricStoreRepo, flagStoreRepo)
+				metricStoreRepo.EXPECT().Update(gomock.AssignableToTypeOf(ctxInterface), "2", "1", flaggio.UpdateMetric{Name: stringPtr("cde")}).
+					Times(1).Return(nil)
+				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
+					Times(1).Return(flg, nil)
+
+				// call redis repository
+				err = metricRedisRepo.Update(ctx, "2", "1", flaggio.UpdateMetric{Name: stringPtr("cde")})
+				assert.NoError(t, err)
+
+				// check cached keys are cleared
+				cachedKeys, err = redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+			metricStoreRepo := repository_mock.NewMockMetric(mockCtrl)
+
+			tt.run(t, metricStoreRepo, flagStoreRepo)
+		})
+	}
+}
+
+func TestMetricRepository_Delete(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockMetric, *repository_mock.MockFlag)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "doesn't clear cached evaluations",
+			run: func(t *testing.T, metricStoreRepo *repository_mock.MockMetric, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache an evaluation
+				err := redisCtx.Set(flaggio.EvalCacheKey("test"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// verify there is a cached evaluation key
+				cachedKeys, err := redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 1)
+
+				// prepare repository mock
+				flg := flagResults.Flags[0]
+				metricRedisRepo := redis_repo.NewMetricRepository(redisClient, metricStoreRepo, flagStoreRepo)
+				metricStoreRepo.EXPECT().Delete(gomock.AssignableToTypeOf(ctxInterface), "2", "1").
+					Times(1).Return(nil)
+				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
+					Times(1).Return(flg, nil)
+
+				// call redis repository
+				err = metricRedisRepo.Delete(ctx, "2", "1")
+				assert.NoError(t, err)
+
+				// check cached keys are cleared
+				cachedKeys, err = redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 1)
+			},
+		},
+		{
+			name: "clears relevant cached flags",
+			run: func(t *testing.T, metricStoreRepo *repository_mock.MockMetric, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache a flag
+				err := redisCtx.Set(flaggio.FlagCacheKey("key", "f1"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// verify there is a cached flag key
+				cachedKeys, err := redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 1)
+
+				// prepare repository mock
+				flg := flagResults.Flags[0]
+				metricRedisRepo := redis_repo.NewMetricRepository(redisClient, metricStoreRepo, flagStoreRepo)
+				metricStoreRepo.EXPECT().Delete(gomock.AssignableToTypeOf(ctxInterface), "2", "1").
+					Times(1).Return(nil)
+				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
+					Times(1).Return(flg, nil)
+
+				// call redis repository
+				err = metricRedisRepo.Delete(ctx, "2", "1")
+				assert.NoError(t, err)
+
+				// check cached keys are cleared
+				cachedKeys, err = redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+			metricStoreRepo := repository_mock.NewMockMetric(mockCtrl)
+
+			tt.run(t, metricStoreRepo, flagStoreRepo)
+		})
+	}
+}
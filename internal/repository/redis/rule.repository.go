@@ -72,6 +72,20 @@ func (r *RuleRepository) DeleteFlagRule(ctx context.Context, flagID, id string)
 	return r.invalidateFlagRelevantCacheKeys(ctx, flagID)
 }
 
+// MoveFlagRule moves a rule under a flag to the given position.
+func (r *RuleRepository) MoveFlagRule(ctx context.Context, flagID, id string, position int) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRuleRepository.MoveFlagRule")
+	defer span.Finish()
+
+	err := r.store.MoveFlagRule(ctx, flagID, id, position)
+	if err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.invalidateFlagRelevantCacheKeys(ctx, flagID)
+}
+
 // FindSegmentRuleByID returns a segment rule that has a given ID.
 func (r *RuleRepository) FindSegmentRuleByID(ctx context.Context, segmentIDHex, idHex string) (*flaggio.SegmentRule, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRuleRepository.FindSegmentRuleByID")
@@ -123,6 +137,20 @@ func (r *RuleRepository) DeleteSegmentRule(ctx context.Context, segmentID, id st
 	return r.invalidateSegmentRelevantCacheKeys(ctx, segmentID)
 }
 
+// MoveSegmentRule moves a rule under a segment to the given position.
+func (r *RuleRepository) MoveSegmentRule(ctx context.Context, segmentID, id string, position int) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRuleRepository.MoveSegmentRule")
+	defer span.Finish()
+
+	err := r.store.MoveSegmentRule(ctx, segmentID, id, position)
+	if err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.invalidateSegmentRelevantCacheKeys(ctx, segmentID)
+}
+
 func (r *RuleRepository) invalidateFlagRelevantCacheKeys(ctx context.Context, flagID string) error {
 	// find the flag so we can get the flag key
 	f, err := r.flagStore.FindByID(ctx, flagID)
@@ -134,18 +134,52 @@ func (r *SegmentRepository) Update(ctx context.Context, id string, input flaggio
 }
 
 // Delete deletes a segment.
-func (r *SegmentRepository) Delete(ctx context.Context, id string) error {
+func (r *SegmentRepository) Delete(ctx context.Context, id string, cascade bool) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisSegmentRepository.Delete")
 	defer span.Finish()
 
-	if err := r.store.Delete(ctx, id); err != nil {
-		return err
+	// find the flags that can have references removed along with the segment
+	var usages []*flaggio.SegmentUsage
+	if cascade {
+		var err error
+		usages, err = r.store.UsedBy(ctx, id)
+		if err != nil {
+			return err
+		}
 	}
 
+	// flags can be updated even if the segment isn't deleted, so their keys
+	// are invalidated either way
+	deleteErr := r.store.Delete(ctx, id, cascade)
+
 	// invalidate all relevant keys
+	if len(usages) > 0 {
+		keysToInvalidate := []string{flaggio.FlagCacheKey("*")}
+		for _, usg := range usages {
+			keysToInvalidate = append(keysToInvalidate,
+				flaggio.FlagCacheKey(usg.Flag.ID),
+				flaggio.FlagCacheKey("key", usg.Flag.Key),
+			)
+		}
+		if err := r.redis.WithContext(ctx).Del(keysToInvalidate...).Err(); err != nil {
+			return err
+		}
+	}
+	if deleteErr != nil {
+		return deleteErr
+	}
 	return r.invalidateRelevantCacheKeys(ctx, id)
 }
 
+// UsedBy returns the flags and flag rules that reference a segment.
+func (r *SegmentRepository) UsedBy(ctx context.Context, id string) ([]*flaggio.SegmentUsage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisSegmentRepository.UsedBy")
+	defer span.Finish()
+
+	// no caching for segment usages
+	return r.store.UsedBy(ctx, id)
+}
+
 func (r *SegmentRepository) invalidateRelevantCacheKeys(ctx context.Context, segmentID string) error {
 	redisCtx := r.redis.WithContext(ctx)
 
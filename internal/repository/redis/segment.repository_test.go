@@ -232,6 +232,36 @@ func TestSegmentRepository_Create(t *testing.T) {
 				assert.Len(t, cachedKeys, 0)
 			},
 		},
+		{
+			name: "clears flags with rules deleted along with the segment",
+			run: func(t *testing.T, segmentStoreRepo *repository_mock.MockSegment) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache a flag
+				err := redisCtx.Set(flaggio.FlagCacheKey("2"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+				err = redisCtx.Set(flaggio.FlagCacheKey("key", "test"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// prepare repository mock
+				segmentRedisRepo := redis_repo.NewSegmentRepository(redisClient, segmentStoreRepo)
+				segmentStoreRepo.EXPECT().UsedBy(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return([]*flaggio.SegmentUsage{{Flag: &flaggio.Flag{ID: "2", Key: "test"}}}, nil)
+				segmentStoreRepo.EXPECT().Delete(gomock.AssignableToTypeOf(ctxInterface), "1", true).
+					Times(1).Return(nil)
+
+				// call redis repository
+				err = segmentRedisRepo.Delete(ctx, "1", true)
+				assert.NoError(t, err)
+
+				// check cached keys are cleared
+				cachedKeys, err := redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 0)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -319,6 +349,36 @@ func TestSegmentRepository_Update(t *testing.T) {
 				assert.Len(t, cachedKeys, 0)
 			},
 		},
+		{
+			name: "clears flags with rules deleted along with the segment",
+			run: func(t *testing.T, segmentStoreRepo *repository_mock.MockSegment) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache a flag
+				err := redisCtx.Set(flaggio.FlagCacheKey("2"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+				err = redisCtx.Set(flaggio.FlagCacheKey("key", "test"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// prepare repository mock
+				segmentRedisRepo := redis_repo.NewSegmentRepository(redisClient, segmentStoreRepo)
+				segmentStoreRepo.EXPECT().UsedBy(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return([]*flaggio.SegmentUsage{{Flag: &flaggio.Flag{ID: "2", Key: "test"}}}, nil)
+				segmentStoreRepo.EXPECT().Delete(gomock.AssignableToTypeOf(ctxInterface), "1", true).
+					Times(1).Return(nil)
+
+				// call redis repository
+				err = segmentRedisRepo.Delete(ctx, "1", true)
+				assert.NoError(t, err)
+
+				// check cached keys are cleared
+				cachedKeys, err := redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 0)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -362,11 +422,11 @@ func TestSegmentRepository_Delete(t *testing.T) {
 
 				// prepare repository mock
 				segmentRedisRepo := redis_repo.NewSegmentRepository(redisClient, segmentStoreRepo)
-				segmentStoreRepo.EXPECT().Delete(gomock.AssignableToTypeOf(ctxInterface), "1").
+				segmentStoreRepo.EXPECT().Delete(gomock.AssignableToTypeOf(ctxInterface), "1", false).
 					Times(1).Return(nil)
 
 				// call redis repository
-				err = segmentRedisRepo.Delete(ctx, "1")
+				err = segmentRedisRepo.Delete(ctx, "1", false)
 				assert.NoError(t, err)
 
 				// check cached keys are cleared
@@ -393,11 +453,11 @@ func TestSegmentRepository_Delete(t *testing.T) {
 
 				// prepare repository mock
 				segmentRedisRepo := redis_repo.NewSegmentRepository(redisClient, segmentStoreRepo)
-				segmentStoreRepo.EXPECT().Delete(gomock.AssignableToTypeOf(ctxInterface), "1").
+				segmentStoreRepo.EXPECT().Delete(gomock.AssignableToTypeOf(ctxInterface), "1", false).
 					Times(1).Return(nil)
 
 				// call redis repository
-				err = segmentRedisRepo.Delete(ctx, "1")
+				err = segmentRedisRepo.Delete(ctx, "1", false)
 				assert.NoError(t, err)
 
 				// check cached keys are cleared
@@ -406,6 +466,36 @@ func TestSegmentRepository_Delete(t *testing.T) {
 				assert.Len(t, cachedKeys, 0)
 			},
 		},
+		{
+			name: "clears flags with rules deleted along with the segment",
+			run: func(t *testing.T, segmentStoreRepo *repository_mock.MockSegment) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache a flag
+				err := redisCtx.Set(flaggio.FlagCacheKey("2"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+				err = redisCtx.Set(flaggio.FlagCacheKey("key", "test"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// prepare repository mock
+				segmentRedisRepo := redis_repo.NewSegmentRepository(redisClient, segmentStoreRepo)
+				segmentStoreRepo.EXPECT().UsedBy(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return([]*flaggio.SegmentUsage{{Flag: &flaggio.Flag{ID: "2", Key: "test"}}}, nil)
+				segmentStoreRepo.EXPECT().Delete(gomock.AssignableToTypeOf(ctxInterface), "1", true).
+					Times(1).Return(nil)
+
+				// call redis repository
+				err = segmentRedisRepo.Delete(ctx, "1", true)
+				assert.NoError(t, err)
+
+				// check cached keys are cleared
+				cachedKeys, err := redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 0)
+			},
+		},
 	}
 
 	for _, tt := range tests {
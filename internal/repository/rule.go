@@ -18,6 +18,8 @@ type Rule interface {
 	UpdateFlagRule(ctx context.Context, flagID, id string, input flaggio.UpdateFlagRule) error
 	// DeleteFlagRule deletes a rule under a flag.
 	DeleteFlagRule(ctx context.Context, flagID, id string) error
+	// MoveFlagRule moves a rule under a flag to the given position.
+	MoveFlagRule(ctx context.Context, flagID, id string, position int) error
 	// FindSegmentRuleByID returns a segment rule that has a given ID.
 	FindSegmentRuleByID(ctx context.Context, segmentIDHex, idHex string) (*flaggio.SegmentRule, error)
 	// CreateSegmentRule creates a new rule under a segment.
@@ -26,4 +28,6 @@ type Rule interface {
 	UpdateSegmentRule(ctx context.Context, segmentID, id string, input flaggio.UpdateSegmentRule) error
 	// DeleteSegmentRule deletes a rule under a segment.
 	DeleteSegmentRule(ctx context.Context, segmentID, id string) error
+	// MoveSegmentRule moves a rule under a segment to the given position.
+	MoveSegmentRule(ctx context.Context, segmentID, id string, position int) error
 }
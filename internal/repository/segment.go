@@ -18,6 +18,10 @@ type Segment interface {
 	Create(ctx context.Context, input flaggio.NewSegment) (string, error)
 	// Update updates a segment.
 	Update(ctx context.Context, id string, input flaggio.UpdateSegment) error
-	// Delete deletes a segment.
-	Delete(ctx context.Context, id string) error
+	// UsedBy returns the flags and flag rules that reference a segment.
+	UsedBy(ctx context.Context, id string) ([]*flaggio.SegmentUsage, error)
+	// Delete deletes a segment. If the segment is referenced by flag rules,
+	// the segment is only deleted when cascade is true, after the constraints
+	// that reference it are removed from the rules.
+	Delete(ctx context.Context, id string, cascade bool) error
 }
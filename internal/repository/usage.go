@@ -0,0 +1,21 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/usage_mock.go -package=repository_mock github.com/uw-labs/flaggio/internal/repository FlagUsage
+
+import (
+	"context"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+// FlagUsage represents a set of operations available to record and list
+// how flags are being evaluated.
+type FlagUsage interface {
+	// FindAll returns the usage of all flags that were evaluated.
+	FindAll(ctx context.Context) ([]*flaggio.FlagUsage, error)
+	// FindByFlagKey returns the usage of a flag.
+	FindByFlagKey(ctx context.Context, flagKey string) (*flaggio.FlagUsage, error)
+	// RecordUsage adds the evaluation counts to the usage of each flag,
+	// and moves the evaluation times forward.
+	RecordUsage(ctx context.Context, usages []*flaggio.FlagUsage) error
+}
@@ -0,0 +1,36 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/webhook_mock.go -package=repository_mock github.com/uw-labs/flaggio/internal/repository Webhook,WebhookDelivery
+
+import (
+	"context"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+// Webhook represents a set of operations available to list and manage webhooks.
+type Webhook interface {
+	// FindAll returns all webhooks.
+	FindAll(ctx context.Context) ([]*flaggio.Webhook, error)
+	// FindByID returns a webhook that has a given ID.
+	FindByID(ctx context.Context, id string) (*flaggio.Webhook, error)
+	// Create creates a new webhook.
+	Create(ctx context.Context, input flaggio.NewWebhook) (string, error)
+	// Update updates a webhook.
+	Update(ctx context.Context, id string, input flaggio.UpdateWebhook) error
+	// Delete deletes a webhook.
+	Delete(ctx context.Context, id string) error
+}
+
+// WebhookDelivery represents a set of operations available to list and manage
+// the deliveries of webhook notifications.
+type WebhookDelivery interface {
+	// FindAllByWebhookID returns the deliveries of a webhook, most recent first.
+	FindAllByWebhookID(ctx context.Context, webhookID string, offset, limit *int64) (*flaggio.WebhookDeliveryResults, error)
+	// Create creates a new delivery.
+	Create(ctx context.Context, dlvr *flaggio.WebhookDelivery) (string, error)
+	// UpdateAttempt records the outcome of a delivery attempt.
+	UpdateAttempt(ctx context.Context, id string, statusCode *int, errMsg *string, delivered bool) error
+	// DeleteAllByWebhookID deletes the deliveries of a webhook.
+	DeleteAllByWebhookID(ctx context.Context, webhookID string) error
+}
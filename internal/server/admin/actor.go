@@ -0,0 +1,84 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/uw-labs/flaggio/internal/errors"
+)
+
+type actorCtxKey struct{}
+
+// ActorMiddleware identifies the user making the request to the admin API from
+// a request header, which must be set by an authenticating proxy. The header
+// is only trusted on requests coming from one of the trusted proxies, since
+// anyone else can send any value in it. If there are no trusted proxies, the
+// user making the request is never identified.
+func ActorMiddleware(header string, trustedProxies []*net.IPNet) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if actor := r.Header.Get(header); actor != "" && fromTrustedProxy(r, trustedProxies) {
+				r = r.WithContext(context.WithValue(r.Context(), actorCtxKey{}, actor))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ParseTrustedProxies parses the addresses of the trusted proxies, which are
+// either IPs or networks in CIDR notation.
+func ParseTrustedProxies(values []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(values))
+	for _, value := range values {
+		if !strings.Contains(value, "/") {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy: %s", value)
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy: %s", value)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// fromTrustedProxy returns whether the request was sent by one of the
+// trusted proxies.
+func fromTrustedProxy(r *http.Request, trustedProxies []*net.IPNet) bool {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// actorFromContext returns the user making the request, as identified by
+// the ActorMiddleware.
+func actorFromContext(ctx context.Context) (string, error) {
+	actor, ok := ctx.Value(actorCtxKey{}).(string)
+	if !ok {
+		return "", errors.BadRequest("the user making the request is unknown")
+	}
+	return actor, nil
+}
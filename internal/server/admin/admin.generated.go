@@ -37,20 +37,49 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	Flag() FlagResolver
 	Mutation() MutationResolver
 	Query() QueryResolver
+	Segment() SegmentResolver
 	User() UserResolver
+	Webhook() WebhookResolver
 }
 
 type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	Approval struct {
+		CreatedAt func(childComplexity int) int
+		User      func(childComplexity int) int
+	}
+
+	ChangeRequest struct {
+		Approvals         func(childComplexity int) int
+		Author            func(childComplexity int) int
+		CreatedAt         func(childComplexity int) int
+		Diff              func(childComplexity int) int
+		EntityID          func(childComplexity int) int
+		EntityType        func(childComplexity int) int
+		FlagVersion       func(childComplexity int) int
+		ID                func(childComplexity int) int
+		RejectedBy        func(childComplexity int) int
+		RequiredApprovals func(childComplexity int) int
+		Status            func(childComplexity int) int
+		UpdatedAt         func(childComplexity int) int
+	}
+
+	ConfidenceInterval struct {
+		Lower func(childComplexity int) int
+		Upper func(childComplexity int) int
+	}
+
 	Constraint struct {
-		ID        func(childComplexity int) int
-		Operation func(childComplexity int) int
-		Property  func(childComplexity int) int
-		Values    func(childComplexity int) int
+		Constraints func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Operation   func(childComplexity int) int
+		Property    func(childComplexity int) int
+		Values      func(childComplexity int) int
 	}
 
 	Distribution struct {
@@ -65,7 +94,11 @@ type ComplexityRoot struct {
 		FlagKey     func(childComplexity int) int
 		FlagVersion func(childComplexity int) int
 		ID          func(childComplexity int) int
+		Reason      func(childComplexity int) int
+		RuleName    func(childComplexity int) int
+		StackTrace  func(childComplexity int) int
 		Value       func(childComplexity int) int
+		VariantID   func(childComplexity int) int
 	}
 
 	EvaluationResults struct {
@@ -73,17 +106,52 @@ type ComplexityRoot struct {
 		Total       func(childComplexity int) int
 	}
 
+	ExclusionGroup struct {
+		Allocations func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		Description func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Name        func(childComplexity int) int
+		UpdatedAt   func(childComplexity int) int
+	}
+
+	ExperimentResults struct {
+		Metrics func(childComplexity int) int
+	}
+
+	FieldChange struct {
+		Field    func(childComplexity int) int
+		NewValue func(childComplexity int) int
+		OldValue func(childComplexity int) int
+	}
+
 	Flag struct {
+		Archived              func(childComplexity int) int
+		ArchivedAt            func(childComplexity int) int
+		ChangeRequests        func(childComplexity int) int
+		ClientExposed         func(childComplexity int) int
 		CreatedAt             func(childComplexity int) int
 		DefaultVariantWhenOff func(childComplexity int) int
 		DefaultVariantWhenOn  func(childComplexity int) int
 		Description           func(childComplexity int) int
 		Enabled               func(childComplexity int) int
+		Experiment            func(childComplexity int) int
+		ExperimentResults     func(childComplexity int) int
+		ExpiresAt             func(childComplexity int) int
 		ID                    func(childComplexity int) int
 		Key                   func(childComplexity int) int
+		Kind                  func(childComplexity int) int
+		Links                 func(childComplexity int) int
+		Maintainer            func(childComplexity int) int
+		Metrics               func(childComplexity int) int
 		Name                  func(childComplexity int) int
+		OwnerTeam             func(childComplexity int) int
+		Protected             func(childComplexity int) int
+		RequiredApprovals     func(childComplexity int) int
 		Rules                 func(childComplexity int) int
+		Tags                  func(childComplexity int) int
 		UpdatedAt             func(childComplexity int) int
+		Usage                 func(childComplexity int) int
 		Variants              func(childComplexity int) int
 	}
 
@@ -94,39 +162,99 @@ type ComplexityRoot struct {
 
 	FlagRule struct {
 		Constraints   func(childComplexity int) int
+		Description   func(childComplexity int) int
 		Distributions func(childComplexity int) int
+		Enabled       func(childComplexity int) int
 		ID            func(childComplexity int) int
+		Name          func(childComplexity int) int
+	}
+
+	FlagSimulation struct {
+		AffectedUsers func(childComplexity int) int
+		SampleUserIDs func(childComplexity int) int
+		TotalUsers    func(childComplexity int) int
+		Users         func(childComplexity int) int
+	}
+
+	FlagUsage struct {
+		Evaluations      func(childComplexity int) int
+		FirstEvaluatedAt func(childComplexity int) int
+		LastEvaluatedAt  func(childComplexity int) int
+		Variants         func(childComplexity int) int
+	}
+
+	LintIssue struct {
+		Code     func(childComplexity int) int
+		Message  func(childComplexity int) int
+		RuleID   func(childComplexity int) int
+		Severity func(childComplexity int) int
+	}
+
+	Metric struct {
+		Description func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Key         func(childComplexity int) int
+		Name        func(childComplexity int) int
+	}
+
+	MetricResults struct {
+		Metric   func(childComplexity int) int
+		Variants func(childComplexity int) int
 	}
 
 	Mutation struct {
-		CreateFlag        func(childComplexity int, input flaggio.NewFlag) int
-		CreateFlagRule    func(childComplexity int, flagID string, input flaggio.NewFlagRule) int
-		CreateSegment     func(childComplexity int, input flaggio.NewSegment) int
-		CreateSegmentRule func(childComplexity int, segmentID string, input flaggio.NewSegmentRule) int
-		CreateVariant     func(childComplexity int, flagID string, input flaggio.NewVariant) int
-		DeleteEvaluation  func(childComplexity int, id string) int
-		DeleteFlag        func(childComplexity int, id string) int
-		DeleteFlagRule    func(childComplexity int, flagID string, id string) int
-		DeleteSegment     func(childComplexity int, id string) int
-		DeleteSegmentRule func(childComplexity int, segmentID string, id string) int
-		DeleteUser        func(childComplexity int, id string) int
-		DeleteVariant     func(childComplexity int, flagID string, id string) int
-		Ping              func(childComplexity int) int
-		UpdateFlag        func(childComplexity int, id string, input flaggio.UpdateFlag) int
-		UpdateFlagRule    func(childComplexity int, flagID string, id string, input flaggio.UpdateFlagRule) int
-		UpdateSegment     func(childComplexity int, id string, input flaggio.UpdateSegment) int
-		UpdateSegmentRule func(childComplexity int, segmentID string, id string, input flaggio.UpdateSegmentRule) int
-		UpdateVariant     func(childComplexity int, flagID string, id string, input flaggio.UpdateVariant) int
+		ApproveChangeRequest func(childComplexity int, flagID string, id string) int
+		ArchiveFlag          func(childComplexity int, id string) int
+		CloneFlag            func(childComplexity int, id string, newKey string, newName string) int
+		CreateExclusionGroup func(childComplexity int, input flaggio.NewExclusionGroup) int
+		CreateFlag           func(childComplexity int, input flaggio.NewFlag) int
+		CreateFlagRule       func(childComplexity int, flagID string, input flaggio.NewFlagRule) int
+		CreateMetric         func(childComplexity int, flagID string, input flaggio.NewMetric) int
+		CreateSegment        func(childComplexity int, input flaggio.NewSegment) int
+		CreateSegmentRule    func(childComplexity int, segmentID string, input flaggio.NewSegmentRule) int
+		CreateVariant        func(childComplexity int, flagID string, input flaggio.NewVariant) int
+		CreateWebhook        func(childComplexity int, input flaggio.NewWebhook) int
+		DeleteEvaluation     func(childComplexity int, id string) int
+		DeleteExclusionGroup func(childComplexity int, id string) int
+		DeleteFlag           func(childComplexity int, id string) int
+		DeleteFlagRule       func(childComplexity int, flagID string, id string) int
+		DeleteMetric         func(childComplexity int, flagID string, id string) int
+		DeleteSegment        func(childComplexity int, id string, cascade *bool) int
+		DeleteSegmentRule    func(childComplexity int, segmentID string, id string) int
+		DeleteUser           func(childComplexity int, id string) int
+		DeleteVariant        func(childComplexity int, flagID string, id string) int
+		DeleteWebhook        func(childComplexity int, id string) int
+		MoveFlagRule         func(childComplexity int, flagID string, ruleID string, position int) int
+		MoveSegmentRule      func(childComplexity int, segmentID string, ruleID string, position int) int
+		Ping                 func(childComplexity int) int
+		RejectChangeRequest  func(childComplexity int, flagID string, id string) int
+		RestoreFlag          func(childComplexity int, id string) int
+		UpdateExclusionGroup func(childComplexity int, id string, input flaggio.UpdateExclusionGroup) int
+		UpdateFlag           func(childComplexity int, id string, input flaggio.UpdateFlag) int
+		UpdateFlagRule       func(childComplexity int, flagID string, id string, input flaggio.UpdateFlagRule) int
+		UpdateMetric         func(childComplexity int, flagID string, id string, input flaggio.UpdateMetric) int
+		UpdateSegment        func(childComplexity int, id string, input flaggio.UpdateSegment) int
+		UpdateSegmentRule    func(childComplexity int, segmentID string, id string, input flaggio.UpdateSegmentRule) int
+		UpdateVariant        func(childComplexity int, flagID string, id string, input flaggio.UpdateVariant) int
+		UpdateWebhook        func(childComplexity int, id string, input flaggio.UpdateWebhook) int
 	}
 
 	Query struct {
-		Flag     func(childComplexity int, id string) int
-		Flags    func(childComplexity int, search *string, offset *int, limit *int) int
-		Ping     func(childComplexity int) int
-		Segment  func(childComplexity int, id string) int
-		Segments func(childComplexity int, offset *int, limit *int) int
-		User     func(childComplexity int, id string) int
-		Users    func(childComplexity int, search *string, offset *int, limit *int) int
+		Evaluate        func(childComplexity int, flagKey string, userID *string, context map[string]interface{}, debug *bool) int
+		ExclusionGroup  func(childComplexity int, id string) int
+		ExclusionGroups func(childComplexity int) int
+		Flag            func(childComplexity int, id string) int
+		FlagLint        func(childComplexity int, id string) int
+		Flags           func(childComplexity int, search *string, filter *flaggio.FlagFilter, offset *int, limit *int) int
+		Ping            func(childComplexity int) int
+		Segment         func(childComplexity int, id string) int
+		Segments        func(childComplexity int, offset *int, limit *int) int
+		SimulateFlag    func(childComplexity int, id string, proposed flaggio.FlagProposal, sampleSize *int) int
+		StaleFlags      func(childComplexity int, days *int) int
+		User            func(childComplexity int, id string) int
+		Users           func(childComplexity int, search *string, offset *int, limit *int) int
+		Webhook         func(childComplexity int, id string) int
+		Webhooks        func(childComplexity int) int
 	}
 
 	Segment struct {
@@ -136,11 +264,40 @@ type ComplexityRoot struct {
 		Name        func(childComplexity int) int
 		Rules       func(childComplexity int) int
 		UpdatedAt   func(childComplexity int) int
+		UsedBy      func(childComplexity int) int
 	}
 
 	SegmentRule struct {
 		Constraints func(childComplexity int) int
+		Description func(childComplexity int) int
+		Enabled     func(childComplexity int) int
 		ID          func(childComplexity int) int
+		Name        func(childComplexity int) int
+	}
+
+	SegmentUsage struct {
+		Flag  func(childComplexity int) int
+		Rules func(childComplexity int) int
+	}
+
+	SlotAllocation struct {
+		End    func(childComplexity int) int
+		FlagID func(childComplexity int) int
+		Start  func(childComplexity int) int
+	}
+
+	StackTrace struct {
+		Answer func(childComplexity int) int
+		ID     func(childComplexity int) int
+		Name   func(childComplexity int) int
+		Type   func(childComplexity int) int
+	}
+
+	StaleFlag struct {
+		Evaluations     func(childComplexity int) int
+		Flag            func(childComplexity int) int
+		LastEvaluatedAt func(childComplexity int) int
+		Reasons         func(childComplexity int) int
 	}
 
 	User struct {
@@ -160,40 +317,118 @@ type ComplexityRoot struct {
 		ID          func(childComplexity int) int
 		Value       func(childComplexity int) int
 	}
+
+	VariantResults struct {
+		ConfidenceInterval func(childComplexity int) int
+		ConversionRate     func(childComplexity int) int
+		Conversions        func(childComplexity int) int
+		Exposures          func(childComplexity int) int
+		Variant            func(childComplexity int) int
+	}
+
+	VariantUsage struct {
+		LastServedAt func(childComplexity int) int
+		VariantID    func(childComplexity int) int
+	}
+
+	Webhook struct {
+		CreatedAt   func(childComplexity int) int
+		Deliveries  func(childComplexity int, offset *int, limit *int) int
+		Enabled     func(childComplexity int) int
+		EntityTypes func(childComplexity int) int
+		FlagKeys    func(childComplexity int) int
+		ID          func(childComplexity int) int
+		URL         func(childComplexity int) int
+		UpdatedAt   func(childComplexity int) int
+	}
+
+	WebhookDelivery struct {
+		Action     func(childComplexity int) int
+		Attempts   func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		Delivered  func(childComplexity int) int
+		EntityID   func(childComplexity int) int
+		EntityType func(childComplexity int) int
+		Error      func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Payload    func(childComplexity int) int
+		StatusCode func(childComplexity int) int
+		UpdatedAt  func(childComplexity int) int
+		WebhookID  func(childComplexity int) int
+	}
+
+	WebhookDeliveryResults struct {
+		Deliveries func(childComplexity int) int
+		Total      func(childComplexity int) int
+	}
 }
 
+type FlagResolver interface {
+	ExperimentResults(ctx context.Context, obj *flaggio.Flag) (*flaggio.ExperimentResults, error)
+	Usage(ctx context.Context, obj *flaggio.Flag) (*flaggio.FlagUsage, error)
+}
 type MutationResolver interface {
 	Ping(ctx context.Context) (bool, error)
 	CreateFlag(ctx context.Context, input flaggio.NewFlag) (*flaggio.Flag, error)
 	UpdateFlag(ctx context.Context, id string, input flaggio.UpdateFlag) (*flaggio.Flag, error)
+	CloneFlag(ctx context.Context, id string, newKey string, newName string) (*flaggio.Flag, error)
+	ArchiveFlag(ctx context.Context, id string) (*flaggio.Flag, error)
+	RestoreFlag(ctx context.Context, id string) (*flaggio.Flag, error)
 	DeleteFlag(ctx context.Context, id string) (string, error)
 	CreateVariant(ctx context.Context, flagID string, input flaggio.NewVariant) (*flaggio.Variant, error)
 	UpdateVariant(ctx context.Context, flagID string, id string, input flaggio.UpdateVariant) (*flaggio.Variant, error)
 	DeleteVariant(ctx context.Context, flagID string, id string) (string, error)
+	CreateMetric(ctx context.Context, flagID string, input flaggio.NewMetric) (*flaggio.Metric, error)
+	UpdateMetric(ctx context.Context, flagID string, id string, input flaggio.UpdateMetric) (*flaggio.Metric, error)
+	DeleteMetric(ctx context.Context, flagID string, id string) (string, error)
+	ApproveChangeRequest(ctx context.Context, flagID string, id string) (*flaggio.ChangeRequest, error)
+	RejectChangeRequest(ctx context.Context, flagID string, id string) (*flaggio.ChangeRequest, error)
 	CreateFlagRule(ctx context.Context, flagID string, input flaggio.NewFlagRule) (*flaggio.FlagRule, error)
 	UpdateFlagRule(ctx context.Context, flagID string, id string, input flaggio.UpdateFlagRule) (*flaggio.FlagRule, error)
 	DeleteFlagRule(ctx context.Context, flagID string, id string) (string, error)
+	MoveFlagRule(ctx context.Context, flagID string, ruleID string, position int) (*flaggio.Flag, error)
 	CreateSegmentRule(ctx context.Context, segmentID string, input flaggio.NewSegmentRule) (*flaggio.SegmentRule, error)
 	UpdateSegmentRule(ctx context.Context, segmentID string, id string, input flaggio.UpdateSegmentRule) (*flaggio.SegmentRule, error)
 	DeleteSegmentRule(ctx context.Context, segmentID string, id string) (string, error)
+	MoveSegmentRule(ctx context.Context, segmentID string, ruleID string, position int) (*flaggio.Segment, error)
 	CreateSegment(ctx context.Context, input flaggio.NewSegment) (*flaggio.Segment, error)
 	UpdateSegment(ctx context.Context, id string, input flaggio.UpdateSegment) (*flaggio.Segment, error)
-	DeleteSegment(ctx context.Context, id string) (string, error)
+	DeleteSegment(ctx context.Context, id string, cascade *bool) (string, error)
+	CreateExclusionGroup(ctx context.Context, input flaggio.NewExclusionGroup) (*flaggio.ExclusionGroup, error)
+	UpdateExclusionGroup(ctx context.Context, id string, input flaggio.UpdateExclusionGroup) (*flaggio.ExclusionGroup, error)
+	DeleteExclusionGroup(ctx context.Context, id string) (string, error)
 	DeleteUser(ctx context.Context, id string) (string, error)
 	DeleteEvaluation(ctx context.Context, id string) (string, error)
+	CreateWebhook(ctx context.Context, input flaggio.NewWebhook) (*flaggio.Webhook, error)
+	UpdateWebhook(ctx context.Context, id string, input flaggio.UpdateWebhook) (*flaggio.Webhook, error)
+	DeleteWebhook(ctx context.Context, id string) (string, error)
 }
 type QueryResolver interface {
 	Ping(ctx context.Context) (bool, error)
-	Flags(ctx context.Context, search *string, offset *int, limit *int) (*flaggio.FlagResults, error)
+	Flags(ctx context.Context, search *string, filter *flaggio.FlagFilter, offset *int, limit *int) (*flaggio.FlagResults, error)
 	Flag(ctx context.Context, id string) (*flaggio.Flag, error)
+	StaleFlags(ctx context.Context, days *int) ([]*flaggio.StaleFlag, error)
+	FlagLint(ctx context.Context, id string) ([]*flaggio.LintIssue, error)
+	SimulateFlag(ctx context.Context, id string, proposed flaggio.FlagProposal, sampleSize *int) (*flaggio.FlagSimulation, error)
+	Evaluate(ctx context.Context, flagKey string, userID *string, context map[string]interface{}, debug *bool) (*flaggio.Evaluation, error)
 	Segments(ctx context.Context, offset *int, limit *int) ([]*flaggio.Segment, error)
 	Segment(ctx context.Context, id string) (*flaggio.Segment, error)
+	ExclusionGroups(ctx context.Context) ([]*flaggio.ExclusionGroup, error)
+	ExclusionGroup(ctx context.Context, id string) (*flaggio.ExclusionGroup, error)
 	Users(ctx context.Context, search *string, offset *int, limit *int) (*flaggio.UserResults, error)
 	User(ctx context.Context, id string) (*flaggio.User, error)
+	Webhooks(ctx context.Context) ([]*flaggio.Webhook, error)
+	Webhook(ctx context.Context, id string) (*flaggio.Webhook, error)
+}
+type SegmentResolver interface {
+	UsedBy(ctx context.Context, obj *flaggio.Segment) ([]*flaggio.SegmentUsage, error)
 }
 type UserResolver interface {
 	Evaluations(ctx context.Context, obj *flaggio.User, search *string, offset *int, limit *int) (*flaggio.EvaluationResults, error)
 }
+type WebhookResolver interface {
+	Deliveries(ctx context.Context, obj *flaggio.Webhook, offset *int, limit *int) (*flaggio.WebhookDeliveryResults, error)
+}
 
 type executableSchema struct {
 	resolvers  ResolverRoot
@@ -210,6 +445,125 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "Approval.createdAt":
+		if e.complexity.Approval.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Approval.CreatedAt(childComplexity), true
+
+	case "Approval.user":
+		if e.complexity.Approval.User == nil {
+			break
+		}
+
+		return e.complexity.Approval.User(childComplexity), true
+
+	case "ChangeRequest.approvals":
+		if e.complexity.ChangeRequest.Approvals == nil {
+			break
+		}
+
+		return e.complexity.ChangeRequest.Approvals(childComplexity), true
+
+	case "ChangeRequest.author":
+		if e.complexity.ChangeRequest.Author == nil {
+			break
+		}
+
+		return e.complexity.ChangeRequest.Author(childComplexity), true
+
+	case "ChangeRequest.createdAt":
+		if e.complexity.ChangeRequest.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ChangeRequest.CreatedAt(childComplexity), true
+
+	case "ChangeRequest.diff":
+		if e.complexity.ChangeRequest.Diff == nil {
+			break
+		}
+
+		return e.complexity.ChangeRequest.Diff(childComplexity), true
+
+	case "ChangeRequest.entityId":
+		if e.complexity.ChangeRequest.EntityID == nil {
+			break
+		}
+
+		return e.complexity.ChangeRequest.EntityID(childComplexity), true
+
+	case "ChangeRequest.entityType":
+		if e.complexity.ChangeRequest.EntityType == nil {
+			break
+		}
+
+		return e.complexity.ChangeRequest.EntityType(childComplexity), true
+
+	case "ChangeRequest.flagVersion":
+		if e.complexity.ChangeRequest.FlagVersion == nil {
+			break
+		}
+
+		return e.complexity.ChangeRequest.FlagVersion(childComplexity), true
+
+	case "ChangeRequest.id":
+		if e.complexity.ChangeRequest.ID == nil {
+			break
+		}
+
+		return e.complexity.ChangeRequest.ID(childComplexity), true
+
+	case "ChangeRequest.rejectedBy":
+		if e.complexity.ChangeRequest.RejectedBy == nil {
+			break
+		}
+
+		return e.complexity.ChangeRequest.RejectedBy(childComplexity), true
+
+	case "ChangeRequest.requiredApprovals":
+		if e.complexity.ChangeRequest.RequiredApprovals == nil {
+			break
+		}
+
+		return e.complexity.ChangeRequest.RequiredApprovals(childComplexity), true
+
+	case "ChangeRequest.status":
+		if e.complexity.ChangeRequest.Status == nil {
+			break
+		}
+
+		return e.complexity.ChangeRequest.Status(childComplexity), true
+
+	case "ChangeRequest.updatedAt":
+		if e.complexity.ChangeRequest.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.ChangeRequest.UpdatedAt(childComplexity), true
+
+	case "ConfidenceInterval.lower":
+		if e.complexity.ConfidenceInterval.Lower == nil {
+			break
+		}
+
+		return e.complexity.ConfidenceInterval.Lower(childComplexity), true
+
+	case "ConfidenceInterval.upper":
+		if e.complexity.ConfidenceInterval.Upper == nil {
+			break
+		}
+
+		return e.complexity.ConfidenceInterval.Upper(childComplexity), true
+
+	case "Constraint.constraints":
+		if e.complexity.Constraint.Constraints == nil {
+			break
+		}
+
+		return e.complexity.Constraint.Constraints(childComplexity), true
+
 	case "Constraint.id":
 		if e.complexity.Constraint.ID == nil {
 			break
@@ -294,6 +648,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Evaluation.ID(childComplexity), true
 
+	case "Evaluation.reason":
+		if e.complexity.Evaluation.Reason == nil {
+			break
+		}
+
+		return e.complexity.Evaluation.Reason(childComplexity), true
+
+	case "Evaluation.ruleName":
+		if e.complexity.Evaluation.RuleName == nil {
+			break
+		}
+
+		return e.complexity.Evaluation.RuleName(childComplexity), true
+
+	case "Evaluation.stackTrace":
+		if e.complexity.Evaluation.StackTrace == nil {
+			break
+		}
+
+		return e.complexity.Evaluation.StackTrace(childComplexity), true
+
 	case "Evaluation.value":
 		if e.complexity.Evaluation.Value == nil {
 			break
@@ -301,6 +676,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Evaluation.Value(childComplexity), true
 
+	case "Evaluation.variantId":
+		if e.complexity.Evaluation.VariantID == nil {
+			break
+		}
+
+		return e.complexity.Evaluation.VariantID(childComplexity), true
+
 	case "EvaluationResults.evaluations":
 		if e.complexity.EvaluationResults.Evaluations == nil {
 			break
@@ -315,6 +697,104 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.EvaluationResults.Total(childComplexity), true
 
+	case "ExclusionGroup.allocations":
+		if e.complexity.ExclusionGroup.Allocations == nil {
+			break
+		}
+
+		return e.complexity.ExclusionGroup.Allocations(childComplexity), true
+
+	case "ExclusionGroup.createdAt":
+		if e.complexity.ExclusionGroup.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ExclusionGroup.CreatedAt(childComplexity), true
+
+	case "ExclusionGroup.description":
+		if e.complexity.ExclusionGroup.Description == nil {
+			break
+		}
+
+		return e.complexity.ExclusionGroup.Description(childComplexity), true
+
+	case "ExclusionGroup.id":
+		if e.complexity.ExclusionGroup.ID == nil {
+			break
+		}
+
+		return e.complexity.ExclusionGroup.ID(childComplexity), true
+
+	case "ExclusionGroup.name":
+		if e.complexity.ExclusionGroup.Name == nil {
+			break
+		}
+
+		return e.complexity.ExclusionGroup.Name(childComplexity), true
+
+	case "ExclusionGroup.updatedAt":
+		if e.complexity.ExclusionGroup.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.ExclusionGroup.UpdatedAt(childComplexity), true
+
+	case "ExperimentResults.metrics":
+		if e.complexity.ExperimentResults.Metrics == nil {
+			break
+		}
+
+		return e.complexity.ExperimentResults.Metrics(childComplexity), true
+
+	case "FieldChange.field":
+		if e.complexity.FieldChange.Field == nil {
+			break
+		}
+
+		return e.complexity.FieldChange.Field(childComplexity), true
+
+	case "FieldChange.newValue":
+		if e.complexity.FieldChange.NewValue == nil {
+			break
+		}
+
+		return e.complexity.FieldChange.NewValue(childComplexity), true
+
+	case "FieldChange.oldValue":
+		if e.complexity.FieldChange.OldValue == nil {
+			break
+		}
+
+		return e.complexity.FieldChange.OldValue(childComplexity), true
+
+	case "Flag.archived":
+		if e.complexity.Flag.Archived == nil {
+			break
+		}
+
+		return e.complexity.Flag.Archived(childComplexity), true
+
+	case "Flag.archivedAt":
+		if e.complexity.Flag.ArchivedAt == nil {
+			break
+		}
+
+		return e.complexity.Flag.ArchivedAt(childComplexity), true
+
+	case "Flag.changeRequests":
+		if e.complexity.Flag.ChangeRequests == nil {
+			break
+		}
+
+		return e.complexity.Flag.ChangeRequests(childComplexity), true
+
+	case "Flag.clientExposed":
+		if e.complexity.Flag.ClientExposed == nil {
+			break
+		}
+
+		return e.complexity.Flag.ClientExposed(childComplexity), true
+
 	case "Flag.createdAt":
 		if e.complexity.Flag.CreatedAt == nil {
 			break
@@ -350,6 +830,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Flag.Enabled(childComplexity), true
 
+	case "Flag.experiment":
+		if e.complexity.Flag.Experiment == nil {
+			break
+		}
+
+		return e.complexity.Flag.Experiment(childComplexity), true
+
+	case "Flag.experimentResults":
+		if e.complexity.Flag.ExperimentResults == nil {
+			break
+		}
+
+		return e.complexity.Flag.ExperimentResults(childComplexity), true
+
+	case "Flag.expiresAt":
+		if e.complexity.Flag.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.Flag.ExpiresAt(childComplexity), true
+
 	case "Flag.id":
 		if e.complexity.Flag.ID == nil {
 			break
@@ -364,6 +865,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Flag.Key(childComplexity), true
 
+	case "Flag.kind":
+		if e.complexity.Flag.Kind == nil {
+			break
+		}
+
+		return e.complexity.Flag.Kind(childComplexity), true
+
+	case "Flag.links":
+		if e.complexity.Flag.Links == nil {
+			break
+		}
+
+		return e.complexity.Flag.Links(childComplexity), true
+
+	case "Flag.maintainer":
+		if e.complexity.Flag.Maintainer == nil {
+			break
+		}
+
+		return e.complexity.Flag.Maintainer(childComplexity), true
+
+	case "Flag.metrics":
+		if e.complexity.Flag.Metrics == nil {
+			break
+		}
+
+		return e.complexity.Flag.Metrics(childComplexity), true
+
 	case "Flag.name":
 		if e.complexity.Flag.Name == nil {
 			break
@@ -371,6 +900,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Flag.Name(childComplexity), true
 
+	case "Flag.ownerTeam":
+		if e.complexity.Flag.OwnerTeam == nil {
+			break
+		}
+
+		return e.complexity.Flag.OwnerTeam(childComplexity), true
+
+	case "Flag.protected":
+		if e.complexity.Flag.Protected == nil {
+			break
+		}
+
+		return e.complexity.Flag.Protected(childComplexity), true
+
+	case "Flag.requiredApprovals":
+		if e.complexity.Flag.RequiredApprovals == nil {
+			break
+		}
+
+		return e.complexity.Flag.RequiredApprovals(childComplexity), true
+
 	case "Flag.rules":
 		if e.complexity.Flag.Rules == nil {
 			break
@@ -378,6 +928,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Flag.Rules(childComplexity), true
 
+	case "Flag.tags":
+		if e.complexity.Flag.Tags == nil {
+			break
+		}
+
+		return e.complexity.Flag.Tags(childComplexity), true
+
 	case "Flag.updatedAt":
 		if e.complexity.Flag.UpdatedAt == nil {
 			break
@@ -385,6 +942,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Flag.UpdatedAt(childComplexity), true
 
+	case "Flag.usage":
+		if e.complexity.Flag.Usage == nil {
+			break
+		}
+
+		return e.complexity.Flag.Usage(childComplexity), true
+
 	case "Flag.variants":
 		if e.complexity.Flag.Variants == nil {
 			break
@@ -413,6 +977,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.FlagRule.Constraints(childComplexity), true
 
+	case "FlagRule.description":
+		if e.complexity.FlagRule.Description == nil {
+			break
+		}
+
+		return e.complexity.FlagRule.Description(childComplexity), true
+
 	case "FlagRule.distributions":
 		if e.complexity.FlagRule.Distributions == nil {
 			break
@@ -420,6 +991,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.FlagRule.Distributions(childComplexity), true
 
+	case "FlagRule.enabled":
+		if e.complexity.FlagRule.Enabled == nil {
+			break
+		}
+
+		return e.complexity.FlagRule.Enabled(childComplexity), true
+
 	case "FlagRule.id":
 		if e.complexity.FlagRule.ID == nil {
 			break
@@ -427,7 +1005,188 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.FlagRule.ID(childComplexity), true
 
-	case "Mutation.createFlag":
+	case "FlagRule.name":
+		if e.complexity.FlagRule.Name == nil {
+			break
+		}
+
+		return e.complexity.FlagRule.Name(childComplexity), true
+
+	case "FlagSimulation.affectedUsers":
+		if e.complexity.FlagSimulation.AffectedUsers == nil {
+			break
+		}
+
+		return e.complexity.FlagSimulation.AffectedUsers(childComplexity), true
+
+	case "FlagSimulation.sampleUserIds":
+		if e.complexity.FlagSimulation.SampleUserIDs == nil {
+			break
+		}
+
+		return e.complexity.FlagSimulation.SampleUserIDs(childComplexity), true
+
+	case "FlagSimulation.totalUsers":
+		if e.complexity.FlagSimulation.TotalUsers == nil {
+			break
+		}
+
+		return e.complexity.FlagSimulation.TotalUsers(childComplexity), true
+
+	case "FlagSimulation.users":
+		if e.complexity.FlagSimulation.Users == nil {
+			break
+		}
+
+		return e.complexity.FlagSimulation.Users(childComplexity), true
+
+	case "FlagUsage.evaluations":
+		if e.complexity.FlagUsage.Evaluations == nil {
+			break
+		}
+
+		return e.complexity.FlagUsage.Evaluations(childComplexity), true
+
+	case "FlagUsage.firstEvaluatedAt":
+		if e.complexity.FlagUsage.FirstEvaluatedAt == nil {
+			break
+		}
+
+		return e.complexity.FlagUsage.FirstEvaluatedAt(childComplexity), true
+
+	case "FlagUsage.lastEvaluatedAt":
+		if e.complexity.FlagUsage.LastEvaluatedAt == nil {
+			break
+		}
+
+		return e.complexity.FlagUsage.LastEvaluatedAt(childComplexity), true
+
+	case "FlagUsage.variants":
+		if e.complexity.FlagUsage.Variants == nil {
+			break
+		}
+
+		return e.complexity.FlagUsage.Variants(childComplexity), true
+
+	case "LintIssue.code":
+		if e.complexity.LintIssue.Code == nil {
+			break
+		}
+
+		return e.complexity.LintIssue.Code(childComplexity), true
+
+	case "LintIssue.message":
+		if e.complexity.LintIssue.Message == nil {
+			break
+		}
+
+		return e.complexity.LintIssue.Message(childComplexity), true
+
+	case "LintIssue.ruleId":
+		if e.complexity.LintIssue.RuleID == nil {
+			break
+		}
+
+		return e.complexity.LintIssue.RuleID(childComplexity), true
+
+	case "LintIssue.severity":
+		if e.complexity.LintIssue.Severity == nil {
+			break
+		}
+
+		return e.complexity.LintIssue.Severity(childComplexity), true
+
+	case "Metric.description":
+		if e.complexity.Metric.Description == nil {
+			break
+		}
+
+		return e.complexity.Metric.Description(childComplexity), true
+
+	case "Metric.id":
+		if e.complexity.Metric.ID == nil {
+			break
+		}
+
+		return e.complexity.Metric.ID(childComplexity), true
+
+	case "Metric.key":
+		if e.complexity.Metric.Key == nil {
+			break
+		}
+
+		return e.complexity.Metric.Key(childComplexity), true
+
+	case "Metric.name":
+		if e.complexity.Metric.Name == nil {
+			break
+		}
+
+		return e.complexity.Metric.Name(childComplexity), true
+
+	case "MetricResults.metric":
+		if e.complexity.MetricResults.Metric == nil {
+			break
+		}
+
+		return e.complexity.MetricResults.Metric(childComplexity), true
+
+	case "MetricResults.variants":
+		if e.complexity.MetricResults.Variants == nil {
+			break
+		}
+
+		return e.complexity.MetricResults.Variants(childComplexity), true
+
+	case "Mutation.approveChangeRequest":
+		if e.complexity.Mutation.ApproveChangeRequest == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_approveChangeRequest_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ApproveChangeRequest(childComplexity, args["flagId"].(string), args["id"].(string)), true
+
+	case "Mutation.archiveFlag":
+		if e.complexity.Mutation.ArchiveFlag == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_archiveFlag_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ArchiveFlag(childComplexity, args["id"].(string)), true
+
+	case "Mutation.cloneFlag":
+		if e.complexity.Mutation.CloneFlag == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_cloneFlag_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CloneFlag(childComplexity, args["id"].(string), args["newKey"].(string), args["newName"].(string)), true
+
+	case "Mutation.createExclusionGroup":
+		if e.complexity.Mutation.CreateExclusionGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createExclusionGroup_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateExclusionGroup(childComplexity, args["input"].(flaggio.NewExclusionGroup)), true
+
+	case "Mutation.createFlag":
 		if e.complexity.Mutation.CreateFlag == nil {
 			break
 		}
@@ -451,6 +1210,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.CreateFlagRule(childComplexity, args["flagId"].(string), args["input"].(flaggio.NewFlagRule)), true
 
+	case "Mutation.createMetric":
+		if e.complexity.Mutation.CreateMetric == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createMetric_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateMetric(childComplexity, args["flagId"].(string), args["input"].(flaggio.NewMetric)), true
+
 	case "Mutation.createSegment":
 		if e.complexity.Mutation.CreateSegment == nil {
 			break
@@ -487,6 +1258,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.CreateVariant(childComplexity, args["flagId"].(string), args["input"].(flaggio.NewVariant)), true
 
+	case "Mutation.createWebhook":
+		if e.complexity.Mutation.CreateWebhook == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createWebhook_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateWebhook(childComplexity, args["input"].(flaggio.NewWebhook)), true
+
 	case "Mutation.deleteEvaluation":
 		if e.complexity.Mutation.DeleteEvaluation == nil {
 			break
@@ -499,6 +1282,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DeleteEvaluation(childComplexity, args["id"].(string)), true
 
+	case "Mutation.deleteExclusionGroup":
+		if e.complexity.Mutation.DeleteExclusionGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteExclusionGroup_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteExclusionGroup(childComplexity, args["id"].(string)), true
+
 	case "Mutation.deleteFlag":
 		if e.complexity.Mutation.DeleteFlag == nil {
 			break
@@ -523,6 +1318,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DeleteFlagRule(childComplexity, args["flagId"].(string), args["id"].(string)), true
 
+	case "Mutation.deleteMetric":
+		if e.complexity.Mutation.DeleteMetric == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteMetric_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteMetric(childComplexity, args["flagId"].(string), args["id"].(string)), true
+
 	case "Mutation.deleteSegment":
 		if e.complexity.Mutation.DeleteSegment == nil {
 			break
@@ -533,7 +1340,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteSegment(childComplexity, args["id"].(string)), true
+		return e.complexity.Mutation.DeleteSegment(childComplexity, args["id"].(string), args["cascade"].(*bool)), true
 
 	case "Mutation.deleteSegmentRule":
 		if e.complexity.Mutation.DeleteSegmentRule == nil {
@@ -571,6 +1378,42 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DeleteVariant(childComplexity, args["flagId"].(string), args["id"].(string)), true
 
+	case "Mutation.deleteWebhook":
+		if e.complexity.Mutation.DeleteWebhook == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteWebhook_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteWebhook(childComplexity, args["id"].(string)), true
+
+	case "Mutation.moveFlagRule":
+		if e.complexity.Mutation.MoveFlagRule == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_moveFlagRule_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.MoveFlagRule(childComplexity, args["flagId"].(string), args["ruleId"].(string), args["position"].(int)), true
+
+	case "Mutation.moveSegmentRule":
+		if e.complexity.Mutation.MoveSegmentRule == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_moveSegmentRule_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.MoveSegmentRule(childComplexity, args["segmentId"].(string), args["ruleId"].(string), args["position"].(int)), true
+
 	case "Mutation.ping":
 		if e.complexity.Mutation.Ping == nil {
 			break
@@ -578,6 +1421,42 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.Ping(childComplexity), true
 
+	case "Mutation.rejectChangeRequest":
+		if e.complexity.Mutation.RejectChangeRequest == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_rejectChangeRequest_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RejectChangeRequest(childComplexity, args["flagId"].(string), args["id"].(string)), true
+
+	case "Mutation.restoreFlag":
+		if e.complexity.Mutation.RestoreFlag == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_restoreFlag_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RestoreFlag(childComplexity, args["id"].(string)), true
+
+	case "Mutation.updateExclusionGroup":
+		if e.complexity.Mutation.UpdateExclusionGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateExclusionGroup_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateExclusionGroup(childComplexity, args["id"].(string), args["input"].(flaggio.UpdateExclusionGroup)), true
+
 	case "Mutation.updateFlag":
 		if e.complexity.Mutation.UpdateFlag == nil {
 			break
@@ -602,6 +1481,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpdateFlagRule(childComplexity, args["flagId"].(string), args["id"].(string), args["input"].(flaggio.UpdateFlagRule)), true
 
+	case "Mutation.updateMetric":
+		if e.complexity.Mutation.UpdateMetric == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateMetric_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateMetric(childComplexity, args["flagId"].(string), args["id"].(string), args["input"].(flaggio.UpdateMetric)), true
+
 	case "Mutation.updateSegment":
 		if e.complexity.Mutation.UpdateSegment == nil {
 			break
@@ -638,6 +1529,49 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpdateVariant(childComplexity, args["flagId"].(string), args["id"].(string), args["input"].(flaggio.UpdateVariant)), true
 
+	case "Mutation.updateWebhook":
+		if e.complexity.Mutation.UpdateWebhook == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateWebhook_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateWebhook(childComplexity, args["id"].(string), args["input"].(flaggio.UpdateWebhook)), true
+
+	case "Query.evaluate":
+		if e.complexity.Query.Evaluate == nil {
+			break
+		}
+
+		args, err := ec.field_Query_evaluate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Evaluate(childComplexity, args["flagKey"].(string), args["userId"].(*string), args["context"].(map[string]interface{}), args["debug"].(*bool)), true
+
+	case "Query.exclusionGroup":
+		if e.complexity.Query.ExclusionGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Query_exclusionGroup_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ExclusionGroup(childComplexity, args["id"].(string)), true
+
+	case "Query.exclusionGroups":
+		if e.complexity.Query.ExclusionGroups == nil {
+			break
+		}
+
+		return e.complexity.Query.ExclusionGroups(childComplexity), true
+
 	case "Query.flag":
 		if e.complexity.Query.Flag == nil {
 			break
@@ -650,6 +1584,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Flag(childComplexity, args["id"].(string)), true
 
+	case "Query.flagLint":
+		if e.complexity.Query.FlagLint == nil {
+			break
+		}
+
+		args, err := ec.field_Query_flagLint_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.FlagLint(childComplexity, args["id"].(string)), true
+
 	case "Query.flags":
 		if e.complexity.Query.Flags == nil {
 			break
@@ -660,7 +1606,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.Flags(childComplexity, args["search"].(*string), args["offset"].(*int), args["limit"].(*int)), true
+		return e.complexity.Query.Flags(childComplexity, args["search"].(*string), args["filter"].(*flaggio.FlagFilter), args["offset"].(*int), args["limit"].(*int)), true
 
 	case "Query.ping":
 		if e.complexity.Query.Ping == nil {
@@ -693,6 +1639,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Segments(childComplexity, args["offset"].(*int), args["limit"].(*int)), true
 
+	case "Query.simulateFlag":
+		if e.complexity.Query.SimulateFlag == nil {
+			break
+		}
+
+		args, err := ec.field_Query_simulateFlag_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SimulateFlag(childComplexity, args["id"].(string), args["proposed"].(flaggio.FlagProposal), args["sampleSize"].(*int)), true
+
+	case "Query.staleFlags":
+		if e.complexity.Query.StaleFlags == nil {
+			break
+		}
+
+		args, err := ec.field_Query_staleFlags_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.StaleFlags(childComplexity, args["days"].(*int)), true
+
 	case "Query.user":
 		if e.complexity.Query.User == nil {
 			break
@@ -717,14 +1687,33 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Users(childComplexity, args["search"].(*string), args["offset"].(*int), args["limit"].(*int)), true
 
-	case "Segment.createdAt":
-		if e.complexity.Segment.CreatedAt == nil {
+	case "Query.webhook":
+		if e.complexity.Query.Webhook == nil {
 			break
 		}
 
-		return e.complexity.Segment.CreatedAt(childComplexity), true
+		args, err := ec.field_Query_webhook_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Segment.description":
+		return e.complexity.Query.Webhook(childComplexity, args["id"].(string)), true
+
+	case "Query.webhooks":
+		if e.complexity.Query.Webhooks == nil {
+			break
+		}
+
+		return e.complexity.Query.Webhooks(childComplexity), true
+
+	case "Segment.createdAt":
+		if e.complexity.Segment.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Segment.CreatedAt(childComplexity), true
+
+	case "Segment.description":
 		if e.complexity.Segment.Description == nil {
 			break
 		}
@@ -759,6 +1748,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Segment.UpdatedAt(childComplexity), true
 
+	case "Segment.usedBy":
+		if e.complexity.Segment.UsedBy == nil {
+			break
+		}
+
+		return e.complexity.Segment.UsedBy(childComplexity), true
+
 	case "SegmentRule.constraints":
 		if e.complexity.SegmentRule.Constraints == nil {
 			break
@@ -766,6 +1762,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SegmentRule.Constraints(childComplexity), true
 
+	case "SegmentRule.description":
+		if e.complexity.SegmentRule.Description == nil {
+			break
+		}
+
+		return e.complexity.SegmentRule.Description(childComplexity), true
+
+	case "SegmentRule.enabled":
+		if e.complexity.SegmentRule.Enabled == nil {
+			break
+		}
+
+		return e.complexity.SegmentRule.Enabled(childComplexity), true
+
 	case "SegmentRule.id":
 		if e.complexity.SegmentRule.ID == nil {
 			break
@@ -773,6 +1783,104 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SegmentRule.ID(childComplexity), true
 
+	case "SegmentRule.name":
+		if e.complexity.SegmentRule.Name == nil {
+			break
+		}
+
+		return e.complexity.SegmentRule.Name(childComplexity), true
+
+	case "SegmentUsage.flag":
+		if e.complexity.SegmentUsage.Flag == nil {
+			break
+		}
+
+		return e.complexity.SegmentUsage.Flag(childComplexity), true
+
+	case "SegmentUsage.rules":
+		if e.complexity.SegmentUsage.Rules == nil {
+			break
+		}
+
+		return e.complexity.SegmentUsage.Rules(childComplexity), true
+
+	case "SlotAllocation.end":
+		if e.complexity.SlotAllocation.End == nil {
+			break
+		}
+
+		return e.complexity.SlotAllocation.End(childComplexity), true
+
+	case "SlotAllocation.flagId":
+		if e.complexity.SlotAllocation.FlagID == nil {
+			break
+		}
+
+		return e.complexity.SlotAllocation.FlagID(childComplexity), true
+
+	case "SlotAllocation.start":
+		if e.complexity.SlotAllocation.Start == nil {
+			break
+		}
+
+		return e.complexity.SlotAllocation.Start(childComplexity), true
+
+	case "StackTrace.answer":
+		if e.complexity.StackTrace.Answer == nil {
+			break
+		}
+
+		return e.complexity.StackTrace.Answer(childComplexity), true
+
+	case "StackTrace.id":
+		if e.complexity.StackTrace.ID == nil {
+			break
+		}
+
+		return e.complexity.StackTrace.ID(childComplexity), true
+
+	case "StackTrace.name":
+		if e.complexity.StackTrace.Name == nil {
+			break
+		}
+
+		return e.complexity.StackTrace.Name(childComplexity), true
+
+	case "StackTrace.type":
+		if e.complexity.StackTrace.Type == nil {
+			break
+		}
+
+		return e.complexity.StackTrace.Type(childComplexity), true
+
+	case "StaleFlag.evaluations":
+		if e.complexity.StaleFlag.Evaluations == nil {
+			break
+		}
+
+		return e.complexity.StaleFlag.Evaluations(childComplexity), true
+
+	case "StaleFlag.flag":
+		if e.complexity.StaleFlag.Flag == nil {
+			break
+		}
+
+		return e.complexity.StaleFlag.Flag(childComplexity), true
+
+	case "StaleFlag.lastEvaluatedAt":
+		if e.complexity.StaleFlag.LastEvaluatedAt == nil {
+			break
+		}
+
+		return e.complexity.StaleFlag.LastEvaluatedAt(childComplexity), true
+
+	case "StaleFlag.reasons":
+		if e.complexity.StaleFlag.Reasons == nil {
+			break
+		}
+
+		return e.complexity.StaleFlag.Reasons(childComplexity), true
+
 	case "User.context":
 		if e.complexity.User.Context == nil {
 			break
@@ -841,6 +1949,214 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Variant.Value(childComplexity), true
 
+	case "VariantResults.confidenceInterval":
+		if e.complexity.VariantResults.ConfidenceInterval == nil {
+			break
+		}
+
+		return e.complexity.VariantResults.ConfidenceInterval(childComplexity), true
+
+	case "VariantResults.conversionRate":
+		if e.complexity.VariantResults.ConversionRate == nil {
+			break
+		}
+
+		return e.complexity.VariantResults.ConversionRate(childComplexity), true
+
+	case "VariantResults.conversions":
+		if e.complexity.VariantResults.Conversions == nil {
+			break
+		}
+
+		return e.complexity.VariantResults.Conversions(childComplexity), true
+
+	case "VariantResults.exposures":
+		if e.complexity.VariantResults.Exposures == nil {
+			break
+		}
+
+		return e.complexity.VariantResults.Exposures(childComplexity), true
+
+	case "VariantResults.variant":
+		if e.complexity.VariantResults.Variant == nil {
+			break
+		}
+
+		return e.complexity.VariantResults.Variant(childComplexity), true
+
+	case "VariantUsage.lastServedAt":
+		if e.complexity.VariantUsage.LastServedAt == nil {
+			break
+		}
+
+		return e.complexity.VariantUsage.LastServedAt(childComplexity), true
+
+	case "VariantUsage.variantId":
+		if e.complexity.VariantUsage.VariantID == nil {
+			break
+		}
+
+		return e.complexity.VariantUsage.VariantID(childComplexity), true
+
+	case "Webhook.createdAt":
+		if e.complexity.Webhook.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Webhook.CreatedAt(childComplexity), true
+
+	case "Webhook.deliveries":
+		if e.complexity.Webhook.Deliveries == nil {
+			break
+		}
+
+		args, err := ec.field_Webhook_deliveries_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Webhook.Deliveries(childComplexity, args["offset"].(*int), args["limit"].(*int)), true
+
+	case "Webhook.enabled":
+		if e.complexity.Webhook.Enabled == nil {
+			break
+		}
+
+		return e.complexity.Webhook.Enabled(childComplexity), true
+
+	case "Webhook.entityTypes":
+		if e.complexity.Webhook.EntityTypes == nil {
+			break
+		}
+
+		return e.complexity.Webhook.EntityTypes(childComplexity), true
+
+	case "Webhook.flagKeys":
+		if e.complexity.Webhook.FlagKeys == nil {
+			break
+		}
+
+		return e.complexity.Webhook.FlagKeys(childComplexity), true
+
+	case "Webhook.id":
+		if e.complexity.Webhook.ID == nil {
+			break
+		}
+
+		return e.complexity.Webhook.ID(childComplexity), true
+
+	case "Webhook.url":
+		if e.complexity.Webhook.URL == nil {
+			break
+		}
+
+		return e.complexity.Webhook.URL(childComplexity), true
+
+	case "Webhook.updatedAt":
+		if e.complexity.Webhook.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Webhook.UpdatedAt(childComplexity), true
+
+	case "WebhookDelivery.action":
+		if e.complexity.WebhookDelivery.Action == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Action(childComplexity), true
+
+	case "WebhookDelivery.attempts":
+		if e.complexity.WebhookDelivery.Attempts == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Attempts(childComplexity), true
+
+	case "WebhookDelivery.createdAt":
+		if e.complexity.WebhookDelivery.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.CreatedAt(childComplexity), true
+
+	case "WebhookDelivery.delivered":
+		if e.complexity.WebhookDelivery.Delivered == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Delivered(childComplexity), true
+
+	case "WebhookDelivery.entityId":
+		if e.complexity.WebhookDelivery.EntityID == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.EntityID(childComplexity), true
+
+	case "WebhookDelivery.entityType":
+		if e.complexity.WebhookDelivery.EntityType == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.EntityType(childComplexity), true
+
+	case "WebhookDelivery.error":
+		if e.complexity.WebhookDelivery.Error == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Error(childComplexity), true
+
+	case "WebhookDelivery.id":
+		if e.complexity.WebhookDelivery.ID == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.ID(childComplexity), true
+
+	case "WebhookDelivery.payload":
+		if e.complexity.WebhookDelivery.Payload == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Payload(childComplexity), true
+
+	case "WebhookDelivery.statusCode":
+		if e.complexity.WebhookDelivery.StatusCode == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.StatusCode(childComplexity), true
+
+	case "WebhookDelivery.updatedAt":
+		if e.complexity.WebhookDelivery.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.UpdatedAt(childComplexity), true
+
+	case "WebhookDelivery.webhookId":
+		if e.complexity.WebhookDelivery.WebhookID == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.WebhookID(childComplexity), true
+
+	case "WebhookDeliveryResults.deliveries":
+		if e.complexity.WebhookDeliveryResults.Deliveries == nil {
+			break
+		}
+
+		return e.complexity.WebhookDeliveryResults.Deliveries(childComplexity), true
+
+	case "WebhookDeliveryResults.total":
+		if e.complexity.WebhookDeliveryResults.Total == nil {
+			break
+		}
+
+		return e.complexity.WebhookDeliveryResults.Total(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -916,11 +2232,27 @@ type Flag {
     key: String!
     name: String!
     description: String
+    kind: FlagKind!
+    tags: [String!]!
+    ownerTeam: String
+    maintainer: String
+    links: [String!]!
+    expiresAt: Time
+    clientExposed: Boolean!
+    archived: Boolean!
+    archivedAt: Time
     enabled: Boolean!
     variants: [Variant!]!
     rules: [FlagRule!]!
     defaultVariantWhenOn: Variant
     defaultVariantWhenOff: Variant
+    experiment: Boolean!
+    metrics: [Metric!]!
+    experimentResults: ExperimentResults @goField(forceResolver: true)
+    usage: FlagUsage @goField(forceResolver: true)
+    protected: Boolean!
+    requiredApprovals: Int!
+    changeRequests: [ChangeRequest!]!
     createdAt: Time!
     updatedAt: Time
 }
@@ -931,11 +2263,100 @@ type Variant {
     value: Any!
 }
 
+type Metric {
+    id: ID!
+    key: String!
+    name: String!
+    description: String
+}
+
+type FlagUsage {
+    evaluations: Int!
+    firstEvaluatedAt: Time!
+    lastEvaluatedAt: Time!
+    variants: [VariantUsage!]!
+}
+
+type VariantUsage {
+    variantId: ID!
+    lastServedAt: Time!
+}
+
+type StaleFlag {
+    flag: Flag!
+    reasons: [StaleReason!]!
+    evaluations: Int!
+    lastEvaluatedAt: Time
+}
+
+type LintIssue {
+    severity: LintSeverity!
+    code: LintCode!
+    message: String!
+    ruleId: ID
+}
+
+type FlagSimulation {
+    users: Int!
+    totalUsers: Int!
+    affectedUsers: Int!
+    sampleUserIds: [ID!]!
+}
+
+type ExperimentResults {
+    metrics: [MetricResults!]!
+}
+
+type MetricResults {
+    metric: Metric!
+    variants: [VariantResults!]!
+}
+
+type VariantResults {
+    variant: Variant!
+    exposures: Int!
+    conversions: Int!
+    conversionRate: Float!
+    confidenceInterval: ConfidenceInterval!
+}
+
+type ConfidenceInterval {
+    lower: Float!
+    upper: Float!
+}
+
+type ChangeRequest {
+    id: ID!
+    entityType: EntityType!
+    entityId: ID!
+    flagVersion: Int!
+    diff: [FieldChange!]!
+    status: ChangeRequestStatus!
+    author: String!
+    requiredApprovals: Int!
+    approvals: [Approval!]!
+    rejectedBy: String
+    createdAt: Time!
+    updatedAt: Time
+}
+
+type FieldChange {
+    field: String!
+    oldValue: Any
+    newValue: Any
+}
+
+type Approval {
+    user: String!
+    createdAt: Time!
+}
+
 type Constraint {
     id: ID!
     property: String!
     operation: Operation!
     values: [Any]!
+    constraints: [Constraint!]
 }
 
 type Distribution {
@@ -946,17 +2367,26 @@ type Distribution {
 
 interface Ruler {
     id: ID!
+    name: String
+    description: String
+    enabled: Boolean!
     constraints: [Constraint!]
 }
 
 type FlagRule implements Ruler {
     id: ID!
+    name: String
+    description: String
+    enabled: Boolean!
     constraints: [Constraint!]
     distributions: [Distribution!]
 }
 
 type SegmentRule implements Ruler {
     id: ID!
+    name: String
+    description: String
+    enabled: Boolean!
     constraints: [Constraint!]
 }
 
@@ -965,26 +2395,84 @@ type Segment {
     name: String!
     description: String
     rules: [SegmentRule!]!
+    usedBy: [SegmentUsage!]! @goField(forceResolver: true)
     createdAt: Time!
     updatedAt: Time
 }
 
-type User {
-    id: ID!
-    context: Map!
-    updatedAt: Time!
-    evaluations(search: String, offset: Int, limit: Int): EvaluationResults! @goField(forceResolver: true)
+type SegmentUsage {
+    flag: Flag!
+    rules: [FlagRule!]!
 }
 
-type Evaluation {
+type ExclusionGroup {
     id: ID!
-    flagId: ID!
+    name: String!
+    description: String
+    allocations: [SlotAllocation!]!
+    createdAt: Time!
+    updatedAt: Time
+}
+
+type SlotAllocation {
+    flagId: ID!
+    start: Int!
+    end: Int!
+}
+
+type User {
+    id: ID!
+    context: Map!
+    updatedAt: Time!
+    evaluations(search: String, offset: Int, limit: Int): EvaluationResults! @goField(forceResolver: true)
+}
+
+type Webhook {
+    id: ID!
+    url: String!
+    entityTypes: [EntityType!]!
+    flagKeys: [String!]!
+    enabled: Boolean!
+    deliveries(offset: Int, limit: Int): WebhookDeliveryResults! @goField(forceResolver: true)
+    createdAt: Time!
+    updatedAt: Time
+}
+
+type WebhookDelivery {
+    id: ID!
+    webhookId: ID!
+    entityType: EntityType!
+    entityId: ID!
+    action: ChangeAction!
+    payload: String!
+    attempts: Int!
+    statusCode: Int
+    error: String
+    delivered: Boolean!
+    createdAt: Time!
+    updatedAt: Time
+}
+
+type Evaluation {
+    id: ID!
+    flagId: ID!
     flagKey: String!
     flagVersion: Int!
     value: Any
+    variantId: ID
+    reason: Reason
+    ruleName: String
+    stackTrace: [StackTrace!]
     createdAt: Time!
 }
 
+type StackTrace {
+    type: String!
+    id: ID
+    name: String
+    answer: Any
+}
+
 enum Operation {
     ONE_OF
     NOT_ONE_OF
@@ -1005,6 +2493,64 @@ enum Operation {
     IS_IN_SEGMENT
     ISNT_IN_SEGMENT
     IS_IN_NETWORK
+    ALL
+    ANY
+    NONE
+}
+
+enum StaleReason {
+    NOT_EVALUATED
+    SINGLE_VARIANT
+    EXPIRED
+}
+
+enum LintSeverity {
+    ERROR
+    WARNING
+}
+
+enum LintCode {
+    DISTRIBUTION_SUM
+    MISSING_DEFAULT_VARIANT
+    UNKNOWN_VARIANT
+    UNKNOWN_SEGMENT
+    INVALID_REGEX
+    INVALID_CIDR
+    INVALID_VALUE_TYPE
+    UNREACHABLE_RULE
+    DUPLICATE_CONSTRAINT
+}
+
+enum Reason {
+    DISABLED
+    DEFAULT
+    TARGETING_MATCH
+    SPLIT
+    EXCLUDED
+}
+
+enum FlagKind {
+    TEMPORARY
+    PERMANENT
+}
+
+enum EntityType {
+    FLAG
+    VARIANT
+    RULE
+    SEGMENT
+}
+
+enum ChangeRequestStatus {
+    PENDING
+    APPLIED
+    REJECTED
+}
+
+enum ChangeAction {
+    CREATED
+    UPDATED
+    DELETED
 }
 
 type Query {
@@ -1018,15 +2564,46 @@ type Mutation {
     key: String!
     name: String!
     description: String
+    kind: FlagKind
+    tags: [String!]
+    ownerTeam: String
+    maintainer: String
+    links: [String!]
+    expiresAt: Time
+    clientExposed: Boolean
 }
 
 input UpdateFlag {
     key: String
     name: String
     description: String
+    kind: FlagKind
+    tags: [String!]
+    ownerTeam: String
+    maintainer: String
+    links: [String!]
+    expiresAt: Time
+    clientExposed: Boolean
     enabled: Boolean
     defaultVariantWhenOn: ID
     defaultVariantWhenOff: ID
+    experiment: Boolean
+    protected: Boolean
+    requiredApprovals: Int
+}
+
+input FlagProposal {
+    flag: UpdateFlag
+    rules: [NewFlagRule!]
+}
+
+input FlagFilter {
+    keys: [String!]
+    tags: [String!]
+    owner: String
+    enabled: Boolean
+    archived: Boolean
+    clientExposed: Boolean
 }
 
 input NewVariant {
@@ -1039,10 +2616,23 @@ input UpdateVariant {
     value: Any
 }
 
+input NewMetric {
+    key: String!
+    name: String!
+    description: String
+}
+
+input UpdateMetric {
+    key: String
+    name: String
+    description: String
+}
+
 input NewConstraint {
-    property: String!
+    property: String
     operation: Operation!
-    values: [Any!]!
+    values: [Any!]
+    constraints: [NewConstraint!]
 }
 
 input NewDistribution {
@@ -1051,20 +2641,32 @@ input NewDistribution {
 }
 
 input NewFlagRule {
+    name: String
+    description: String
+    enabled: Boolean
     constraints: [NewConstraint!]!
     distributions: [NewDistribution!]!
 }
 
 input UpdateFlagRule {
+    name: String
+    description: String
+    enabled: Boolean
     constraints: [NewConstraint!]!
     distributions: [NewDistribution!]!
 }
 
 input NewSegmentRule {
+    name: String
+    description: String
+    enabled: Boolean
     constraints: [NewConstraint!]!
 }
 
 input UpdateSegmentRule {
+    name: String
+    description: String
+    enabled: Boolean
     constraints: [NewConstraint!]!
 }
 
@@ -1078,6 +2680,40 @@ input UpdateSegment {
     description: String
 }
 
+input NewExclusionGroup {
+    name: String!
+    description: String
+    allocations: [NewSlotAllocation!]
+}
+
+input UpdateExclusionGroup {
+    name: String
+    description: String
+    allocations: [NewSlotAllocation!]
+}
+
+input NewSlotAllocation {
+    flagId: ID!
+    start: Int!
+    end: Int!
+}
+
+input NewWebhook {
+    url: String!
+    secret: String!
+    entityTypes: [EntityType!]
+    flagKeys: [String!]
+    enabled: Boolean
+}
+
+input UpdateWebhook {
+    url: String
+    secret: String
+    entityTypes: [EntityType!]
+    flagKeys: [String!]
+    enabled: Boolean
+}
+
 type FlagResults {
     flags: [Flag!]!
     total: Int!
@@ -1093,38 +2729,71 @@ type EvaluationResults {
     total: Int!
 }
 
+type WebhookDeliveryResults {
+    deliveries: [WebhookDelivery!]!
+    total: Int!
+}
+
 extend type Query {
-    flags(search: String, offset: Int, limit: Int): FlagResults!
+    flags(search: String, filter: FlagFilter, offset: Int, limit: Int): FlagResults!
     flag(id: ID!): Flag
+    staleFlags(days: Int = 30): [StaleFlag!]!
+    flagLint(id: ID!): [LintIssue!]!
+    simulateFlag(id: ID!, proposed: FlagProposal!, sampleSize: Int = 10): FlagSimulation!
+    evaluate(flagKey: String!, userId: ID, context: Map, debug: Boolean = true): Evaluation!
     segments(offset: Int, limit: Int): [Segment!]!
     segment(id: ID!): Segment
+    exclusionGroups: [ExclusionGroup!]!
+    exclusionGroup(id: ID!): ExclusionGroup
     users(search: String, offset: Int, limit: Int): UserResults!
     user(id: ID!): User
+    webhooks: [Webhook!]!
+    webhook(id: ID!): Webhook
 }
 
 extend type Mutation {
     createFlag(input: NewFlag!): Flag!
     updateFlag(id: ID!, input: UpdateFlag!): Flag!
+    cloneFlag(id: ID!, newKey: String!, newName: String!): Flag!
+    archiveFlag(id: ID!): Flag!
+    restoreFlag(id: ID!): Flag!
     deleteFlag(id: ID!): ID!
 
     createVariant(flagId: ID!, input: NewVariant!): Variant!
     updateVariant(flagId: ID!, id: ID!, input: UpdateVariant!): Variant!
     deleteVariant(flagId: ID!, id: ID!): ID!
 
+    createMetric(flagId: ID!, input: NewMetric!): Metric!
+    updateMetric(flagId: ID!, id: ID!, input: UpdateMetric!): Metric!
+    deleteMetric(flagId: ID!, id: ID!): ID!
+
+    approveChangeRequest(flagId: ID!, id: ID!): ChangeRequest!
+    rejectChangeRequest(flagId: ID!, id: ID!): ChangeRequest!
+
     createFlagRule(flagId: ID!, input: NewFlagRule!): FlagRule!
     updateFlagRule(flagId: ID!, id: ID!, input: UpdateFlagRule!): FlagRule!
     deleteFlagRule(flagId: ID!, id: ID!): ID!
+    moveFlagRule(flagId: ID!, ruleId: ID!, position: Int!): Flag!
     createSegmentRule(segmentId: ID!, input: NewSegmentRule!): SegmentRule!
     updateSegmentRule(segmentId: ID!, id: ID!, input: UpdateSegmentRule!): SegmentRule!
     deleteSegmentRule(segmentId: ID!, id: ID!): ID!
+    moveSegmentRule(segmentId: ID!, ruleId: ID!, position: Int!): Segment!
 
     createSegment(input: NewSegment!): Segment!
     updateSegment(id: ID!, input: UpdateSegment!): Segment!
-    deleteSegment(id: ID!): ID!
+    deleteSegment(id: ID!, cascade: Boolean = false): ID!
+
+    createExclusionGroup(input: NewExclusionGroup!): ExclusionGroup!
+    updateExclusionGroup(id: ID!, input: UpdateExclusionGroup!): ExclusionGroup!
+    deleteExclusionGroup(id: ID!): ID!
 
     deleteUser(id: ID!): ID!
 
     deleteEvaluation(id: ID!): ID!
+
+    createWebhook(input: NewWebhook!): Webhook!
+    updateWebhook(id: ID!, input: UpdateWebhook!): Webhook!
+    deleteWebhook(id: ID!): ID!
 }`, BuiltIn: false},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
@@ -1133,6 +2802,93 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // region    ***************************** args.gotpl *****************************
 
+func (ec *executionContext) field_Mutation_approveChangeRequest_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["flagId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flagId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["flagId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_archiveFlag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_cloneFlag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["newKey"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("newKey"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["newKey"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["newName"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("newName"))
+		arg2, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["newName"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createExclusionGroup_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 flaggio.NewExclusionGroup
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNNewExclusionGroup2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewExclusionGroup(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_createFlagRule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1172,6 +2928,30 @@ func (ec *executionContext) field_Mutation_createFlag_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_createMetric_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["flagId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flagId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["flagId"] = arg0
+	var arg1 flaggio.NewMetric
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg1, err = ec.unmarshalNNewMetric2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewMetric(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_createSegmentRule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1235,6 +3015,21 @@ func (ec *executionContext) field_Mutation_createVariant_args(ctx context.Contex
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_createWebhook_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 flaggio.NewWebhook
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNNewWebhook2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewWebhook(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_deleteEvaluation_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1250,6 +3045,21 @@ func (ec *executionContext) field_Mutation_deleteEvaluation_args(ctx context.Con
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_deleteExclusionGroup_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_deleteFlagRule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1289,6 +3099,30 @@ func (ec *executionContext) field_Mutation_deleteFlag_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_deleteMetric_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["flagId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flagId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["flagId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_deleteSegmentRule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1325,6 +3159,15 @@ func (ec *executionContext) field_Mutation_deleteSegment_args(ctx context.Contex
 		}
 	}
 	args["id"] = arg0
+	var arg1 *bool
+	if tmp, ok := rawArgs["cascade"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cascade"))
+		arg1, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cascade"] = arg1
 	return args, nil
 }
 
@@ -1367,21 +3210,165 @@ func (ec *executionContext) field_Mutation_deleteVariant_args(ctx context.Contex
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_updateFlagRule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_deleteWebhook_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["flagId"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flagId"))
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
 		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["flagId"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_moveFlagRule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["flagId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flagId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["flagId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["ruleId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ruleId"))
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["ruleId"] = arg1
+	var arg2 int
+	if tmp, ok := rawArgs["position"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("position"))
+		arg2, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["position"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_moveSegmentRule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["segmentId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("segmentId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["segmentId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["ruleId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ruleId"))
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["ruleId"] = arg1
+	var arg2 int
+	if tmp, ok := rawArgs["position"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("position"))
+		arg2, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["position"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_rejectChangeRequest_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["flagId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flagId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["flagId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_restoreFlag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateExclusionGroup_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 flaggio.UpdateExclusionGroup
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg1, err = ec.unmarshalNUpdateExclusionGroup2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateExclusionGroup(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateFlagRule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["flagId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flagId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["flagId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
 		arg1, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
@@ -1424,6 +3411,39 @@ func (ec *executionContext) field_Mutation_updateFlag_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_updateMetric_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["flagId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flagId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["flagId"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg1, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg1
+	var arg2 flaggio.UpdateMetric
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg2, err = ec.unmarshalNUpdateMetric2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateMetric(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_updateSegmentRule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1514,6 +3534,30 @@ func (ec *executionContext) field_Mutation_updateVariant_args(ctx context.Contex
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_updateWebhook_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 flaggio.UpdateWebhook
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg1, err = ec.unmarshalNUpdateWebhook2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateWebhook(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1529,6 +3573,78 @@ func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_evaluate_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["flagKey"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flagKey"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["flagKey"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["userId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+		arg1, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["userId"] = arg1
+	var arg2 map[string]interface{}
+	if tmp, ok := rawArgs["context"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("context"))
+		arg2, err = ec.unmarshalOMap2map(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["context"] = arg2
+	var arg3 *bool
+	if tmp, ok := rawArgs["debug"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("debug"))
+		arg3, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["debug"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_exclusionGroup_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_flagLint_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_flag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1556,24 +3672,33 @@ func (ec *executionContext) field_Query_flags_args(ctx context.Context, rawArgs
 		}
 	}
 	args["search"] = arg0
-	var arg1 *int
+	var arg1 *flaggio.FlagFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg1, err = ec.unmarshalOFlagFilter2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg1
+	var arg2 *int
 	if tmp, ok := rawArgs["offset"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
-		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["offset"] = arg1
-	var arg2 *int
+	args["offset"] = arg2
+	var arg3 *int
 	if tmp, ok := rawArgs["limit"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
-		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["limit"] = arg2
+	args["limit"] = arg3
 	return args, nil
 }
 
@@ -1616,7 +3741,7 @@ func (ec *executionContext) field_Query_segments_args(ctx context.Context, rawAr
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_user_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_simulateFlag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
@@ -1628,17 +3753,65 @@ func (ec *executionContext) field_Query_user_args(ctx context.Context, rawArgs m
 		}
 	}
 	args["id"] = arg0
+	var arg1 flaggio.FlagProposal
+	if tmp, ok := rawArgs["proposed"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("proposed"))
+		arg1, err = ec.unmarshalNFlagProposal2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagProposal(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["proposed"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["sampleSize"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sampleSize"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sampleSize"] = arg2
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_users_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_staleFlags_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *string
-	if tmp, ok := rawArgs["search"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("search"))
-		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
-		if err != nil {
+	var arg0 *int
+	if tmp, ok := rawArgs["days"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("days"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["days"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_user_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_users_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["search"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("search"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
 			return nil, err
 		}
 	}
@@ -1664,6 +3837,21 @@ func (ec *executionContext) field_Query_users_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_webhook_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_User_evaluations_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1697,6 +3885,30 @@ func (ec *executionContext) field_User_evaluations_args(ctx context.Context, raw
 	return args, nil
 }
 
+func (ec *executionContext) field_Webhook_deliveries_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["offset"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["offset"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1735,7 +3947,7 @@ func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArg
 
 // region    **************************** field.gotpl *****************************
 
-func (ec *executionContext) _Constraint_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.Constraint) (ret graphql.Marshaler) {
+func (ec *executionContext) _Approval_user(ctx context.Context, field graphql.CollectedField, obj *flaggio.Approval) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1743,7 +3955,7 @@ func (ec *executionContext) _Constraint_id(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Constraint",
+		Object:     "Approval",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -1753,7 +3965,7 @@ func (ec *executionContext) _Constraint_id(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.User, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1767,10 +3979,10 @@ func (ec *executionContext) _Constraint_id(ctx context.Context, field graphql.Co
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Constraint_property(ctx context.Context, field graphql.CollectedField, obj *flaggio.Constraint) (ret graphql.Marshaler) {
+func (ec *executionContext) _Approval_createdAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.Approval) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1778,7 +3990,7 @@ func (ec *executionContext) _Constraint_property(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Constraint",
+		Object:     "Approval",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -1788,7 +4000,7 @@ func (ec *executionContext) _Constraint_property(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Property, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1800,12 +4012,12 @@ func (ec *executionContext) _Constraint_property(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Constraint_operation(ctx context.Context, field graphql.CollectedField, obj *flaggio.Constraint) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChangeRequest_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.ChangeRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1813,7 +4025,7 @@ func (ec *executionContext) _Constraint_operation(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Constraint",
+		Object:     "ChangeRequest",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -1823,7 +4035,7 @@ func (ec *executionContext) _Constraint_operation(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Operation, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1835,12 +4047,12 @@ func (ec *executionContext) _Constraint_operation(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(flaggio.Operation)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNOperation2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐOperation(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Constraint_values(ctx context.Context, field graphql.CollectedField, obj *flaggio.Constraint) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChangeRequest_entityType(ctx context.Context, field graphql.CollectedField, obj *flaggio.ChangeRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1848,7 +4060,7 @@ func (ec *executionContext) _Constraint_values(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Constraint",
+		Object:     "ChangeRequest",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -1858,7 +4070,7 @@ func (ec *executionContext) _Constraint_values(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Values, nil
+		return obj.EntityType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1870,12 +4082,12 @@ func (ec *executionContext) _Constraint_values(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]interface{})
+	res := resTmp.(flaggio.EntityType)
 	fc.Result = res
-	return ec.marshalNAny2ᚕinterface(ctx, field.Selections, res)
+	return ec.marshalNEntityType2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEntityType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Distribution_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.Distribution) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChangeRequest_entityId(ctx context.Context, field graphql.CollectedField, obj *flaggio.ChangeRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1883,7 +4095,7 @@ func (ec *executionContext) _Distribution_id(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Distribution",
+		Object:     "ChangeRequest",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -1893,7 +4105,7 @@ func (ec *executionContext) _Distribution_id(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.EntityID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1910,7 +4122,7 @@ func (ec *executionContext) _Distribution_id(ctx context.Context, field graphql.
 	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Distribution_variant(ctx context.Context, field graphql.CollectedField, obj *flaggio.Distribution) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChangeRequest_flagVersion(ctx context.Context, field graphql.CollectedField, obj *flaggio.ChangeRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1918,7 +4130,7 @@ func (ec *executionContext) _Distribution_variant(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Distribution",
+		Object:     "ChangeRequest",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -1928,7 +4140,7 @@ func (ec *executionContext) _Distribution_variant(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Variant, nil
+		return obj.FlagVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1940,12 +4152,12 @@ func (ec *executionContext) _Distribution_variant(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.Variant)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Distribution_percentage(ctx context.Context, field graphql.CollectedField, obj *flaggio.Distribution) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChangeRequest_diff(ctx context.Context, field graphql.CollectedField, obj *flaggio.ChangeRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1953,7 +4165,7 @@ func (ec *executionContext) _Distribution_percentage(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Distribution",
+		Object:     "ChangeRequest",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -1963,7 +4175,7 @@ func (ec *executionContext) _Distribution_percentage(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Percentage, nil
+		return obj.Diff, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1975,12 +4187,12 @@ func (ec *executionContext) _Distribution_percentage(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]*flaggio.FieldChange)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNFieldChange2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFieldChangeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Evaluation_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChangeRequest_status(ctx context.Context, field graphql.CollectedField, obj *flaggio.ChangeRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1988,7 +4200,7 @@ func (ec *executionContext) _Evaluation_id(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Evaluation",
+		Object:     "ChangeRequest",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -1998,7 +4210,7 @@ func (ec *executionContext) _Evaluation_id(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2010,12 +4222,12 @@ func (ec *executionContext) _Evaluation_id(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(flaggio.ChangeRequestStatus)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNChangeRequestStatus2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐChangeRequestStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Evaluation_flagId(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChangeRequest_author(ctx context.Context, field graphql.CollectedField, obj *flaggio.ChangeRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2023,7 +4235,7 @@ func (ec *executionContext) _Evaluation_flagId(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Evaluation",
+		Object:     "ChangeRequest",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2033,7 +4245,7 @@ func (ec *executionContext) _Evaluation_flagId(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FlagID, nil
+		return obj.Author, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2047,10 +4259,10 @@ func (ec *executionContext) _Evaluation_flagId(ctx context.Context, field graphq
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Evaluation_flagKey(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChangeRequest_requiredApprovals(ctx context.Context, field graphql.CollectedField, obj *flaggio.ChangeRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2058,7 +4270,7 @@ func (ec *executionContext) _Evaluation_flagKey(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Evaluation",
+		Object:     "ChangeRequest",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2068,7 +4280,7 @@ func (ec *executionContext) _Evaluation_flagKey(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FlagKey, nil
+		return obj.RequiredApprovals, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2080,12 +4292,12 @@ func (ec *executionContext) _Evaluation_flagKey(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Evaluation_flagVersion(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChangeRequest_approvals(ctx context.Context, field graphql.CollectedField, obj *flaggio.ChangeRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2093,7 +4305,7 @@ func (ec *executionContext) _Evaluation_flagVersion(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Evaluation",
+		Object:     "ChangeRequest",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2103,7 +4315,7 @@ func (ec *executionContext) _Evaluation_flagVersion(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FlagVersion, nil
+		return obj.Approvals, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2115,12 +4327,12 @@ func (ec *executionContext) _Evaluation_flagVersion(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]*flaggio.Approval)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNApproval2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐApprovalᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Evaluation_value(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChangeRequest_rejectedBy(ctx context.Context, field graphql.CollectedField, obj *flaggio.ChangeRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2128,7 +4340,7 @@ func (ec *executionContext) _Evaluation_value(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Evaluation",
+		Object:     "ChangeRequest",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2138,7 +4350,7 @@ func (ec *executionContext) _Evaluation_value(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.RejectedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2147,12 +4359,12 @@ func (ec *executionContext) _Evaluation_value(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(interface{})
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOAny2interface(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Evaluation_createdAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChangeRequest_createdAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.ChangeRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2160,7 +4372,7 @@ func (ec *executionContext) _Evaluation_createdAt(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Evaluation",
+		Object:     "ChangeRequest",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2187,7 +4399,7 @@ func (ec *executionContext) _Evaluation_createdAt(ctx context.Context, field gra
 	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _EvaluationResults_evaluations(ctx context.Context, field graphql.CollectedField, obj *flaggio.EvaluationResults) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChangeRequest_updatedAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.ChangeRequest) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2195,7 +4407,7 @@ func (ec *executionContext) _EvaluationResults_evaluations(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "EvaluationResults",
+		Object:     "ChangeRequest",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2205,24 +4417,21 @@ func (ec *executionContext) _EvaluationResults_evaluations(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Evaluations, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*flaggio.Evaluation)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNEvaluation2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluationᚄ(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _EvaluationResults_total(ctx context.Context, field graphql.CollectedField, obj *flaggio.EvaluationResults) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConfidenceInterval_lower(ctx context.Context, field graphql.CollectedField, obj *flaggio.ConfidenceInterval) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2230,7 +4439,7 @@ func (ec *executionContext) _EvaluationResults_total(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "EvaluationResults",
+		Object:     "ConfidenceInterval",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2240,7 +4449,7 @@ func (ec *executionContext) _EvaluationResults_total(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Total, nil
+		return obj.Lower, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2252,12 +4461,12 @@ func (ec *executionContext) _EvaluationResults_total(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Flag_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConfidenceInterval_upper(ctx context.Context, field graphql.CollectedField, obj *flaggio.ConfidenceInterval) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2265,7 +4474,7 @@ func (ec *executionContext) _Flag_id(ctx context.Context, field graphql.Collecte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Flag",
+		Object:     "ConfidenceInterval",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2275,7 +4484,7 @@ func (ec *executionContext) _Flag_id(ctx context.Context, field graphql.Collecte
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Upper, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2287,12 +4496,12 @@ func (ec *executionContext) _Flag_id(ctx context.Context, field graphql.Collecte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Flag_key(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
+func (ec *executionContext) _Constraint_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.Constraint) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2300,7 +4509,7 @@ func (ec *executionContext) _Flag_key(ctx context.Context, field graphql.Collect
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Flag",
+		Object:     "Constraint",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2310,7 +4519,7 @@ func (ec *executionContext) _Flag_key(ctx context.Context, field graphql.Collect
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2324,10 +4533,10 @@ func (ec *executionContext) _Flag_key(ctx context.Context, field graphql.Collect
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Flag_name(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
+func (ec *executionContext) _Constraint_property(ctx context.Context, field graphql.CollectedField, obj *flaggio.Constraint) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2335,7 +4544,7 @@ func (ec *executionContext) _Flag_name(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Flag",
+		Object:     "Constraint",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2345,7 +4554,7 @@ func (ec *executionContext) _Flag_name(ctx context.Context, field graphql.Collec
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Property, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2362,7 +4571,7 @@ func (ec *executionContext) _Flag_name(ctx context.Context, field graphql.Collec
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Flag_description(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
+func (ec *executionContext) _Constraint_operation(ctx context.Context, field graphql.CollectedField, obj *flaggio.Constraint) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2370,7 +4579,7 @@ func (ec *executionContext) _Flag_description(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Flag",
+		Object:     "Constraint",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2380,21 +4589,24 @@ func (ec *executionContext) _Flag_description(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Operation, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(flaggio.Operation)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNOperation2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐOperation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Flag_enabled(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
+func (ec *executionContext) _Constraint_values(ctx context.Context, field graphql.CollectedField, obj *flaggio.Constraint) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2402,7 +4614,7 @@ func (ec *executionContext) _Flag_enabled(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Flag",
+		Object:     "Constraint",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2412,7 +4624,7 @@ func (ec *executionContext) _Flag_enabled(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Enabled, nil
+		return obj.Values, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2424,12 +4636,12 @@ func (ec *executionContext) _Flag_enabled(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]interface{})
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNAny2ᚕinterface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Flag_variants(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
+func (ec *executionContext) _Constraint_constraints(ctx context.Context, field graphql.CollectedField, obj *flaggio.Constraint) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2437,7 +4649,7 @@ func (ec *executionContext) _Flag_variants(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Flag",
+		Object:     "Constraint",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2447,24 +4659,21 @@ func (ec *executionContext) _Flag_variants(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Variants, nil
+		return obj.Constraints, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*flaggio.Variant)
+	res := resTmp.([]*flaggio.Constraint)
 	fc.Result = res
-	return ec.marshalNVariant2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariantᚄ(ctx, field.Selections, res)
+	return ec.marshalOConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐConstraintᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Flag_rules(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
+func (ec *executionContext) _Distribution_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.Distribution) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2472,7 +4681,7 @@ func (ec *executionContext) _Flag_rules(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Flag",
+		Object:     "Distribution",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2482,7 +4691,7 @@ func (ec *executionContext) _Flag_rules(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Rules, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2494,12 +4703,12 @@ func (ec *executionContext) _Flag_rules(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*flaggio.FlagRule)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNFlagRule2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagRuleᚄ(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Flag_defaultVariantWhenOn(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
+func (ec *executionContext) _Distribution_variant(ctx context.Context, field graphql.CollectedField, obj *flaggio.Distribution) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2507,7 +4716,7 @@ func (ec *executionContext) _Flag_defaultVariantWhenOn(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Flag",
+		Object:     "Distribution",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2517,21 +4726,24 @@ func (ec *executionContext) _Flag_defaultVariantWhenOn(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultVariantWhenOn, nil
+		return obj.Variant, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(*flaggio.Variant)
 	fc.Result = res
-	return ec.marshalOVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx, field.Selections, res)
+	return ec.marshalNVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Flag_defaultVariantWhenOff(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
+func (ec *executionContext) _Distribution_percentage(ctx context.Context, field graphql.CollectedField, obj *flaggio.Distribution) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2539,7 +4751,7 @@ func (ec *executionContext) _Flag_defaultVariantWhenOff(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Flag",
+		Object:     "Distribution",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2549,21 +4761,24 @@ func (ec *executionContext) _Flag_defaultVariantWhenOff(ctx context.Context, fie
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultVariantWhenOff, nil
+		return obj.Percentage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.Variant)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Flag_createdAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
+func (ec *executionContext) _Evaluation_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2571,7 +4786,7 @@ func (ec *executionContext) _Flag_createdAt(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Flag",
+		Object:     "Evaluation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2581,7 +4796,7 @@ func (ec *executionContext) _Flag_createdAt(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2593,12 +4808,12 @@ func (ec *executionContext) _Flag_createdAt(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Flag_updatedAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
+func (ec *executionContext) _Evaluation_flagId(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2606,7 +4821,7 @@ func (ec *executionContext) _Flag_updatedAt(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Flag",
+		Object:     "Evaluation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2616,21 +4831,24 @@ func (ec *executionContext) _Flag_updatedAt(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.FlagID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FlagResults_flags(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagResults) (ret graphql.Marshaler) {
+func (ec *executionContext) _Evaluation_flagKey(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2638,7 +4856,7 @@ func (ec *executionContext) _FlagResults_flags(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "FlagResults",
+		Object:     "Evaluation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2648,7 +4866,7 @@ func (ec *executionContext) _FlagResults_flags(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Flags, nil
+		return obj.FlagKey, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2660,12 +4878,12 @@ func (ec *executionContext) _FlagResults_flags(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*flaggio.Flag)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNFlag2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FlagResults_total(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagResults) (ret graphql.Marshaler) {
+func (ec *executionContext) _Evaluation_flagVersion(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2673,7 +4891,7 @@ func (ec *executionContext) _FlagResults_total(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "FlagResults",
+		Object:     "Evaluation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2683,7 +4901,7 @@ func (ec *executionContext) _FlagResults_total(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Total, nil
+		return obj.FlagVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2700,7 +4918,7 @@ func (ec *executionContext) _FlagResults_total(ctx context.Context, field graphq
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FlagRule_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagRule) (ret graphql.Marshaler) {
+func (ec *executionContext) _Evaluation_value(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2708,7 +4926,7 @@ func (ec *executionContext) _FlagRule_id(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "FlagRule",
+		Object:     "Evaluation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2718,24 +4936,21 @@ func (ec *executionContext) _FlagRule_id(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(interface{})
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalOAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FlagRule_constraints(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagRule) (ret graphql.Marshaler) {
+func (ec *executionContext) _Evaluation_variantId(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2743,7 +4958,7 @@ func (ec *executionContext) _FlagRule_constraints(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "FlagRule",
+		Object:     "Evaluation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2753,7 +4968,7 @@ func (ec *executionContext) _FlagRule_constraints(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Constraints, nil
+		return obj.VariantID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2762,12 +4977,12 @@ func (ec *executionContext) _FlagRule_constraints(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*flaggio.Constraint)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐConstraintᚄ(ctx, field.Selections, res)
+	return ec.marshalOID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _FlagRule_distributions(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagRule) (ret graphql.Marshaler) {
+func (ec *executionContext) _Evaluation_reason(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2775,7 +4990,7 @@ func (ec *executionContext) _FlagRule_distributions(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "FlagRule",
+		Object:     "Evaluation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2785,7 +5000,7 @@ func (ec *executionContext) _FlagRule_distributions(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Distributions, nil
+		return obj.Reason, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2794,12 +5009,12 @@ func (ec *executionContext) _FlagRule_distributions(ctx context.Context, field g
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*flaggio.Distribution)
+	res := resTmp.(flaggio.Reason)
 	fc.Result = res
-	return ec.marshalODistribution2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐDistributionᚄ(ctx, field.Selections, res)
+	return ec.marshalOReason2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐReason(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_ping(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Evaluation_ruleName(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2807,34 +5022,31 @@ func (ec *executionContext) _Mutation_ping(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Evaluation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().Ping(rctx)
+		return obj.RuleName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_createFlag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Evaluation_stackTrace(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2842,41 +5054,31 @@ func (ec *executionContext) _Mutation_createFlag(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Evaluation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createFlag_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateFlag(rctx, args["input"].(flaggio.NewFlag))
+		return obj.StackTrace, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.Flag)
+	res := resTmp.([]*flaggio.StackTrace)
 	fc.Result = res
-	return ec.marshalNFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx, field.Selections, res)
+	return ec.marshalOStackTrace2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐStackTraceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_updateFlag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Evaluation_createdAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.Evaluation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2884,24 +5086,17 @@ func (ec *executionContext) _Mutation_updateFlag(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Evaluation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_updateFlag_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdateFlag(rctx, args["id"].(string), args["input"].(flaggio.UpdateFlag))
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2913,12 +5108,12 @@ func (ec *executionContext) _Mutation_updateFlag(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.Flag)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_deleteFlag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _EvaluationResults_evaluations(ctx context.Context, field graphql.CollectedField, obj *flaggio.EvaluationResults) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2926,24 +5121,17 @@ func (ec *executionContext) _Mutation_deleteFlag(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "EvaluationResults",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_deleteFlag_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteFlag(rctx, args["id"].(string))
+		return obj.Evaluations, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2955,12 +5143,12 @@ func (ec *executionContext) _Mutation_deleteFlag(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*flaggio.Evaluation)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNEvaluation2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluationᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_createVariant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _EvaluationResults_total(ctx context.Context, field graphql.CollectedField, obj *flaggio.EvaluationResults) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2968,24 +5156,17 @@ func (ec *executionContext) _Mutation_createVariant(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "EvaluationResults",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createVariant_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateVariant(rctx, args["flagId"].(string), args["input"].(flaggio.NewVariant))
+		return obj.Total, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2997,12 +5178,12 @@ func (ec *executionContext) _Mutation_createVariant(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.Variant)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_updateVariant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExclusionGroup_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.ExclusionGroup) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3010,24 +5191,17 @@ func (ec *executionContext) _Mutation_updateVariant(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ExclusionGroup",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_updateVariant_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdateVariant(rctx, args["flagId"].(string), args["id"].(string), args["input"].(flaggio.UpdateVariant))
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3039,12 +5213,12 @@ func (ec *executionContext) _Mutation_updateVariant(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.Variant)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_deleteVariant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExclusionGroup_name(ctx context.Context, field graphql.CollectedField, obj *flaggio.ExclusionGroup) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3052,24 +5226,17 @@ func (ec *executionContext) _Mutation_deleteVariant(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ExclusionGroup",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_deleteVariant_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteVariant(rctx, args["flagId"].(string), args["id"].(string))
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3083,10 +5250,10 @@ func (ec *executionContext) _Mutation_deleteVariant(ctx context.Context, field g
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_createFlagRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExclusionGroup_description(ctx context.Context, field graphql.CollectedField, obj *flaggio.ExclusionGroup) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3094,41 +5261,31 @@ func (ec *executionContext) _Mutation_createFlagRule(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ExclusionGroup",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createFlagRule_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateFlagRule(rctx, args["flagId"].(string), args["input"].(flaggio.NewFlagRule))
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.FlagRule)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNFlagRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagRule(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_updateFlagRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExclusionGroup_allocations(ctx context.Context, field graphql.CollectedField, obj *flaggio.ExclusionGroup) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3136,24 +5293,17 @@ func (ec *executionContext) _Mutation_updateFlagRule(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ExclusionGroup",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_updateFlagRule_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdateFlagRule(rctx, args["flagId"].(string), args["id"].(string), args["input"].(flaggio.UpdateFlagRule))
+		return obj.Allocations, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3165,12 +5315,12 @@ func (ec *executionContext) _Mutation_updateFlagRule(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.FlagRule)
+	res := resTmp.([]*flaggio.SlotAllocation)
 	fc.Result = res
-	return ec.marshalNFlagRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagRule(ctx, field.Selections, res)
+	return ec.marshalNSlotAllocation2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSlotAllocationᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_deleteFlagRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExclusionGroup_createdAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.ExclusionGroup) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3178,24 +5328,17 @@ func (ec *executionContext) _Mutation_deleteFlagRule(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ExclusionGroup",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_deleteFlagRule_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteFlagRule(rctx, args["flagId"].(string), args["id"].(string))
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3207,12 +5350,12 @@ func (ec *executionContext) _Mutation_deleteFlagRule(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_createSegmentRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExclusionGroup_updatedAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.ExclusionGroup) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3220,41 +5363,31 @@ func (ec *executionContext) _Mutation_createSegmentRule(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ExclusionGroup",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createSegmentRule_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateSegmentRule(rctx, args["segmentId"].(string), args["input"].(flaggio.NewSegmentRule))
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.SegmentRule)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNSegmentRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentRule(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_updateSegmentRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExperimentResults_metrics(ctx context.Context, field graphql.CollectedField, obj *flaggio.ExperimentResults) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3262,24 +5395,17 @@ func (ec *executionContext) _Mutation_updateSegmentRule(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ExperimentResults",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_updateSegmentRule_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdateSegmentRule(rctx, args["segmentId"].(string), args["id"].(string), args["input"].(flaggio.UpdateSegmentRule))
+		return obj.Metrics, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3291,12 +5417,12 @@ func (ec *executionContext) _Mutation_updateSegmentRule(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.SegmentRule)
+	res := resTmp.([]*flaggio.MetricResults)
 	fc.Result = res
-	return ec.marshalNSegmentRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentRule(ctx, field.Selections, res)
+	return ec.marshalNMetricResults2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐMetricResultsᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_deleteSegmentRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _FieldChange_field(ctx context.Context, field graphql.CollectedField, obj *flaggio.FieldChange) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3304,24 +5430,17 @@ func (ec *executionContext) _Mutation_deleteSegmentRule(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "FieldChange",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_deleteSegmentRule_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteSegmentRule(rctx, args["segmentId"].(string), args["id"].(string))
+		return obj.Field, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3335,10 +5454,10 @@ func (ec *executionContext) _Mutation_deleteSegmentRule(ctx context.Context, fie
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_createSegment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _FieldChange_oldValue(ctx context.Context, field graphql.CollectedField, obj *flaggio.FieldChange) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3346,41 +5465,31 @@ func (ec *executionContext) _Mutation_createSegment(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "FieldChange",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createSegment_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateSegment(rctx, args["input"].(flaggio.NewSegment))
+		return obj.OldValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.Segment)
+	res := resTmp.(interface{})
 	fc.Result = res
-	return ec.marshalNSegment2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegment(ctx, field.Selections, res)
+	return ec.marshalOAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_updateSegment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _FieldChange_newValue(ctx context.Context, field graphql.CollectedField, obj *flaggio.FieldChange) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3388,41 +5497,31 @@ func (ec *executionContext) _Mutation_updateSegment(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "FieldChange",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_updateSegment_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdateSegment(rctx, args["id"].(string), args["input"].(flaggio.UpdateSegment))
+		return obj.NewValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.Segment)
+	res := resTmp.(interface{})
 	fc.Result = res
-	return ec.marshalNSegment2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegment(ctx, field.Selections, res)
+	return ec.marshalOAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_deleteSegment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3430,24 +5529,17 @@ func (ec *executionContext) _Mutation_deleteSegment(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_deleteSegment_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteSegment(rctx, args["id"].(string))
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3464,7 +5556,7 @@ func (ec *executionContext) _Mutation_deleteSegment(ctx context.Context, field g
 	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_deleteUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_key(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3472,24 +5564,17 @@ func (ec *executionContext) _Mutation_deleteUser(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_deleteUser_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteUser(rctx, args["id"].(string))
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3503,10 +5588,10 @@ func (ec *executionContext) _Mutation_deleteUser(ctx context.Context, field grap
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_deleteEvaluation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_name(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3514,24 +5599,17 @@ func (ec *executionContext) _Mutation_deleteEvaluation(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_deleteEvaluation_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteEvaluation(rctx, args["id"].(string))
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3545,10 +5623,10 @@ func (ec *executionContext) _Mutation_deleteEvaluation(ctx context.Context, fiel
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_ping(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_description(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3556,34 +5634,31 @@ func (ec *executionContext) _Query_ping(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Ping(rctx)
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_flags(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_kind(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3591,24 +5666,17 @@ func (ec *executionContext) _Query_flags(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_flags_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Flags(rctx, args["search"].(*string), args["offset"].(*int), args["limit"].(*int))
+		return obj.Kind, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3620,12 +5688,12 @@ func (ec *executionContext) _Query_flags(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.FlagResults)
+	res := resTmp.(flaggio.FlagKind)
 	fc.Result = res
-	return ec.marshalNFlagResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagResults(ctx, field.Selections, res)
+	return ec.marshalNFlagKind2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagKind(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_flag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_tags(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3633,38 +5701,34 @@ func (ec *executionContext) _Query_flag(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_flag_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Flag(rctx, args["id"].(string))
+		return obj.Tags, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.Flag)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_segments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_ownerTeam(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3672,41 +5736,31 @@ func (ec *executionContext) _Query_segments(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_segments_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Segments(rctx, args["offset"].(*int), args["limit"].(*int))
+		return obj.OwnerTeam, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*flaggio.Segment)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNSegment2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_segment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_maintainer(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3714,24 +5768,17 @@ func (ec *executionContext) _Query_segment(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_segment_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Segment(rctx, args["id"].(string))
+		return obj.Maintainer, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3740,12 +5787,12 @@ func (ec *executionContext) _Query_segment(ctx context.Context, field graphql.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.Segment)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOSegment2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegment(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_users(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_links(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3753,24 +5800,17 @@ func (ec *executionContext) _Query_users(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_users_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Users(rctx, args["search"].(*string), args["offset"].(*int), args["limit"].(*int))
+		return obj.Links, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3782,12 +5822,12 @@ func (ec *executionContext) _Query_users(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.UserResults)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNUserResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUserResults(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_user(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_expiresAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3795,24 +5835,17 @@ func (ec *executionContext) _Query_user(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_user_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().User(rctx, args["id"].(string))
+		return obj.ExpiresAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3821,12 +5854,12 @@ func (ec *executionContext) _Query_user(ctx context.Context, field graphql.Colle
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.User)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOUser2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUser(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_clientExposed(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3834,38 +5867,34 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query___type_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string))
+		return obj.ClientExposed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_archived(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3873,31 +5902,34 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.Archived, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Segment_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.Segment) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_archivedAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3905,7 +5937,7 @@ func (ec *executionContext) _Segment_id(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Segment",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3915,24 +5947,21 @@ func (ec *executionContext) _Segment_id(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.ArchivedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Segment_name(ctx context.Context, field graphql.CollectedField, obj *flaggio.Segment) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_enabled(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3940,7 +5969,7 @@ func (ec *executionContext) _Segment_name(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Segment",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3950,7 +5979,7 @@ func (ec *executionContext) _Segment_name(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Enabled, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3962,12 +5991,12 @@ func (ec *executionContext) _Segment_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Segment_description(ctx context.Context, field graphql.CollectedField, obj *flaggio.Segment) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_variants(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3975,7 +6004,7 @@ func (ec *executionContext) _Segment_description(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Segment",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3985,21 +6014,24 @@ func (ec *executionContext) _Segment_description(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Variants, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*flaggio.Variant)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNVariant2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariantᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Segment_rules(ctx context.Context, field graphql.CollectedField, obj *flaggio.Segment) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_rules(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4007,7 +6039,7 @@ func (ec *executionContext) _Segment_rules(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Segment",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4029,12 +6061,12 @@ func (ec *executionContext) _Segment_rules(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*flaggio.SegmentRule)
+	res := resTmp.([]*flaggio.FlagRule)
 	fc.Result = res
-	return ec.marshalNSegmentRule2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentRuleᚄ(ctx, field.Selections, res)
+	return ec.marshalNFlagRule2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagRuleᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Segment_createdAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.Segment) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_defaultVariantWhenOn(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4042,7 +6074,7 @@ func (ec *executionContext) _Segment_createdAt(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Segment",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4052,24 +6084,21 @@ func (ec *executionContext) _Segment_createdAt(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.DefaultVariantWhenOn, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*flaggio.Variant)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Segment_updatedAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.Segment) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_defaultVariantWhenOff(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4077,7 +6106,7 @@ func (ec *executionContext) _Segment_updatedAt(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Segment",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4087,7 +6116,7 @@ func (ec *executionContext) _Segment_updatedAt(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.DefaultVariantWhenOff, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4096,12 +6125,12 @@ func (ec *executionContext) _Segment_updatedAt(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(*flaggio.Variant)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SegmentRule_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.SegmentRule) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_experiment(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4109,7 +6138,7 @@ func (ec *executionContext) _SegmentRule_id(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "SegmentRule",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4119,7 +6148,7 @@ func (ec *executionContext) _SegmentRule_id(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Experiment, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4131,12 +6160,12 @@ func (ec *executionContext) _SegmentRule_id(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SegmentRule_constraints(ctx context.Context, field graphql.CollectedField, obj *flaggio.SegmentRule) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_metrics(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4144,7 +6173,7 @@ func (ec *executionContext) _SegmentRule_constraints(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "SegmentRule",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4154,21 +6183,24 @@ func (ec *executionContext) _SegmentRule_constraints(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Constraints, nil
+		return obj.Metrics, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*flaggio.Constraint)
+	res := resTmp.([]*flaggio.Metric)
 	fc.Result = res
-	return ec.marshalOConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐConstraintᚄ(ctx, field.Selections, res)
+	return ec.marshalNMetric2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐMetricᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_experimentResults(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4176,34 +6208,31 @@ func (ec *executionContext) _User_id(ctx context.Context, field graphql.Collecte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Flag().ExperimentResults(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*flaggio.ExperimentResults)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalOExperimentResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐExperimentResults(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_context(ctx context.Context, field graphql.CollectedField, obj *flaggio.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_usage(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4211,34 +6240,31 @@ func (ec *executionContext) _User_context(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Context, nil
+		return ec.resolvers.Flag().Usage(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(map[string]interface{})
+	res := resTmp.(*flaggio.FlagUsage)
 	fc.Result = res
-	return ec.marshalNMap2map(ctx, field.Selections, res)
+	return ec.marshalOFlagUsage2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagUsage(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_updatedAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_protected(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4246,7 +6272,7 @@ func (ec *executionContext) _User_updatedAt(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4256,7 +6282,7 @@ func (ec *executionContext) _User_updatedAt(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Protected, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4268,12 +6294,12 @@ func (ec *executionContext) _User_updatedAt(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _User_evaluations(ctx context.Context, field graphql.CollectedField, obj *flaggio.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_requiredApprovals(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4281,24 +6307,17 @@ func (ec *executionContext) _User_evaluations(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_User_evaluations_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.User().Evaluations(rctx, obj, args["search"].(*string), args["offset"].(*int), args["limit"].(*int))
+		return obj.RequiredApprovals, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4310,12 +6329,12 @@ func (ec *executionContext) _User_evaluations(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*flaggio.EvaluationResults)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNEvaluationResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluationResults(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UserResults_users(ctx context.Context, field graphql.CollectedField, obj *flaggio.UserResults) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_changeRequests(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4323,7 +6342,7 @@ func (ec *executionContext) _UserResults_users(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UserResults",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4333,7 +6352,7 @@ func (ec *executionContext) _UserResults_users(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Users, nil
+		return obj.ChangeRequests, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4345,12 +6364,12 @@ func (ec *executionContext) _UserResults_users(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*flaggio.User)
+	res := resTmp.([]*flaggio.ChangeRequest)
 	fc.Result = res
-	return ec.marshalNUser2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUserᚄ(ctx, field.Selections, res)
+	return ec.marshalNChangeRequest2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐChangeRequestᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _UserResults_total(ctx context.Context, field graphql.CollectedField, obj *flaggio.UserResults) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_createdAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4358,7 +6377,7 @@ func (ec *executionContext) _UserResults_total(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "UserResults",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4368,7 +6387,7 @@ func (ec *executionContext) _UserResults_total(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Total, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4380,12 +6399,12 @@ func (ec *executionContext) _UserResults_total(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Variant_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.Variant) (ret graphql.Marshaler) {
+func (ec *executionContext) _Flag_updatedAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.Flag) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4393,7 +6412,7 @@ func (ec *executionContext) _Variant_id(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Variant",
+		Object:     "Flag",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4403,24 +6422,21 @@ func (ec *executionContext) _Variant_id(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Variant_description(ctx context.Context, field graphql.CollectedField, obj *flaggio.Variant) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagResults_flags(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagResults) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4428,7 +6444,7 @@ func (ec *executionContext) _Variant_description(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Variant",
+		Object:     "FlagResults",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4438,21 +6454,24 @@ func (ec *executionContext) _Variant_description(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Flags, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*flaggio.Flag)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNFlag2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Variant_value(ctx context.Context, field graphql.CollectedField, obj *flaggio.Variant) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagResults_total(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagResults) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4460,7 +6479,7 @@ func (ec *executionContext) _Variant_value(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Variant",
+		Object:     "FlagResults",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4470,7 +6489,7 @@ func (ec *executionContext) _Variant_value(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Total, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4482,12 +6501,12 @@ func (ec *executionContext) _Variant_value(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(interface{})
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNAny2interface(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagRule_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagRule) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4495,7 +6514,7 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "FlagRule",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4505,7 +6524,7 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4519,10 +6538,10 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagRule_name(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagRule) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4530,7 +6549,7 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "FlagRule",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4540,7 +6559,7 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4549,12 +6568,12 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagRule_description(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagRule) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4562,7 +6581,7 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "FlagRule",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4572,24 +6591,21 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagRule_enabled(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagRule) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4597,17 +6613,17 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "FlagRule",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.Enabled(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4619,12 +6635,12 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagRule_constraints(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagRule) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4632,7 +6648,7 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "FlagRule",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4642,24 +6658,21 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Constraints, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*flaggio.Constraint)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐConstraintᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagRule_distributions(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagRule) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4667,7 +6680,7 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "FlagRule",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4677,7 +6690,7 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Distributions, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4686,12 +6699,12 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*flaggio.Distribution)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalODistribution2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐDistributionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagSimulation_users(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagSimulation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4699,17 +6712,17 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "FlagSimulation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.Users, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4721,12 +6734,12 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagSimulation_totalUsers(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagSimulation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4734,31 +6747,34 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "FlagSimulation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.TotalUsers, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagSimulation_affectedUsers(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagSimulation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4766,7 +6782,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "FlagSimulation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4776,7 +6792,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.AffectedUsers, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4788,12 +6804,12 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagSimulation_sampleUserIds(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagSimulation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4801,7 +6817,7 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "FlagSimulation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4811,21 +6827,24 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.SampleUserIDs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNID2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagUsage_evaluations(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagUsage) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4833,7 +6852,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "FlagUsage",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4843,7 +6862,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.Evaluations, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4855,12 +6874,12 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagUsage_firstEvaluatedAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagUsage) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4868,7 +6887,7 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "FlagUsage",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4878,7 +6897,7 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.FirstEvaluatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4890,12 +6909,12 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagUsage_lastEvaluatedAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagUsage) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4903,17 +6922,17 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "FlagUsage",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.LastEvaluatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4925,12 +6944,12 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _FlagUsage_variants(ctx context.Context, field graphql.CollectedField, obj *flaggio.FlagUsage) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4938,31 +6957,34 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "FlagUsage",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.Variants, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*flaggio.VariantUsage)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNVariantUsage2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariantUsageᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _LintIssue_severity(ctx context.Context, field graphql.CollectedField, obj *flaggio.LintIssue) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4970,7 +6992,7 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "LintIssue",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4980,7 +7002,7 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Severity, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4992,12 +7014,12 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(flaggio.LintSeverity)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLintSeverity2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐLintSeverity(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _LintIssue_code(ctx context.Context, field graphql.CollectedField, obj *flaggio.LintIssue) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5005,7 +7027,7 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "LintIssue",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5015,21 +7037,24 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Code, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(flaggio.LintCode)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNLintCode2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐLintCode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _LintIssue_message(ctx context.Context, field graphql.CollectedField, obj *flaggio.LintIssue) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5037,7 +7062,7 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "LintIssue",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5047,7 +7072,7 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5059,12 +7084,12 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _LintIssue_ruleId(ctx context.Context, field graphql.CollectedField, obj *flaggio.LintIssue) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5072,7 +7097,7 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "LintIssue",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5082,7 +7107,7 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
+		return obj.RuleID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5093,10 +7118,10 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	}
 	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Metric_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.Metric) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5104,17 +7129,17 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Metric",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5126,12 +7151,12 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Metric_key(ctx context.Context, field graphql.CollectedField, obj *flaggio.Metric) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5139,17 +7164,17 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Metric",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5161,12 +7186,12 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Metric_name(ctx context.Context, field graphql.CollectedField, obj *flaggio.Metric) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5174,31 +7199,34 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Metric",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Metric_description(ctx context.Context, field graphql.CollectedField, obj *flaggio.Metric) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5206,17 +7234,17 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Metric",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5225,12 +7253,12 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _MetricResults_metric(ctx context.Context, field graphql.CollectedField, obj *flaggio.MetricResults) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5238,17 +7266,17 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "MetricResults",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
+		return obj.Metric, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5260,12 +7288,12 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
+	res := resTmp.(*flaggio.Metric)
 	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+	return ec.marshalNMetric2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐMetric(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _MetricResults_variants(ctx context.Context, field graphql.CollectedField, obj *flaggio.MetricResults) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5273,17 +7301,17 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "MetricResults",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
+		return obj.Variants, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5295,12 +7323,12 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*flaggio.VariantResults)
 	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec.marshalNVariantResults2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariantResultsᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_ping(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5308,31 +7336,34 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
+		return ec.resolvers.Mutation().Ping(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createFlag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5340,31 +7371,41 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createFlag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return ec.resolvers.Mutation().CreateFlag(rctx, args["input"].(flaggio.NewFlag))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*flaggio.Flag)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateFlag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5372,16 +7413,16 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_fields_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_updateFlag_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -5389,21 +7430,24 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(args["includeDeprecated"].(bool)), nil
+		return ec.resolvers.Mutation().UpdateFlag(rctx, args["id"].(string), args["input"].(flaggio.UpdateFlag))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
+	res := resTmp.(*flaggio.Flag)
 	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+	return ec.marshalNFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_cloneFlag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5411,31 +7455,41 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_cloneFlag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
+		return ec.resolvers.Mutation().CloneFlag(rctx, args["id"].(string), args["newKey"].(string), args["newName"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*flaggio.Flag)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_archiveFlag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5443,31 +7497,41 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_archiveFlag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
+		return ec.resolvers.Mutation().ArchiveFlag(rctx, args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*flaggio.Flag)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_restoreFlag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5475,16 +7539,16 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
+	args, err := ec.field_Mutation_restoreFlag_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -5492,21 +7556,24 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
+		return ec.resolvers.Mutation().RestoreFlag(rctx, args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
+	res := resTmp.(*flaggio.Flag)
 	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_deleteFlag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5514,31 +7581,41 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteFlag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
+		return ec.resolvers.Mutation().DeleteFlag(rctx, args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createVariant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5546,1415 +7623,9398 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createVariant_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
+		return ec.resolvers.Mutation().CreateVariant(rctx, args["flagId"].(string), args["input"].(flaggio.NewVariant))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*flaggio.Variant)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx, field.Selections, res)
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-func (ec *executionContext) unmarshalInputNewConstraint(ctx context.Context, obj interface{}) (flaggio.NewConstraint, error) {
-	var it flaggio.NewConstraint
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "property":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("property"))
-			it.Property, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "operation":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("operation"))
-			it.Operation, err = ec.unmarshalNOperation2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐOperation(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "values":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("values"))
-			it.Values, err = ec.unmarshalNAny2ᚕinterfaceᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Mutation_updateVariant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputNewDistribution(ctx context.Context, obj interface{}) (flaggio.NewDistribution, error) {
-	var it flaggio.NewDistribution
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "variantId":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("variantId"))
-			it.VariantID, err = ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "percentage":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("percentage"))
-			it.Percentage, err = ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
-			}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_updateVariant_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateVariant(rctx, args["flagId"].(string), args["id"].(string), args["input"].(flaggio.UpdateVariant))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-
-	return it, nil
+	res := resTmp.(*flaggio.Variant)
+	fc.Result = res
+	return ec.marshalNVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputNewFlag(ctx context.Context, obj interface{}) (flaggio.NewFlag, error) {
-	var it flaggio.NewFlag
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "key":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
-			it.Key, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "name":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			it.Name, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "description":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
-			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Mutation_deleteVariant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
-	return it, nil
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteVariant_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteVariant(rctx, args["flagId"].(string), args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputNewFlagRule(ctx context.Context, obj interface{}) (flaggio.NewFlagRule, error) {
-	var it flaggio.NewFlagRule
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "constraints":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("constraints"))
-			it.Constraints, err = ec.unmarshalNNewConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraintᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "distributions":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("distributions"))
-			it.Distributions, err = ec.unmarshalNNewDistribution2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewDistributionᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Mutation_createMetric(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
-	return it, nil
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createMetric_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateMetric(rctx, args["flagId"].(string), args["input"].(flaggio.NewMetric))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.Metric)
+	fc.Result = res
+	return ec.marshalNMetric2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐMetric(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputNewSegment(ctx context.Context, obj interface{}) (flaggio.NewSegment, error) {
-	var it flaggio.NewSegment
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "name":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			it.Name, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "description":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
-			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Mutation_updateMetric(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
-	return it, nil
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_updateMetric_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateMetric(rctx, args["flagId"].(string), args["id"].(string), args["input"].(flaggio.UpdateMetric))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.Metric)
+	fc.Result = res
+	return ec.marshalNMetric2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐMetric(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputNewSegmentRule(ctx context.Context, obj interface{}) (flaggio.NewSegmentRule, error) {
-	var it flaggio.NewSegmentRule
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "constraints":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("constraints"))
-			it.Constraints, err = ec.unmarshalNNewConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraintᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Mutation_deleteMetric(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
-	return it, nil
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteMetric_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteMetric(rctx, args["flagId"].(string), args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputNewVariant(ctx context.Context, obj interface{}) (flaggio.NewVariant, error) {
-	var it flaggio.NewVariant
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "description":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
-			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "value":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
-			it.Value, err = ec.unmarshalNAny2interface(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Mutation_approveChangeRequest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
-	return it, nil
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_approveChangeRequest_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ApproveChangeRequest(rctx, args["flagId"].(string), args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.ChangeRequest)
+	fc.Result = res
+	return ec.marshalNChangeRequest2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐChangeRequest(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputUpdateFlag(ctx context.Context, obj interface{}) (flaggio.UpdateFlag, error) {
-	var it flaggio.UpdateFlag
-	var asMap = obj.(map[string]interface{})
+func (ec *executionContext) _Mutation_rejectChangeRequest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
 
-	for k, v := range asMap {
-		switch k {
-		case "key":
-			var err error
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_rejectChangeRequest_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RejectChangeRequest(rctx, args["flagId"].(string), args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.ChangeRequest)
+	fc.Result = res
+	return ec.marshalNChangeRequest2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐChangeRequest(ctx, field.Selections, res)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
-			it.Key, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "name":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "description":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
-			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "enabled":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
-			it.Enabled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "defaultVariantWhenOn":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("defaultVariantWhenOn"))
-			it.DefaultVariantWhenOn, err = ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "defaultVariantWhenOff":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("defaultVariantWhenOff"))
-			it.DefaultVariantWhenOff, err = ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Mutation_createFlagRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputUpdateFlagRule(ctx context.Context, obj interface{}) (flaggio.UpdateFlagRule, error) {
-	var it flaggio.UpdateFlagRule
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "constraints":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("constraints"))
-			it.Constraints, err = ec.unmarshalNNewConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraintᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "distributions":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("distributions"))
-			it.Distributions, err = ec.unmarshalNNewDistribution2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewDistributionᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createFlagRule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateFlagRule(rctx, args["flagId"].(string), args["input"].(flaggio.NewFlagRule))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-
-	return it, nil
+	res := resTmp.(*flaggio.FlagRule)
+	fc.Result = res
+	return ec.marshalNFlagRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagRule(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputUpdateSegment(ctx context.Context, obj interface{}) (flaggio.UpdateSegment, error) {
-	var it flaggio.UpdateSegment
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "name":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "description":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
-			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Mutation_updateFlagRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputUpdateSegmentRule(ctx context.Context, obj interface{}) (flaggio.UpdateSegmentRule, error) {
-	var it flaggio.UpdateSegmentRule
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "constraints":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("constraints"))
-			it.Constraints, err = ec.unmarshalNNewConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraintᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_updateFlagRule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateFlagRule(rctx, args["flagId"].(string), args["id"].(string), args["input"].(flaggio.UpdateFlagRule))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-
-	return it, nil
+	res := resTmp.(*flaggio.FlagRule)
+	fc.Result = res
+	return ec.marshalNFlagRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagRule(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputUpdateVariant(ctx context.Context, obj interface{}) (flaggio.UpdateVariant, error) {
-	var it flaggio.UpdateVariant
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "description":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
-			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "value":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
-			it.Value, err = ec.unmarshalOAny2interface(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Mutation_deleteFlagRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
-	return it, nil
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteFlagRule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteFlagRule(rctx, args["flagId"].(string), args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+func (ec *executionContext) _Mutation_moveFlagRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
 
-func (ec *executionContext) _Ruler(ctx context.Context, sel ast.SelectionSet, obj flaggio.Ruler) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_moveFlagRule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
 		return graphql.Null
-	case flaggio.FlagRule:
-		return ec._FlagRule(ctx, sel, &obj)
-	case *flaggio.FlagRule:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._FlagRule(ctx, sel, obj)
-	case flaggio.SegmentRule:
-		return ec._SegmentRule(ctx, sel, &obj)
-	case *flaggio.SegmentRule:
-		if obj == nil {
-			return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().MoveFlagRule(rctx, args["flagId"].(string), args["ruleId"].(string), args["position"].(int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-		return ec._SegmentRule(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+		return graphql.Null
 	}
+	res := resTmp.(*flaggio.Flag)
+	fc.Result = res
+	return ec.marshalNFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx, field.Selections, res)
 }
 
-// endregion ************************** interface.gotpl ***************************
-
-// region    **************************** object.gotpl ****************************
-
-var constraintImplementors = []string{"Constraint"}
-
-func (ec *executionContext) _Constraint(ctx context.Context, sel ast.SelectionSet, obj *flaggio.Constraint) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, constraintImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Constraint")
-		case "id":
-			out.Values[i] = ec._Constraint_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "property":
-			out.Values[i] = ec._Constraint_property(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "operation":
-			out.Values[i] = ec._Constraint_operation(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "values":
-			out.Values[i] = ec._Constraint_values(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) _Mutation_createSegmentRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
-	out.Dispatch()
-	if invalids > 0 {
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createSegmentRule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	return out
-}
-
-var distributionImplementors = []string{"Distribution"}
-
-func (ec *executionContext) _Distribution(ctx context.Context, sel ast.SelectionSet, obj *flaggio.Distribution) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, distributionImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Distribution")
-		case "id":
-			out.Values[i] = ec._Distribution_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "variant":
-			out.Values[i] = ec._Distribution_variant(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "percentage":
-			out.Values[i] = ec._Distribution_percentage(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateSegmentRule(rctx, args["segmentId"].(string), args["input"].(flaggio.NewSegmentRule))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	res := resTmp.(*flaggio.SegmentRule)
+	fc.Result = res
+	return ec.marshalNSegmentRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentRule(ctx, field.Selections, res)
 }
 
-var evaluationImplementors = []string{"Evaluation"}
-
-func (ec *executionContext) _Evaluation(ctx context.Context, sel ast.SelectionSet, obj *flaggio.Evaluation) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, evaluationImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Evaluation")
-		case "id":
-			out.Values[i] = ec._Evaluation_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "flagId":
-			out.Values[i] = ec._Evaluation_flagId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "flagKey":
-			out.Values[i] = ec._Evaluation_flagKey(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "flagVersion":
-			out.Values[i] = ec._Evaluation_flagVersion(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "value":
-			out.Values[i] = ec._Evaluation_value(ctx, field, obj)
-		case "createdAt":
-			out.Values[i] = ec._Evaluation_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) _Mutation_updateSegmentRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
-	out.Dispatch()
-	if invalids > 0 {
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_updateSegmentRule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	return out
-}
-
-var evaluationResultsImplementors = []string{"EvaluationResults"}
-
-func (ec *executionContext) _EvaluationResults(ctx context.Context, sel ast.SelectionSet, obj *flaggio.EvaluationResults) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, evaluationResultsImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("EvaluationResults")
-		case "evaluations":
-			out.Values[i] = ec._EvaluationResults_evaluations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "total":
-			out.Values[i] = ec._EvaluationResults_total(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateSegmentRule(rctx, args["segmentId"].(string), args["id"].(string), args["input"].(flaggio.UpdateSegmentRule))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	res := resTmp.(*flaggio.SegmentRule)
+	fc.Result = res
+	return ec.marshalNSegmentRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentRule(ctx, field.Selections, res)
 }
 
-var flagImplementors = []string{"Flag"}
-
-func (ec *executionContext) _Flag(ctx context.Context, sel ast.SelectionSet, obj *flaggio.Flag) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, flagImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Flag")
-		case "id":
-			out.Values[i] = ec._Flag_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "key":
-			out.Values[i] = ec._Flag_key(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			out.Values[i] = ec._Flag_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec._Flag_description(ctx, field, obj)
-		case "enabled":
-			out.Values[i] = ec._Flag_enabled(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "variants":
-			out.Values[i] = ec._Flag_variants(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "rules":
-			out.Values[i] = ec._Flag_rules(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "defaultVariantWhenOn":
-			out.Values[i] = ec._Flag_defaultVariantWhenOn(ctx, field, obj)
-		case "defaultVariantWhenOff":
-			out.Values[i] = ec._Flag_defaultVariantWhenOff(ctx, field, obj)
-		case "createdAt":
-			out.Values[i] = ec._Flag_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "updatedAt":
-			out.Values[i] = ec._Flag_updatedAt(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) _Mutation_deleteSegmentRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
-	out.Dispatch()
-	if invalids > 0 {
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteSegmentRule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	return out
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteSegmentRule(rctx, args["segmentId"].(string), args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-var flagResultsImplementors = []string{"FlagResults"}
-
-func (ec *executionContext) _FlagResults(ctx context.Context, sel ast.SelectionSet, obj *flaggio.FlagResults) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, flagResultsImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("FlagResults")
-		case "flags":
-			out.Values[i] = ec._FlagResults_flags(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "total":
-			out.Values[i] = ec._FlagResults_total(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) _Mutation_moveSegmentRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
-	out.Dispatch()
-	if invalids > 0 {
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_moveSegmentRule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	return out
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().MoveSegmentRule(rctx, args["segmentId"].(string), args["ruleId"].(string), args["position"].(int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.Segment)
+	fc.Result = res
+	return ec.marshalNSegment2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegment(ctx, field.Selections, res)
 }
 
-var flagRuleImplementors = []string{"FlagRule", "Ruler"}
-
-func (ec *executionContext) _FlagRule(ctx context.Context, sel ast.SelectionSet, obj *flaggio.FlagRule) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, flagRuleImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("FlagRule")
-		case "id":
-			out.Values[i] = ec._FlagRule_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "constraints":
-			out.Values[i] = ec._FlagRule_constraints(ctx, field, obj)
-		case "distributions":
-			out.Values[i] = ec._FlagRule_distributions(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) _Mutation_createSegment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
-	out.Dispatch()
-	if invalids > 0 {
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createSegment_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	return out
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateSegment(rctx, args["input"].(flaggio.NewSegment))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.Segment)
+	fc.Result = res
+	return ec.marshalNSegment2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegment(ctx, field.Selections, res)
 }
 
-var mutationImplementors = []string{"Mutation"}
-
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+func (ec *executionContext) _Mutation_updateSegment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
 
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_updateSegment_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateSegment(rctx, args["id"].(string), args["input"].(flaggio.UpdateSegment))
 	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.Segment)
+	fc.Result = res
+	return ec.marshalNSegment2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegment(ctx, field.Selections, res)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
+func (ec *executionContext) _Mutation_deleteSegment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteSegment_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteSegment(rctx, args["id"].(string), args["cascade"].(*bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_createExclusionGroup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createExclusionGroup_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateExclusionGroup(rctx, args["input"].(flaggio.NewExclusionGroup))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.ExclusionGroup)
+	fc.Result = res
+	return ec.marshalNExclusionGroup2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐExclusionGroup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_updateExclusionGroup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_updateExclusionGroup_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateExclusionGroup(rctx, args["id"].(string), args["input"].(flaggio.UpdateExclusionGroup))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.ExclusionGroup)
+	fc.Result = res
+	return ec.marshalNExclusionGroup2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐExclusionGroup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteExclusionGroup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteExclusionGroup_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteExclusionGroup(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteUser_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteUser(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteEvaluation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteEvaluation_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteEvaluation(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_createWebhook(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createWebhook_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateWebhook(rctx, args["input"].(flaggio.NewWebhook))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.Webhook)
+	fc.Result = res
+	return ec.marshalNWebhook2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhook(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_updateWebhook(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_updateWebhook_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateWebhook(rctx, args["id"].(string), args["input"].(flaggio.UpdateWebhook))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.Webhook)
+	fc.Result = res
+	return ec.marshalNWebhook2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhook(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteWebhook(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteWebhook_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteWebhook(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_ping(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Ping(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_flags(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_flags_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Flags(rctx, args["search"].(*string), args["filter"].(*flaggio.FlagFilter), args["offset"].(*int), args["limit"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.FlagResults)
+	fc.Result = res
+	return ec.marshalNFlagResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagResults(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_flag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_flag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Flag(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.Flag)
+	fc.Result = res
+	return ec.marshalOFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_staleFlags(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_staleFlags_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().StaleFlags(rctx, args["days"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*flaggio.StaleFlag)
+	fc.Result = res
+	return ec.marshalNStaleFlag2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐStaleFlagᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_flagLint(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_flagLint_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().FlagLint(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*flaggio.LintIssue)
+	fc.Result = res
+	return ec.marshalNLintIssue2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐLintIssueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_simulateFlag(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_simulateFlag_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SimulateFlag(rctx, args["id"].(string), args["proposed"].(flaggio.FlagProposal), args["sampleSize"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.FlagSimulation)
+	fc.Result = res
+	return ec.marshalNFlagSimulation2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagSimulation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_evaluate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_evaluate_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Evaluate(rctx, args["flagKey"].(string), args["userId"].(*string), args["context"].(map[string]interface{}), args["debug"].(*bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.Evaluation)
+	fc.Result = res
+	return ec.marshalNEvaluation2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_segments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_segments_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Segments(rctx, args["offset"].(*int), args["limit"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*flaggio.Segment)
+	fc.Result = res
+	return ec.marshalNSegment2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_segment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_segment_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Segment(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.Segment)
+	fc.Result = res
+	return ec.marshalOSegment2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegment(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_exclusionGroups(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ExclusionGroups(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*flaggio.ExclusionGroup)
+	fc.Result = res
+	return ec.marshalNExclusionGroup2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐExclusionGroupᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_exclusionGroup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_exclusionGroup_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ExclusionGroup(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.ExclusionGroup)
+	fc.Result = res
+	return ec.marshalOExclusionGroup2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐExclusionGroup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_users(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_users_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Users(rctx, args["search"].(*string), args["offset"].(*int), args["limit"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.UserResults)
+	fc.Result = res
+	return ec.marshalNUserResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUserResults(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_user(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_user_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().User(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.User)
+	fc.Result = res
+	return ec.marshalOUser2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_webhooks(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Webhooks(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*flaggio.Webhook)
+	fc.Result = res
+	return ec.marshalNWebhook2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhookᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_webhook(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_webhook_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Webhook(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.Webhook)
+	fc.Result = res
+	return ec.marshalOWebhook2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhook(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query___type_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Segment_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.Segment) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Segment_name(ctx context.Context, field graphql.CollectedField, obj *flaggio.Segment) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Segment_description(ctx context.Context, field graphql.CollectedField, obj *flaggio.Segment) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Segment_rules(ctx context.Context, field graphql.CollectedField, obj *flaggio.Segment) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Rules, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*flaggio.SegmentRule)
+	fc.Result = res
+	return ec.marshalNSegmentRule2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentRuleᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Segment_usedBy(ctx context.Context, field graphql.CollectedField, obj *flaggio.Segment) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Segment().UsedBy(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*flaggio.SegmentUsage)
+	fc.Result = res
+	return ec.marshalNSegmentUsage2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentUsageᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Segment_createdAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.Segment) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Segment_updatedAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.Segment) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SegmentRule_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.SegmentRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SegmentRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SegmentRule_name(ctx context.Context, field graphql.CollectedField, obj *flaggio.SegmentRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SegmentRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SegmentRule_description(ctx context.Context, field graphql.CollectedField, obj *flaggio.SegmentRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SegmentRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SegmentRule_enabled(ctx context.Context, field graphql.CollectedField, obj *flaggio.SegmentRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SegmentRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Enabled(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SegmentRule_constraints(ctx context.Context, field graphql.CollectedField, obj *flaggio.SegmentRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SegmentRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Constraints, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*flaggio.Constraint)
+	fc.Result = res
+	return ec.marshalOConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐConstraintᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SegmentUsage_flag(ctx context.Context, field graphql.CollectedField, obj *flaggio.SegmentUsage) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SegmentUsage",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Flag, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.Flag)
+	fc.Result = res
+	return ec.marshalNFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SegmentUsage_rules(ctx context.Context, field graphql.CollectedField, obj *flaggio.SegmentUsage) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SegmentUsage",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Rules, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*flaggio.FlagRule)
+	fc.Result = res
+	return ec.marshalNFlagRule2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagRuleᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SlotAllocation_flagId(ctx context.Context, field graphql.CollectedField, obj *flaggio.SlotAllocation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SlotAllocation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FlagID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SlotAllocation_start(ctx context.Context, field graphql.CollectedField, obj *flaggio.SlotAllocation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SlotAllocation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Start, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SlotAllocation_end(ctx context.Context, field graphql.CollectedField, obj *flaggio.SlotAllocation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SlotAllocation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.End, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _StackTrace_type(ctx context.Context, field graphql.CollectedField, obj *flaggio.StackTrace) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "StackTrace",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _StackTrace_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.StackTrace) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "StackTrace",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _StackTrace_name(ctx context.Context, field graphql.CollectedField, obj *flaggio.StackTrace) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "StackTrace",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _StackTrace_answer(ctx context.Context, field graphql.CollectedField, obj *flaggio.StackTrace) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "StackTrace",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Answer, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(interface{})
+	fc.Result = res
+	return ec.marshalOAny2interface(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _StaleFlag_flag(ctx context.Context, field graphql.CollectedField, obj *flaggio.StaleFlag) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "StaleFlag",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Flag, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.Flag)
+	fc.Result = res
+	return ec.marshalNFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _StaleFlag_reasons(ctx context.Context, field graphql.CollectedField, obj *flaggio.StaleFlag) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "StaleFlag",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reasons, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]flaggio.StaleReason)
+	fc.Result = res
+	return ec.marshalNStaleReason2ᚕgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐStaleReasonᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _StaleFlag_evaluations(ctx context.Context, field graphql.CollectedField, obj *flaggio.StaleFlag) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "StaleFlag",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Evaluations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _StaleFlag_lastEvaluatedAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.StaleFlag) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "StaleFlag",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastEvaluatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_context(ctx context.Context, field graphql.CollectedField, obj *flaggio.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Context, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(map[string]interface{})
+	fc.Result = res
+	return ec.marshalNMap2map(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_updatedAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_evaluations(ctx context.Context, field graphql.CollectedField, obj *flaggio.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_User_evaluations_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.User().Evaluations(rctx, obj, args["search"].(*string), args["offset"].(*int), args["limit"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.EvaluationResults)
+	fc.Result = res
+	return ec.marshalNEvaluationResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluationResults(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UserResults_users(ctx context.Context, field graphql.CollectedField, obj *flaggio.UserResults) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UserResults",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Users, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*flaggio.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUserᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UserResults_total(ctx context.Context, field graphql.CollectedField, obj *flaggio.UserResults) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UserResults",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Total, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Variant_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.Variant) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Variant",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Variant_description(ctx context.Context, field graphql.CollectedField, obj *flaggio.Variant) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Variant",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Variant_value(ctx context.Context, field graphql.CollectedField, obj *flaggio.Variant) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Variant",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(interface{})
+	fc.Result = res
+	return ec.marshalNAny2interface(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _VariantResults_variant(ctx context.Context, field graphql.CollectedField, obj *flaggio.VariantResults) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "VariantResults",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Variant, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.Variant)
+	fc.Result = res
+	return ec.marshalNVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _VariantResults_exposures(ctx context.Context, field graphql.CollectedField, obj *flaggio.VariantResults) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "VariantResults",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Exposures, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _VariantResults_conversions(ctx context.Context, field graphql.CollectedField, obj *flaggio.VariantResults) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "VariantResults",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Conversions, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _VariantResults_conversionRate(ctx context.Context, field graphql.CollectedField, obj *flaggio.VariantResults) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "VariantResults",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ConversionRate, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _VariantResults_confidenceInterval(ctx context.Context, field graphql.CollectedField, obj *flaggio.VariantResults) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "VariantResults",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ConfidenceInterval, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.ConfidenceInterval)
+	fc.Result = res
+	return ec.marshalNConfidenceInterval2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐConfidenceInterval(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _VariantUsage_variantId(ctx context.Context, field graphql.CollectedField, obj *flaggio.VariantUsage) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "VariantUsage",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.VariantID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _VariantUsage_lastServedAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.VariantUsage) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "VariantUsage",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastServedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Webhook_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.Webhook) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Webhook",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Webhook_url(ctx context.Context, field graphql.CollectedField, obj *flaggio.Webhook) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Webhook",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Webhook_entityTypes(ctx context.Context, field graphql.CollectedField, obj *flaggio.Webhook) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Webhook",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EntityTypes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]flaggio.EntityType)
+	fc.Result = res
+	return ec.marshalNEntityType2ᚕgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEntityTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Webhook_flagKeys(ctx context.Context, field graphql.CollectedField, obj *flaggio.Webhook) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Webhook",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FlagKeys, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Webhook_enabled(ctx context.Context, field graphql.CollectedField, obj *flaggio.Webhook) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Webhook",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Enabled, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Webhook_deliveries(ctx context.Context, field graphql.CollectedField, obj *flaggio.Webhook) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Webhook",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Webhook_deliveries_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Webhook().Deliveries(rctx, obj, args["offset"].(*int), args["limit"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*flaggio.WebhookDeliveryResults)
+	fc.Result = res
+	return ec.marshalNWebhookDeliveryResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhookDeliveryResults(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Webhook_createdAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.Webhook) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Webhook",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Webhook_updatedAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.Webhook) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Webhook",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_id(ctx context.Context, field graphql.CollectedField, obj *flaggio.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_webhookId(ctx context.Context, field graphql.CollectedField, obj *flaggio.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.WebhookID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_entityType(ctx context.Context, field graphql.CollectedField, obj *flaggio.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EntityType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(flaggio.EntityType)
+	fc.Result = res
+	return ec.marshalNEntityType2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEntityType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_entityId(ctx context.Context, field graphql.CollectedField, obj *flaggio.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EntityID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_action(ctx context.Context, field graphql.CollectedField, obj *flaggio.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Action, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(flaggio.ChangeAction)
+	fc.Result = res
+	return ec.marshalNChangeAction2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐChangeAction(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_payload(ctx context.Context, field graphql.CollectedField, obj *flaggio.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Payload, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_attempts(ctx context.Context, field graphql.CollectedField, obj *flaggio.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Attempts, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_statusCode(ctx context.Context, field graphql.CollectedField, obj *flaggio.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StatusCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_error(ctx context.Context, field graphql.CollectedField, obj *flaggio.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Error, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_delivered(ctx context.Context, field graphql.CollectedField, obj *flaggio.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Delivered, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_createdAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_updatedAt(ctx context.Context, field graphql.CollectedField, obj *flaggio.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDeliveryResults_deliveries(ctx context.Context, field graphql.CollectedField, obj *flaggio.WebhookDeliveryResults) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDeliveryResults",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Deliveries, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*flaggio.WebhookDelivery)
+	fc.Result = res
+	return ec.marshalNWebhookDelivery2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhookDeliveryᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDeliveryResults_total(ctx context.Context, field graphql.CollectedField, obj *flaggio.WebhookDeliveryResults) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDeliveryResults",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Total, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_fields_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputFlagFilter(ctx context.Context, obj interface{}) (flaggio.FlagFilter, error) {
+	var it flaggio.FlagFilter
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "keys":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("keys"))
+			it.Keys, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "tags":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tags"))
+			it.Tags, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "owner":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("owner"))
+			it.Owner, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "archived":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("archived"))
+			it.Archived, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "clientExposed":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientExposed"))
+			it.ClientExposed, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputFlagProposal(ctx context.Context, obj interface{}) (flaggio.FlagProposal, error) {
+	var it flaggio.FlagProposal
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "flag":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flag"))
+			it.Flag, err = ec.unmarshalOUpdateFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateFlag(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "rules":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rules"))
+			it.Rules, err = ec.unmarshalONewFlagRule2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewFlagRuleᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNewConstraint(ctx context.Context, obj interface{}) (flaggio.NewConstraint, error) {
+	var it flaggio.NewConstraint
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "property":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("property"))
+			it.Property, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "operation":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("operation"))
+			it.Operation, err = ec.unmarshalNOperation2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐOperation(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "values":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("values"))
+			it.Values, err = ec.unmarshalOAny2ᚕinterfaceᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "constraints":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("constraints"))
+			it.Constraints, err = ec.unmarshalONewConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNewDistribution(ctx context.Context, obj interface{}) (flaggio.NewDistribution, error) {
+	var it flaggio.NewDistribution
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "variantId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("variantId"))
+			it.VariantID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "percentage":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("percentage"))
+			it.Percentage, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNewExclusionGroup(ctx context.Context, obj interface{}) (flaggio.NewExclusionGroup, error) {
+	var it flaggio.NewExclusionGroup
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "allocations":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("allocations"))
+			it.Allocations, err = ec.unmarshalONewSlotAllocation2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewSlotAllocationᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNewFlag(ctx context.Context, obj interface{}) (flaggio.NewFlag, error) {
+	var it flaggio.NewFlag
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "key":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
+			it.Key, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "kind":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kind"))
+			it.Kind, err = ec.unmarshalOFlagKind2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagKind(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "tags":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tags"))
+			it.Tags, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "ownerTeam":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ownerTeam"))
+			it.OwnerTeam, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "maintainer":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maintainer"))
+			it.Maintainer, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "links":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("links"))
+			it.Links, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "expiresAt":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expiresAt"))
+			it.ExpiresAt, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "clientExposed":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientExposed"))
+			it.ClientExposed, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNewFlagRule(ctx context.Context, obj interface{}) (flaggio.NewFlagRule, error) {
+	var it flaggio.NewFlagRule
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "constraints":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("constraints"))
+			it.Constraints, err = ec.unmarshalNNewConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "distributions":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("distributions"))
+			it.Distributions, err = ec.unmarshalNNewDistribution2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewDistributionᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNewMetric(ctx context.Context, obj interface{}) (flaggio.NewMetric, error) {
+	var it flaggio.NewMetric
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "key":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
+			it.Key, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNewSegment(ctx context.Context, obj interface{}) (flaggio.NewSegment, error) {
+	var it flaggio.NewSegment
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNewSegmentRule(ctx context.Context, obj interface{}) (flaggio.NewSegmentRule, error) {
+	var it flaggio.NewSegmentRule
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "constraints":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("constraints"))
+			it.Constraints, err = ec.unmarshalNNewConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNewSlotAllocation(ctx context.Context, obj interface{}) (flaggio.NewSlotAllocation, error) {
+	var it flaggio.NewSlotAllocation
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "flagId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flagId"))
+			it.FlagID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "start":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("start"))
+			it.Start, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "end":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("end"))
+			it.End, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNewVariant(ctx context.Context, obj interface{}) (flaggio.NewVariant, error) {
+	var it flaggio.NewVariant
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "value":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			it.Value, err = ec.unmarshalNAny2interface(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNewWebhook(ctx context.Context, obj interface{}) (flaggio.NewWebhook, error) {
+	var it flaggio.NewWebhook
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "url":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
+			it.URL, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "secret":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("secret"))
+			it.Secret, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "entityTypes":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("entityTypes"))
+			it.EntityTypes, err = ec.unmarshalOEntityType2ᚕgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEntityTypeᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "flagKeys":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flagKeys"))
+			it.FlagKeys, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateExclusionGroup(ctx context.Context, obj interface{}) (flaggio.UpdateExclusionGroup, error) {
+	var it flaggio.UpdateExclusionGroup
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "allocations":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("allocations"))
+			it.Allocations, err = ec.unmarshalONewSlotAllocation2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewSlotAllocationᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateFlag(ctx context.Context, obj interface{}) (flaggio.UpdateFlag, error) {
+	var it flaggio.UpdateFlag
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "key":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
+			it.Key, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "kind":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kind"))
+			it.Kind, err = ec.unmarshalOFlagKind2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagKind(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "tags":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tags"))
+			it.Tags, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "ownerTeam":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ownerTeam"))
+			it.OwnerTeam, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "maintainer":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maintainer"))
+			it.Maintainer, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "links":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("links"))
+			it.Links, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "expiresAt":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expiresAt"))
+			it.ExpiresAt, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "clientExposed":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("clientExposed"))
+			it.ClientExposed, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "defaultVariantWhenOn":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("defaultVariantWhenOn"))
+			it.DefaultVariantWhenOn, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "defaultVariantWhenOff":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("defaultVariantWhenOff"))
+			it.DefaultVariantWhenOff, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "experiment":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("experiment"))
+			it.Experiment, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "protected":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("protected"))
+			it.Protected, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "requiredApprovals":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("requiredApprovals"))
+			it.RequiredApprovals, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateFlagRule(ctx context.Context, obj interface{}) (flaggio.UpdateFlagRule, error) {
+	var it flaggio.UpdateFlagRule
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "constraints":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("constraints"))
+			it.Constraints, err = ec.unmarshalNNewConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "distributions":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("distributions"))
+			it.Distributions, err = ec.unmarshalNNewDistribution2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewDistributionᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateMetric(ctx context.Context, obj interface{}) (flaggio.UpdateMetric, error) {
+	var it flaggio.UpdateMetric
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "key":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
+			it.Key, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateSegment(ctx context.Context, obj interface{}) (flaggio.UpdateSegment, error) {
+	var it flaggio.UpdateSegment
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateSegmentRule(ctx context.Context, obj interface{}) (flaggio.UpdateSegmentRule, error) {
+	var it flaggio.UpdateSegmentRule
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "constraints":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("constraints"))
+			it.Constraints, err = ec.unmarshalNNewConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateVariant(ctx context.Context, obj interface{}) (flaggio.UpdateVariant, error) {
+	var it flaggio.UpdateVariant
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "value":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			it.Value, err = ec.unmarshalOAny2interface(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateWebhook(ctx context.Context, obj interface{}) (flaggio.UpdateWebhook, error) {
+	var it flaggio.UpdateWebhook
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "url":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
+			it.URL, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "secret":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("secret"))
+			it.Secret, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "entityTypes":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("entityTypes"))
+			it.EntityTypes, err = ec.unmarshalOEntityType2ᚕgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEntityTypeᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "flagKeys":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flagKeys"))
+			it.FlagKeys, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+func (ec *executionContext) _Ruler(ctx context.Context, sel ast.SelectionSet, obj flaggio.Ruler) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case flaggio.FlagRule:
+		return ec._FlagRule(ctx, sel, &obj)
+	case *flaggio.FlagRule:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._FlagRule(ctx, sel, obj)
+	case flaggio.SegmentRule:
+		return ec._SegmentRule(ctx, sel, &obj)
+	case *flaggio.SegmentRule:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SegmentRule(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var approvalImplementors = []string{"Approval"}
+
+func (ec *executionContext) _Approval(ctx context.Context, sel ast.SelectionSet, obj *flaggio.Approval) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, approvalImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Approval")
+		case "user":
+			out.Values[i] = ec._Approval_user(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._Approval_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var changeRequestImplementors = []string{"ChangeRequest"}
+
+func (ec *executionContext) _ChangeRequest(ctx context.Context, sel ast.SelectionSet, obj *flaggio.ChangeRequest) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, changeRequestImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ChangeRequest")
+		case "id":
+			out.Values[i] = ec._ChangeRequest_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "entityType":
+			out.Values[i] = ec._ChangeRequest_entityType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "entityId":
+			out.Values[i] = ec._ChangeRequest_entityId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "flagVersion":
+			out.Values[i] = ec._ChangeRequest_flagVersion(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "diff":
+			out.Values[i] = ec._ChangeRequest_diff(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "status":
+			out.Values[i] = ec._ChangeRequest_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "author":
+			out.Values[i] = ec._ChangeRequest_author(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "requiredApprovals":
+			out.Values[i] = ec._ChangeRequest_requiredApprovals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "approvals":
+			out.Values[i] = ec._ChangeRequest_approvals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "rejectedBy":
+			out.Values[i] = ec._ChangeRequest_rejectedBy(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._ChangeRequest_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._ChangeRequest_updatedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var confidenceIntervalImplementors = []string{"ConfidenceInterval"}
+
+func (ec *executionContext) _ConfidenceInterval(ctx context.Context, sel ast.SelectionSet, obj *flaggio.ConfidenceInterval) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, confidenceIntervalImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ConfidenceInterval")
+		case "lower":
+			out.Values[i] = ec._ConfidenceInterval_lower(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "upper":
+			out.Values[i] = ec._ConfidenceInterval_upper(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var constraintImplementors = []string{"Constraint"}
+
+func (ec *executionContext) _Constraint(ctx context.Context, sel ast.SelectionSet, obj *flaggio.Constraint) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, constraintImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Constraint")
+		case "id":
+			out.Values[i] = ec._Constraint_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "property":
+			out.Values[i] = ec._Constraint_property(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "operation":
+			out.Values[i] = ec._Constraint_operation(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "values":
+			out.Values[i] = ec._Constraint_values(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "constraints":
+			out.Values[i] = ec._Constraint_constraints(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var distributionImplementors = []string{"Distribution"}
+
+func (ec *executionContext) _Distribution(ctx context.Context, sel ast.SelectionSet, obj *flaggio.Distribution) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, distributionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Distribution")
+		case "id":
+			out.Values[i] = ec._Distribution_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "variant":
+			out.Values[i] = ec._Distribution_variant(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "percentage":
+			out.Values[i] = ec._Distribution_percentage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var evaluationImplementors = []string{"Evaluation"}
+
+func (ec *executionContext) _Evaluation(ctx context.Context, sel ast.SelectionSet, obj *flaggio.Evaluation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, evaluationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Evaluation")
+		case "id":
+			out.Values[i] = ec._Evaluation_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "flagId":
+			out.Values[i] = ec._Evaluation_flagId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "flagKey":
+			out.Values[i] = ec._Evaluation_flagKey(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "flagVersion":
+			out.Values[i] = ec._Evaluation_flagVersion(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "value":
+			out.Values[i] = ec._Evaluation_value(ctx, field, obj)
+		case "variantId":
+			out.Values[i] = ec._Evaluation_variantId(ctx, field, obj)
+		case "reason":
+			out.Values[i] = ec._Evaluation_reason(ctx, field, obj)
+		case "ruleName":
+			out.Values[i] = ec._Evaluation_ruleName(ctx, field, obj)
+		case "stackTrace":
+			out.Values[i] = ec._Evaluation_stackTrace(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._Evaluation_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var evaluationResultsImplementors = []string{"EvaluationResults"}
+
+func (ec *executionContext) _EvaluationResults(ctx context.Context, sel ast.SelectionSet, obj *flaggio.EvaluationResults) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, evaluationResultsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EvaluationResults")
+		case "evaluations":
+			out.Values[i] = ec._EvaluationResults_evaluations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "total":
+			out.Values[i] = ec._EvaluationResults_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var exclusionGroupImplementors = []string{"ExclusionGroup"}
+
+func (ec *executionContext) _ExclusionGroup(ctx context.Context, sel ast.SelectionSet, obj *flaggio.ExclusionGroup) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, exclusionGroupImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ExclusionGroup")
+		case "id":
+			out.Values[i] = ec._ExclusionGroup_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._ExclusionGroup_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec._ExclusionGroup_description(ctx, field, obj)
+		case "allocations":
+			out.Values[i] = ec._ExclusionGroup_allocations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._ExclusionGroup_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._ExclusionGroup_updatedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var experimentResultsImplementors = []string{"ExperimentResults"}
+
+func (ec *executionContext) _ExperimentResults(ctx context.Context, sel ast.SelectionSet, obj *flaggio.ExperimentResults) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, experimentResultsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ExperimentResults")
+		case "metrics":
+			out.Values[i] = ec._ExperimentResults_metrics(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var fieldChangeImplementors = []string{"FieldChange"}
+
+func (ec *executionContext) _FieldChange(ctx context.Context, sel ast.SelectionSet, obj *flaggio.FieldChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fieldChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FieldChange")
+		case "field":
+			out.Values[i] = ec._FieldChange_field(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "oldValue":
+			out.Values[i] = ec._FieldChange_oldValue(ctx, field, obj)
+		case "newValue":
+			out.Values[i] = ec._FieldChange_newValue(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var flagImplementors = []string{"Flag"}
+
+func (ec *executionContext) _Flag(ctx context.Context, sel ast.SelectionSet, obj *flaggio.Flag) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, flagImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Flag")
+		case "id":
+			out.Values[i] = ec._Flag_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "key":
+			out.Values[i] = ec._Flag_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._Flag_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "description":
+			out.Values[i] = ec._Flag_description(ctx, field, obj)
+		case "kind":
+			out.Values[i] = ec._Flag_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "tags":
+			out.Values[i] = ec._Flag_tags(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "ownerTeam":
+			out.Values[i] = ec._Flag_ownerTeam(ctx, field, obj)
+		case "maintainer":
+			out.Values[i] = ec._Flag_maintainer(ctx, field, obj)
+		case "links":
+			out.Values[i] = ec._Flag_links(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "expiresAt":
+			out.Values[i] = ec._Flag_expiresAt(ctx, field, obj)
+		case "clientExposed":
+			out.Values[i] = ec._Flag_clientExposed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "archived":
+			out.Values[i] = ec._Flag_archived(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "archivedAt":
+			out.Values[i] = ec._Flag_archivedAt(ctx, field, obj)
+		case "enabled":
+			out.Values[i] = ec._Flag_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "variants":
+			out.Values[i] = ec._Flag_variants(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "rules":
+			out.Values[i] = ec._Flag_rules(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "defaultVariantWhenOn":
+			out.Values[i] = ec._Flag_defaultVariantWhenOn(ctx, field, obj)
+		case "defaultVariantWhenOff":
+			out.Values[i] = ec._Flag_defaultVariantWhenOff(ctx, field, obj)
+		case "experiment":
+			out.Values[i] = ec._Flag_experiment(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "metrics":
+			out.Values[i] = ec._Flag_metrics(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "experimentResults":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Flag_experimentResults(ctx, field, obj)
+				return res
+			})
+		case "usage":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Flag_usage(ctx, field, obj)
+				return res
+			})
+		case "protected":
+			out.Values[i] = ec._Flag_protected(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "requiredApprovals":
+			out.Values[i] = ec._Flag_requiredApprovals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "changeRequests":
+			out.Values[i] = ec._Flag_changeRequests(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "createdAt":
+			out.Values[i] = ec._Flag_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Flag_updatedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var flagResultsImplementors = []string{"FlagResults"}
+
+func (ec *executionContext) _FlagResults(ctx context.Context, sel ast.SelectionSet, obj *flaggio.FlagResults) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, flagResultsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FlagResults")
+		case "flags":
+			out.Values[i] = ec._FlagResults_flags(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "total":
+			out.Values[i] = ec._FlagResults_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var flagRuleImplementors = []string{"FlagRule", "Ruler"}
+
+func (ec *executionContext) _FlagRule(ctx context.Context, sel ast.SelectionSet, obj *flaggio.FlagRule) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, flagRuleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FlagRule")
+		case "id":
+			out.Values[i] = ec._FlagRule_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._FlagRule_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec._FlagRule_description(ctx, field, obj)
+		case "enabled":
+			out.Values[i] = ec._FlagRule_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "constraints":
+			out.Values[i] = ec._FlagRule_constraints(ctx, field, obj)
+		case "distributions":
+			out.Values[i] = ec._FlagRule_distributions(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var flagSimulationImplementors = []string{"FlagSimulation"}
+
+func (ec *executionContext) _FlagSimulation(ctx context.Context, sel ast.SelectionSet, obj *flaggio.FlagSimulation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, flagSimulationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FlagSimulation")
+		case "users":
+			out.Values[i] = ec._FlagSimulation_users(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "totalUsers":
+			out.Values[i] = ec._FlagSimulation_totalUsers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "affectedUsers":
+			out.Values[i] = ec._FlagSimulation_affectedUsers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "sampleUserIds":
+			out.Values[i] = ec._FlagSimulation_sampleUserIds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var flagUsageImplementors = []string{"FlagUsage"}
+
+func (ec *executionContext) _FlagUsage(ctx context.Context, sel ast.SelectionSet, obj *flaggio.FlagUsage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, flagUsageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FlagUsage")
+		case "evaluations":
+			out.Values[i] = ec._FlagUsage_evaluations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "firstEvaluatedAt":
+			out.Values[i] = ec._FlagUsage_firstEvaluatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lastEvaluatedAt":
+			out.Values[i] = ec._FlagUsage_lastEvaluatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "variants":
+			out.Values[i] = ec._FlagUsage_variants(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var lintIssueImplementors = []string{"LintIssue"}
+
+func (ec *executionContext) _LintIssue(ctx context.Context, sel ast.SelectionSet, obj *flaggio.LintIssue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, lintIssueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LintIssue")
+		case "severity":
+			out.Values[i] = ec._LintIssue_severity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "code":
+			out.Values[i] = ec._LintIssue_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "message":
+			out.Values[i] = ec._LintIssue_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "ruleId":
+			out.Values[i] = ec._LintIssue_ruleId(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var metricImplementors = []string{"Metric"}
+
+func (ec *executionContext) _Metric(ctx context.Context, sel ast.SelectionSet, obj *flaggio.Metric) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, metricImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Metric")
+		case "id":
+			out.Values[i] = ec._Metric_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "key":
+			out.Values[i] = ec._Metric_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._Metric_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec._Metric_description(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var metricResultsImplementors = []string{"MetricResults"}
+
+func (ec *executionContext) _MetricResults(ctx context.Context, sel ast.SelectionSet, obj *flaggio.MetricResults) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, metricResultsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MetricResults")
+		case "metric":
+			out.Values[i] = ec._MetricResults_metric(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "variants":
+			out.Values[i] = ec._MetricResults_variants(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "ping":
+			out.Values[i] = ec._Mutation_ping(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createFlag":
+			out.Values[i] = ec._Mutation_createFlag(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updateFlag":
+			out.Values[i] = ec._Mutation_updateFlag(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "cloneFlag":
+			out.Values[i] = ec._Mutation_cloneFlag(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "archiveFlag":
+			out.Values[i] = ec._Mutation_archiveFlag(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "restoreFlag":
+			out.Values[i] = ec._Mutation_restoreFlag(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deleteFlag":
+			out.Values[i] = ec._Mutation_deleteFlag(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createVariant":
+			out.Values[i] = ec._Mutation_createVariant(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updateVariant":
+			out.Values[i] = ec._Mutation_updateVariant(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deleteVariant":
+			out.Values[i] = ec._Mutation_deleteVariant(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createMetric":
+			out.Values[i] = ec._Mutation_createMetric(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updateMetric":
+			out.Values[i] = ec._Mutation_updateMetric(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deleteMetric":
+			out.Values[i] = ec._Mutation_deleteMetric(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "approveChangeRequest":
+			out.Values[i] = ec._Mutation_approveChangeRequest(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "rejectChangeRequest":
+			out.Values[i] = ec._Mutation_rejectChangeRequest(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createFlagRule":
+			out.Values[i] = ec._Mutation_createFlagRule(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updateFlagRule":
+			out.Values[i] = ec._Mutation_updateFlagRule(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deleteFlagRule":
+			out.Values[i] = ec._Mutation_deleteFlagRule(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "moveFlagRule":
+			out.Values[i] = ec._Mutation_moveFlagRule(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createSegmentRule":
+			out.Values[i] = ec._Mutation_createSegmentRule(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updateSegmentRule":
+			out.Values[i] = ec._Mutation_updateSegmentRule(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deleteSegmentRule":
+			out.Values[i] = ec._Mutation_deleteSegmentRule(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "moveSegmentRule":
+			out.Values[i] = ec._Mutation_moveSegmentRule(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createSegment":
+			out.Values[i] = ec._Mutation_createSegment(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updateSegment":
+			out.Values[i] = ec._Mutation_updateSegment(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deleteSegment":
+			out.Values[i] = ec._Mutation_deleteSegment(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createExclusionGroup":
+			out.Values[i] = ec._Mutation_createExclusionGroup(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updateExclusionGroup":
+			out.Values[i] = ec._Mutation_updateExclusionGroup(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deleteExclusionGroup":
+			out.Values[i] = ec._Mutation_deleteExclusionGroup(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deleteUser":
+			out.Values[i] = ec._Mutation_deleteUser(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deleteEvaluation":
+			out.Values[i] = ec._Mutation_deleteEvaluation(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createWebhook":
+			out.Values[i] = ec._Mutation_createWebhook(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updateWebhook":
+			out.Values[i] = ec._Mutation_updateWebhook(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deleteWebhook":
+			out.Values[i] = ec._Mutation_deleteWebhook(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "ping":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_ping(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "flags":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_flags(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "flag":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_flag(ctx, field)
+				return res
+			})
+		case "staleFlags":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_staleFlags(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "flagLint":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_flagLint(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "simulateFlag":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_simulateFlag(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "evaluate":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_evaluate(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "segments":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_segments(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "segment":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_segment(ctx, field)
+				return res
+			})
+		case "exclusionGroups":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_exclusionGroups(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "exclusionGroup":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_exclusionGroup(ctx, field)
+				return res
+			})
+		case "users":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_users(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "user":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_user(ctx, field)
+				return res
+			})
+		case "webhooks":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_webhooks(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "webhook":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_webhook(ctx, field)
+				return res
+			})
+		case "__type":
+			out.Values[i] = ec._Query___type(ctx, field)
+		case "__schema":
+			out.Values[i] = ec._Query___schema(ctx, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var segmentImplementors = []string{"Segment"}
+
+func (ec *executionContext) _Segment(ctx context.Context, sel ast.SelectionSet, obj *flaggio.Segment) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, segmentImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Segment")
+		case "id":
+			out.Values[i] = ec._Segment_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._Segment_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "description":
+			out.Values[i] = ec._Segment_description(ctx, field, obj)
+		case "rules":
+			out.Values[i] = ec._Segment_rules(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "usedBy":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Segment_usedBy(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "createdAt":
+			out.Values[i] = ec._Segment_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Segment_updatedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var segmentRuleImplementors = []string{"SegmentRule", "Ruler"}
+
+func (ec *executionContext) _SegmentRule(ctx context.Context, sel ast.SelectionSet, obj *flaggio.SegmentRule) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, segmentRuleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SegmentRule")
+		case "id":
+			out.Values[i] = ec._SegmentRule_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._SegmentRule_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec._SegmentRule_description(ctx, field, obj)
+		case "enabled":
+			out.Values[i] = ec._SegmentRule_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "constraints":
+			out.Values[i] = ec._SegmentRule_constraints(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var segmentUsageImplementors = []string{"SegmentUsage"}
+
+func (ec *executionContext) _SegmentUsage(ctx context.Context, sel ast.SelectionSet, obj *flaggio.SegmentUsage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, segmentUsageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SegmentUsage")
+		case "flag":
+			out.Values[i] = ec._SegmentUsage_flag(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "rules":
+			out.Values[i] = ec._SegmentUsage_rules(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var slotAllocationImplementors = []string{"SlotAllocation"}
+
+func (ec *executionContext) _SlotAllocation(ctx context.Context, sel ast.SelectionSet, obj *flaggio.SlotAllocation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, slotAllocationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SlotAllocation")
+		case "flagId":
+			out.Values[i] = ec._SlotAllocation_flagId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "start":
+			out.Values[i] = ec._SlotAllocation_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "end":
+			out.Values[i] = ec._SlotAllocation_end(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var stackTraceImplementors = []string{"StackTrace"}
+
+func (ec *executionContext) _StackTrace(ctx context.Context, sel ast.SelectionSet, obj *flaggio.StackTrace) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, stackTraceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StackTrace")
+		case "type":
+			out.Values[i] = ec._StackTrace_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "id":
+			out.Values[i] = ec._StackTrace_id(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._StackTrace_name(ctx, field, obj)
+		case "answer":
+			out.Values[i] = ec._StackTrace_answer(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var staleFlagImplementors = []string{"StaleFlag"}
+
+func (ec *executionContext) _StaleFlag(ctx context.Context, sel ast.SelectionSet, obj *flaggio.StaleFlag) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, staleFlagImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StaleFlag")
+		case "flag":
+			out.Values[i] = ec._StaleFlag_flag(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "reasons":
+			out.Values[i] = ec._StaleFlag_reasons(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "evaluations":
+			out.Values[i] = ec._StaleFlag_evaluations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lastEvaluatedAt":
+			out.Values[i] = ec._StaleFlag_lastEvaluatedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var userImplementors = []string{"User"}
+
+func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *flaggio.User) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, userImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("User")
+		case "id":
+			out.Values[i] = ec._User_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "context":
+			out.Values[i] = ec._User_context(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "updatedAt":
+			out.Values[i] = ec._User_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "evaluations":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._User_evaluations(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var userResultsImplementors = []string{"UserResults"}
+
+func (ec *executionContext) _UserResults(ctx context.Context, sel ast.SelectionSet, obj *flaggio.UserResults) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, userResultsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UserResults")
+		case "users":
+			out.Values[i] = ec._UserResults_users(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "total":
+			out.Values[i] = ec._UserResults_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var variantImplementors = []string{"Variant"}
+
+func (ec *executionContext) _Variant(ctx context.Context, sel ast.SelectionSet, obj *flaggio.Variant) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, variantImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Variant")
+		case "id":
+			out.Values[i] = ec._Variant_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec._Variant_description(ctx, field, obj)
+		case "value":
+			out.Values[i] = ec._Variant_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var variantResultsImplementors = []string{"VariantResults"}
+
+func (ec *executionContext) _VariantResults(ctx context.Context, sel ast.SelectionSet, obj *flaggio.VariantResults) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, variantResultsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("VariantResults")
+		case "variant":
+			out.Values[i] = ec._VariantResults_variant(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "exposures":
+			out.Values[i] = ec._VariantResults_exposures(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "conversions":
+			out.Values[i] = ec._VariantResults_conversions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "conversionRate":
+			out.Values[i] = ec._VariantResults_conversionRate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "confidenceInterval":
+			out.Values[i] = ec._VariantResults_confidenceInterval(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var variantUsageImplementors = []string{"VariantUsage"}
+
+func (ec *executionContext) _VariantUsage(ctx context.Context, sel ast.SelectionSet, obj *flaggio.VariantUsage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, variantUsageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "ping":
-			out.Values[i] = ec._Mutation_ping(ctx, field)
+			out.Values[i] = graphql.MarshalString("VariantUsage")
+		case "variantId":
+			out.Values[i] = ec._VariantUsage_variantId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lastServedAt":
+			out.Values[i] = ec._VariantUsage_lastServedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var webhookImplementors = []string{"Webhook"}
+
+func (ec *executionContext) _Webhook(ctx context.Context, sel ast.SelectionSet, obj *flaggio.Webhook) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, webhookImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Webhook")
+		case "id":
+			out.Values[i] = ec._Webhook_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "url":
+			out.Values[i] = ec._Webhook_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "entityTypes":
+			out.Values[i] = ec._Webhook_entityTypes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "flagKeys":
+			out.Values[i] = ec._Webhook_flagKeys(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "enabled":
+			out.Values[i] = ec._Webhook_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "deliveries":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Webhook_deliveries(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "createdAt":
+			out.Values[i] = ec._Webhook_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Webhook_updatedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var webhookDeliveryImplementors = []string{"WebhookDelivery"}
+
+func (ec *executionContext) _WebhookDelivery(ctx context.Context, sel ast.SelectionSet, obj *flaggio.WebhookDelivery) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, webhookDeliveryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("WebhookDelivery")
+		case "id":
+			out.Values[i] = ec._WebhookDelivery_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "webhookId":
+			out.Values[i] = ec._WebhookDelivery_webhookId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "entityType":
+			out.Values[i] = ec._WebhookDelivery_entityType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "entityId":
+			out.Values[i] = ec._WebhookDelivery_entityId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "action":
+			out.Values[i] = ec._WebhookDelivery_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "payload":
+			out.Values[i] = ec._WebhookDelivery_payload(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "attempts":
+			out.Values[i] = ec._WebhookDelivery_attempts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "statusCode":
+			out.Values[i] = ec._WebhookDelivery_statusCode(ctx, field, obj)
+		case "error":
+			out.Values[i] = ec._WebhookDelivery_error(ctx, field, obj)
+		case "delivered":
+			out.Values[i] = ec._WebhookDelivery_delivered(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._WebhookDelivery_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._WebhookDelivery_updatedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var webhookDeliveryResultsImplementors = []string{"WebhookDeliveryResults"}
+
+func (ec *executionContext) _WebhookDeliveryResults(ctx context.Context, sel ast.SelectionSet, obj *flaggio.WebhookDeliveryResults) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, webhookDeliveryResultsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("WebhookDeliveryResults")
+		case "deliveries":
+			out.Values[i] = ec._WebhookDeliveryResults_deliveries(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "total":
+			out.Values[i] = ec._WebhookDeliveryResults_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __DirectiveImplementors = []string{"__Directive"}
+
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __EnumValueImplementors = []string{"__EnumValue"}
+
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __FieldImplementors = []string{"__Field"}
+
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "createFlag":
-			out.Values[i] = ec._Mutation_createFlag(ctx, field)
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "updateFlag":
-			out.Values[i] = ec._Mutation_updateFlag(ctx, field)
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "deleteFlag":
-			out.Values[i] = ec._Mutation_deleteFlag(ctx, field)
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "createVariant":
-			out.Values[i] = ec._Mutation_createVariant(ctx, field)
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __InputValueImplementors = []string{"__InputValue"}
+
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "updateVariant":
-			out.Values[i] = ec._Mutation_updateVariant(ctx, field)
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "deleteVariant":
-			out.Values[i] = ec._Mutation_deleteVariant(ctx, field)
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __SchemaImplementors = []string{"__Schema"}
+
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "createFlagRule":
-			out.Values[i] = ec._Mutation_createFlagRule(ctx, field)
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "updateFlagRule":
-			out.Values[i] = ec._Mutation_updateFlagRule(ctx, field)
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "deleteFlagRule":
-			out.Values[i] = ec._Mutation_deleteFlagRule(ctx, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __TypeImplementors = []string{"__Type"}
+
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "createSegmentRule":
-			out.Values[i] = ec._Mutation_createSegmentRule(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNAny2interface(ctx context.Context, v interface{}) (interface{}, error) {
+	res, err := graphql.UnmarshalAny(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAny2interface(ctx context.Context, sel ast.SelectionSet, v interface{}) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := graphql.MarshalAny(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNAny2ᚕinterface(ctx context.Context, v interface{}) ([]interface{}, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]interface{}, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalOAny2interface(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNAny2ᚕinterface(ctx context.Context, sel ast.SelectionSet, v []interface{}) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalOAny2interface(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNApproval2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐApprovalᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.Approval) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNApproval2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐApproval(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNApproval2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐApproval(ctx context.Context, sel ast.SelectionSet, v *flaggio.Approval) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Approval(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNChangeAction2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐChangeAction(ctx context.Context, v interface{}) (flaggio.ChangeAction, error) {
+	var res flaggio.ChangeAction
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNChangeAction2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐChangeAction(ctx context.Context, sel ast.SelectionSet, v flaggio.ChangeAction) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNChangeRequest2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐChangeRequest(ctx context.Context, sel ast.SelectionSet, v flaggio.ChangeRequest) graphql.Marshaler {
+	return ec._ChangeRequest(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNChangeRequest2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐChangeRequestᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.ChangeRequest) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "updateSegmentRule":
-			out.Values[i] = ec._Mutation_updateSegmentRule(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
+			ret[i] = ec.marshalNChangeRequest2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐChangeRequest(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNChangeRequest2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐChangeRequest(ctx context.Context, sel ast.SelectionSet, v *flaggio.ChangeRequest) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ChangeRequest(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNChangeRequestStatus2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐChangeRequestStatus(ctx context.Context, v interface{}) (flaggio.ChangeRequestStatus, error) {
+	var res flaggio.ChangeRequestStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNChangeRequestStatus2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐChangeRequestStatus(ctx context.Context, sel ast.SelectionSet, v flaggio.ChangeRequestStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNConfidenceInterval2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐConfidenceInterval(ctx context.Context, sel ast.SelectionSet, v *flaggio.ConfidenceInterval) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ConfidenceInterval(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNConstraint2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐConstraint(ctx context.Context, sel ast.SelectionSet, v *flaggio.Constraint) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Constraint(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNDistribution2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐDistribution(ctx context.Context, sel ast.SelectionSet, v *flaggio.Distribution) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Distribution(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNEntityType2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEntityType(ctx context.Context, v interface{}) (flaggio.EntityType, error) {
+	var res flaggio.EntityType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNEntityType2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEntityType(ctx context.Context, sel ast.SelectionSet, v flaggio.EntityType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNEntityType2ᚕgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEntityTypeᚄ(ctx context.Context, v interface{}) ([]flaggio.EntityType, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]flaggio.EntityType, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNEntityType2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEntityType(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNEntityType2ᚕgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEntityTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []flaggio.EntityType) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deleteSegmentRule":
-			out.Values[i] = ec._Mutation_deleteSegmentRule(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
+			ret[i] = ec.marshalNEntityType2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEntityType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNEvaluation2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluation(ctx context.Context, sel ast.SelectionSet, v flaggio.Evaluation) graphql.Marshaler {
+	return ec._Evaluation(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNEvaluation2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluationᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.Evaluation) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "createSegment":
-			out.Values[i] = ec._Mutation_createSegment(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
+			ret[i] = ec.marshalNEvaluation2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluation(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNEvaluation2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluation(ctx context.Context, sel ast.SelectionSet, v *flaggio.Evaluation) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Evaluation(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNEvaluationResults2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluationResults(ctx context.Context, sel ast.SelectionSet, v flaggio.EvaluationResults) graphql.Marshaler {
+	return ec._EvaluationResults(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNEvaluationResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluationResults(ctx context.Context, sel ast.SelectionSet, v *flaggio.EvaluationResults) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._EvaluationResults(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNExclusionGroup2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐExclusionGroup(ctx context.Context, sel ast.SelectionSet, v flaggio.ExclusionGroup) graphql.Marshaler {
+	return ec._ExclusionGroup(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNExclusionGroup2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐExclusionGroupᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.ExclusionGroup) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "updateSegment":
-			out.Values[i] = ec._Mutation_updateSegment(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
+			ret[i] = ec.marshalNExclusionGroup2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐExclusionGroup(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNExclusionGroup2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐExclusionGroup(ctx context.Context, sel ast.SelectionSet, v *flaggio.ExclusionGroup) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ExclusionGroup(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNFieldChange2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFieldChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.FieldChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deleteSegment":
-			out.Values[i] = ec._Mutation_deleteSegment(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
+			ret[i] = ec.marshalNFieldChange2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFieldChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNFieldChange2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFieldChange(ctx context.Context, sel ast.SelectionSet, v *flaggio.FieldChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._FieldChange(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNFlag2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx context.Context, sel ast.SelectionSet, v flaggio.Flag) graphql.Marshaler {
+	return ec._Flag(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNFlag2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.Flag) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deleteUser":
-			out.Values[i] = ec._Mutation_deleteUser(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
+			ret[i] = ec.marshalNFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx context.Context, sel ast.SelectionSet, v *flaggio.Flag) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Flag(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNFlagKind2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagKind(ctx context.Context, v interface{}) (flaggio.FlagKind, error) {
+	var res flaggio.FlagKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFlagKind2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagKind(ctx context.Context, sel ast.SelectionSet, v flaggio.FlagKind) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNFlagProposal2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagProposal(ctx context.Context, v interface{}) (flaggio.FlagProposal, error) {
+	res, err := ec.unmarshalInputFlagProposal(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFlagResults2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagResults(ctx context.Context, sel ast.SelectionSet, v flaggio.FlagResults) graphql.Marshaler {
+	return ec._FlagResults(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNFlagResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagResults(ctx context.Context, sel ast.SelectionSet, v *flaggio.FlagResults) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._FlagResults(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNFlagRule2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagRule(ctx context.Context, sel ast.SelectionSet, v flaggio.FlagRule) graphql.Marshaler {
+	return ec._FlagRule(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNFlagRule2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagRuleᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.FlagRule) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deleteEvaluation":
-			out.Values[i] = ec._Mutation_deleteEvaluation(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
+			ret[i] = ec.marshalNFlagRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagRule(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNFlagRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagRule(ctx context.Context, sel ast.SelectionSet, v *flaggio.FlagRule) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._FlagRule(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNFlagSimulation2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagSimulation(ctx context.Context, sel ast.SelectionSet, v flaggio.FlagSimulation) graphql.Marshaler {
+	return ec._FlagSimulation(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNFlagSimulation2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagSimulation(ctx context.Context, sel ast.SelectionSet, v *flaggio.FlagSimulation) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._FlagSimulation(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	res, err := graphql.UnmarshalFloat(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloat(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNID2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNID2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNID2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNID2string(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNLintCode2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐLintCode(ctx context.Context, v interface{}) (flaggio.LintCode, error) {
+	var res flaggio.LintCode
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLintCode2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐLintCode(ctx context.Context, sel ast.SelectionSet, v flaggio.LintCode) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNLintIssue2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐLintIssueᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.LintIssue) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNLintIssue2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐLintIssue(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNLintIssue2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐLintIssue(ctx context.Context, sel ast.SelectionSet, v *flaggio.LintIssue) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._LintIssue(ctx, sel, v)
 }
 
-var queryImplementors = []string{"Query"}
+func (ec *executionContext) unmarshalNLintSeverity2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐLintSeverity(ctx context.Context, v interface{}) (flaggio.LintSeverity, error) {
+	var res flaggio.LintSeverity
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+func (ec *executionContext) marshalNLintSeverity2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐLintSeverity(ctx context.Context, sel ast.SelectionSet, v flaggio.LintSeverity) graphql.Marshaler {
+	return v
+}
 
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+func (ec *executionContext) unmarshalNMap2map(ctx context.Context, v interface{}) (map[string]interface{}, error) {
+	res, err := graphql.UnmarshalMap(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "ping":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_ping(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "flags":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_flags(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "flag":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_flag(ctx, field)
-				return res
-			})
-		case "segments":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_segments(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+func (ec *executionContext) marshalNMap2map(ctx context.Context, sel ast.SelectionSet, v map[string]interface{}) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := graphql.MarshalMap(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNMetric2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐMetric(ctx context.Context, sel ast.SelectionSet, v flaggio.Metric) graphql.Marshaler {
+	return ec._Metric(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNMetric2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐMetricᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.Metric) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
-			})
-		case "segment":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_segment(ctx, field)
-				return res
-			})
-		case "users":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_users(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNMetric2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐMetric(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNMetric2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐMetric(ctx context.Context, sel ast.SelectionSet, v *flaggio.Metric) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Metric(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNMetricResults2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐMetricResultsᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.MetricResults) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
-			})
-		case "user":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_user(ctx, field)
-				return res
-			})
-		case "__type":
-			out.Values[i] = ec._Query___type(ctx, field)
-		case "__schema":
-			out.Values[i] = ec._Query___schema(ctx, field)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNMetricResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐMetricResults(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNMetricResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐMetricResults(ctx context.Context, sel ast.SelectionSet, v *flaggio.MetricResults) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._MetricResults(ctx, sel, v)
 }
 
-var segmentImplementors = []string{"Segment"}
-
-func (ec *executionContext) _Segment(ctx context.Context, sel ast.SelectionSet, obj *flaggio.Segment) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, segmentImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Segment")
-		case "id":
-			out.Values[i] = ec._Segment_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			out.Values[i] = ec._Segment_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec._Segment_description(ctx, field, obj)
-		case "rules":
-			out.Values[i] = ec._Segment_rules(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "createdAt":
-			out.Values[i] = ec._Segment_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "updatedAt":
-			out.Values[i] = ec._Segment_updatedAt(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNNewConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraintᚄ(ctx context.Context, v interface{}) ([]*flaggio.NewConstraint, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
+	var err error
+	res := make([]*flaggio.NewConstraint, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNNewConstraint2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraint(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
-	return out
+	return res, nil
 }
 
-var segmentRuleImplementors = []string{"SegmentRule", "Ruler"}
-
-func (ec *executionContext) _SegmentRule(ctx context.Context, sel ast.SelectionSet, obj *flaggio.SegmentRule) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, segmentRuleImplementors)
+func (ec *executionContext) unmarshalNNewConstraint2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraint(ctx context.Context, v interface{}) (*flaggio.NewConstraint, error) {
+	res, err := ec.unmarshalInputNewConstraint(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("SegmentRule")
-		case "id":
-			out.Values[i] = ec._SegmentRule_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "constraints":
-			out.Values[i] = ec._SegmentRule_constraints(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNNewDistribution2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewDistributionᚄ(ctx context.Context, v interface{}) ([]*flaggio.NewDistribution, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
+	var err error
+	res := make([]*flaggio.NewDistribution, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNNewDistribution2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewDistribution(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
-	return out
+	return res, nil
 }
 
-var userImplementors = []string{"User"}
+func (ec *executionContext) unmarshalNNewDistribution2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewDistribution(ctx context.Context, v interface{}) (*flaggio.NewDistribution, error) {
+	res, err := ec.unmarshalInputNewDistribution(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *flaggio.User) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, userImplementors)
+func (ec *executionContext) unmarshalNNewExclusionGroup2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewExclusionGroup(ctx context.Context, v interface{}) (flaggio.NewExclusionGroup, error) {
+	res, err := ec.unmarshalInputNewExclusionGroup(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("User")
-		case "id":
-			out.Values[i] = ec._User_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "context":
-			out.Values[i] = ec._User_context(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "updatedAt":
-			out.Values[i] = ec._User_updatedAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "evaluations":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._User_evaluations(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+func (ec *executionContext) unmarshalNNewFlag2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewFlag(ctx context.Context, v interface{}) (flaggio.NewFlag, error) {
+	res, err := ec.unmarshalInputNewFlag(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var userResultsImplementors = []string{"UserResults"}
+func (ec *executionContext) unmarshalNNewFlagRule2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewFlagRule(ctx context.Context, v interface{}) (flaggio.NewFlagRule, error) {
+	res, err := ec.unmarshalInputNewFlagRule(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _UserResults(ctx context.Context, sel ast.SelectionSet, obj *flaggio.UserResults) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, userResultsImplementors)
+func (ec *executionContext) unmarshalNNewFlagRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewFlagRule(ctx context.Context, v interface{}) (*flaggio.NewFlagRule, error) {
+	res, err := ec.unmarshalInputNewFlagRule(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("UserResults")
-		case "users":
-			out.Values[i] = ec._UserResults_users(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "total":
-			out.Values[i] = ec._UserResults_total(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+func (ec *executionContext) unmarshalNNewMetric2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewMetric(ctx context.Context, v interface{}) (flaggio.NewMetric, error) {
+	res, err := ec.unmarshalInputNewMetric(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var variantImplementors = []string{"Variant"}
+func (ec *executionContext) unmarshalNNewSegment2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewSegment(ctx context.Context, v interface{}) (flaggio.NewSegment, error) {
+	res, err := ec.unmarshalInputNewSegment(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _Variant(ctx context.Context, sel ast.SelectionSet, obj *flaggio.Variant) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, variantImplementors)
+func (ec *executionContext) unmarshalNNewSegmentRule2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewSegmentRule(ctx context.Context, v interface{}) (flaggio.NewSegmentRule, error) {
+	res, err := ec.unmarshalInputNewSegmentRule(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Variant")
-		case "id":
-			out.Values[i] = ec._Variant_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec._Variant_description(ctx, field, obj)
-		case "value":
-			out.Values[i] = ec._Variant_value(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+func (ec *executionContext) unmarshalNNewSlotAllocation2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewSlotAllocation(ctx context.Context, v interface{}) (*flaggio.NewSlotAllocation, error) {
+	res, err := ec.unmarshalInputNewSlotAllocation(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNNewVariant2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewVariant(ctx context.Context, v interface{}) (flaggio.NewVariant, error) {
+	res, err := ec.unmarshalInputNewVariant(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNNewWebhook2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewWebhook(ctx context.Context, v interface{}) (flaggio.NewWebhook, error) {
+	res, err := ec.unmarshalInputNewWebhook(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
+func (ec *executionContext) unmarshalNOperation2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐOperation(ctx context.Context, v interface{}) (flaggio.Operation, error) {
+	var res flaggio.Operation
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) marshalNOperation2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐOperation(ctx context.Context, sel ast.SelectionSet, v flaggio.Operation) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNSegment2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegment(ctx context.Context, sel ast.SelectionSet, v flaggio.Segment) graphql.Marshaler {
+	return ec._Segment(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSegment2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.Segment) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNSegment2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegment(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	wg.Wait()
+	return ret
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
-
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNSegment2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegment(ctx context.Context, sel ast.SelectionSet, v *flaggio.Segment) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._Segment(ctx, sel, v)
 }
 
-var __FieldImplementors = []string{"__Field"}
-
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) marshalNSegmentRule2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentRule(ctx context.Context, sel ast.SelectionSet, v flaggio.SegmentRule) graphql.Marshaler {
+	return ec._SegmentRule(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNSegmentRule2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentRuleᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.SegmentRule) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNSegmentRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentRule(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNSegmentRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentRule(ctx context.Context, sel ast.SelectionSet, v *flaggio.SegmentRule) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._SegmentRule(ctx, sel, v)
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
-
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNSegmentUsage2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentUsageᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.SegmentUsage) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNSegmentUsage2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentUsage(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNSegmentUsage2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentUsage(ctx context.Context, sel ast.SelectionSet, v *flaggio.SegmentUsage) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._SegmentUsage(ctx, sel, v)
 }
 
-var __SchemaImplementors = []string{"__Schema"}
-
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNSlotAllocation2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSlotAllocationᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.SlotAllocation) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNSlotAllocation2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSlotAllocation(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNSlotAllocation2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSlotAllocation(ctx context.Context, sel ast.SelectionSet, v *flaggio.SlotAllocation) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._SlotAllocation(ctx, sel, v)
 }
 
-var __TypeImplementors = []string{"__Type"}
-
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNStackTrace2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐStackTrace(ctx context.Context, sel ast.SelectionSet, v *flaggio.StackTrace) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._StackTrace(ctx, sel, v)
 }
 
-// endregion **************************** object.gotpl ****************************
-
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) marshalNStaleFlag2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐStaleFlagᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.StaleFlag) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNStaleFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐStaleFlag(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) unmarshalNAny2interface(ctx context.Context, v interface{}) (interface{}, error) {
-	res, err := graphql.UnmarshalAny(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	}
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) marshalNAny2interface(ctx context.Context, sel ast.SelectionSet, v interface{}) graphql.Marshaler {
+func (ec *executionContext) marshalNStaleFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐStaleFlag(ctx context.Context, sel ast.SelectionSet, v *flaggio.StaleFlag) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	res := graphql.MarshalAny(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-	}
-	return res
+	return ec._StaleFlag(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNAny2ᚕinterface(ctx context.Context, v interface{}) ([]interface{}, error) {
+func (ec *executionContext) unmarshalNStaleReason2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐStaleReason(ctx context.Context, v interface{}) (flaggio.StaleReason, error) {
+	var res flaggio.StaleReason
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNStaleReason2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐStaleReason(ctx context.Context, sel ast.SelectionSet, v flaggio.StaleReason) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNStaleReason2ᚕgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐStaleReasonᚄ(ctx context.Context, v interface{}) ([]flaggio.StaleReason, error) {
 	var vSlice []interface{}
 	if v != nil {
 		if tmp1, ok := v.([]interface{}); ok {
@@ -6964,10 +17024,10 @@ func (ec *executionContext) unmarshalNAny2ᚕinterface(ctx context.Context, v in
 		}
 	}
 	var err error
-	res := make([]interface{}, len(vSlice))
+	res := make([]flaggio.StaleReason, len(vSlice))
 	for i := range vSlice {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalOAny2interface(ctx, vSlice[i])
+		res[i], err = ec.unmarshalNStaleReason2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐStaleReason(ctx, vSlice[i])
 		if err != nil {
 			return nil, err
 		}
@@ -6975,16 +17035,59 @@ func (ec *executionContext) unmarshalNAny2ᚕinterface(ctx context.Context, v in
 	return res, nil
 }
 
-func (ec *executionContext) marshalNAny2ᚕinterface(ctx context.Context, sel ast.SelectionSet, v []interface{}) graphql.Marshaler {
+func (ec *executionContext) marshalNStaleReason2ᚕgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐStaleReasonᚄ(ctx context.Context, sel ast.SelectionSet, v []flaggio.StaleReason) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
-	for i := range v {
-		ret[i] = ec.marshalOAny2interface(ctx, sel, v[i])
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNStaleReason2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐStaleReason(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
+	}
+	wg.Wait()
 	return ret
 }
 
-func (ec *executionContext) unmarshalNAny2ᚕinterfaceᚄ(ctx context.Context, v interface{}) ([]interface{}, error) {
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
 	var vSlice []interface{}
 	if v != nil {
 		if tmp1, ok := v.([]interface{}); ok {
@@ -6994,10 +17097,10 @@ func (ec *executionContext) unmarshalNAny2ᚕinterfaceᚄ(ctx context.Context, v
 		}
 	}
 	var err error
-	res := make([]interface{}, len(vSlice))
+	res := make([]string, len(vSlice))
 	for i := range vSlice {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNAny2interface(ctx, vSlice[i])
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
 		if err != nil {
 			return nil, err
 		}
@@ -7005,22 +17108,22 @@ func (ec *executionContext) unmarshalNAny2ᚕinterfaceᚄ(ctx context.Context, v
 	return res, nil
 }
 
-func (ec *executionContext) marshalNAny2ᚕinterfaceᚄ(ctx context.Context, sel ast.SelectionSet, v []interface{}) graphql.Marshaler {
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	for i := range v {
-		ret[i] = ec.marshalNAny2interface(ctx, sel, v[i])
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
 	}
 
 	return ret
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
+func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
+	res, err := graphql.UnmarshalTime(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
+func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+	res := graphql.MarshalTime(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
@@ -7029,27 +17132,47 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
-func (ec *executionContext) marshalNConstraint2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐConstraint(ctx context.Context, sel ast.SelectionSet, v *flaggio.Constraint) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._Constraint(ctx, sel, v)
+func (ec *executionContext) unmarshalNUpdateExclusionGroup2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateExclusionGroup(ctx context.Context, v interface{}) (flaggio.UpdateExclusionGroup, error) {
+	res, err := ec.unmarshalInputUpdateExclusionGroup(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNUpdateFlag2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateFlag(ctx context.Context, v interface{}) (flaggio.UpdateFlag, error) {
+	res, err := ec.unmarshalInputUpdateFlag(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNUpdateFlagRule2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateFlagRule(ctx context.Context, v interface{}) (flaggio.UpdateFlagRule, error) {
+	res, err := ec.unmarshalInputUpdateFlagRule(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNUpdateMetric2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateMetric(ctx context.Context, v interface{}) (flaggio.UpdateMetric, error) {
+	res, err := ec.unmarshalInputUpdateMetric(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNUpdateSegment2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateSegment(ctx context.Context, v interface{}) (flaggio.UpdateSegment, error) {
+	res, err := ec.unmarshalInputUpdateSegment(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNDistribution2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐDistribution(ctx context.Context, sel ast.SelectionSet, v *flaggio.Distribution) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._Distribution(ctx, sel, v)
+func (ec *executionContext) unmarshalNUpdateSegmentRule2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateSegmentRule(ctx context.Context, v interface{}) (flaggio.UpdateSegmentRule, error) {
+	res, err := ec.unmarshalInputUpdateSegmentRule(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNEvaluation2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluationᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.Evaluation) graphql.Marshaler {
+func (ec *executionContext) unmarshalNUpdateVariant2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateVariant(ctx context.Context, v interface{}) (flaggio.UpdateVariant, error) {
+	res, err := ec.unmarshalInputUpdateVariant(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNUpdateWebhook2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateWebhook(ctx context.Context, v interface{}) (flaggio.UpdateWebhook, error) {
+	res, err := ec.unmarshalInputUpdateWebhook(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNUser2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUserᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.User) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7073,7 +17196,7 @@ func (ec *executionContext) marshalNEvaluation2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNEvaluation2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluation(ctx, sel, v[i])
+			ret[i] = ec.marshalNUser2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUser(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7086,35 +17209,35 @@ func (ec *executionContext) marshalNEvaluation2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalNEvaluation2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluation(ctx context.Context, sel ast.SelectionSet, v *flaggio.Evaluation) graphql.Marshaler {
+func (ec *executionContext) marshalNUser2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUser(ctx context.Context, sel ast.SelectionSet, v *flaggio.User) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._Evaluation(ctx, sel, v)
+	return ec._User(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNEvaluationResults2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluationResults(ctx context.Context, sel ast.SelectionSet, v flaggio.EvaluationResults) graphql.Marshaler {
-	return ec._EvaluationResults(ctx, sel, &v)
+func (ec *executionContext) marshalNUserResults2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUserResults(ctx context.Context, sel ast.SelectionSet, v flaggio.UserResults) graphql.Marshaler {
+	return ec._UserResults(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNEvaluationResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEvaluationResults(ctx context.Context, sel ast.SelectionSet, v *flaggio.EvaluationResults) graphql.Marshaler {
+func (ec *executionContext) marshalNUserResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUserResults(ctx context.Context, sel ast.SelectionSet, v *flaggio.UserResults) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._EvaluationResults(ctx, sel, v)
+	return ec._UserResults(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNFlag2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx context.Context, sel ast.SelectionSet, v flaggio.Flag) graphql.Marshaler {
-	return ec._Flag(ctx, sel, &v)
+func (ec *executionContext) marshalNVariant2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx context.Context, sel ast.SelectionSet, v flaggio.Variant) graphql.Marshaler {
+	return ec._Variant(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNFlag2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.Flag) graphql.Marshaler {
+func (ec *executionContext) marshalNVariant2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariantᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.Variant) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7138,7 +17261,7 @@ func (ec *executionContext) marshalNFlag2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggi
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx, sel, v[i])
+			ret[i] = ec.marshalNVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7151,35 +17274,64 @@ func (ec *executionContext) marshalNFlag2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggi
 	return ret
 }
 
-func (ec *executionContext) marshalNFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx context.Context, sel ast.SelectionSet, v *flaggio.Flag) graphql.Marshaler {
+func (ec *executionContext) marshalNVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx context.Context, sel ast.SelectionSet, v *flaggio.Variant) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._Flag(ctx, sel, v)
+	return ec._Variant(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNFlagResults2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagResults(ctx context.Context, sel ast.SelectionSet, v flaggio.FlagResults) graphql.Marshaler {
-	return ec._FlagResults(ctx, sel, &v)
+func (ec *executionContext) marshalNVariantResults2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariantResultsᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.VariantResults) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNVariantResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariantResults(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) marshalNFlagResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagResults(ctx context.Context, sel ast.SelectionSet, v *flaggio.FlagResults) graphql.Marshaler {
+func (ec *executionContext) marshalNVariantResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariantResults(ctx context.Context, sel ast.SelectionSet, v *flaggio.VariantResults) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._FlagResults(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNFlagRule2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagRule(ctx context.Context, sel ast.SelectionSet, v flaggio.FlagRule) graphql.Marshaler {
-	return ec._FlagRule(ctx, sel, &v)
+	return ec._VariantResults(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNFlagRule2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagRuleᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.FlagRule) graphql.Marshaler {
+func (ec *executionContext) marshalNVariantUsage2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariantUsageᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.VariantUsage) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7203,7 +17355,7 @@ func (ec *executionContext) marshalNFlagRule2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋfl
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNFlagRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagRule(ctx, sel, v[i])
+			ret[i] = ec.marshalNVariantUsage2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariantUsage(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7216,159 +17368,68 @@ func (ec *executionContext) marshalNFlagRule2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋfl
 	return ret
 }
 
-func (ec *executionContext) marshalNFlagRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagRule(ctx context.Context, sel ast.SelectionSet, v *flaggio.FlagRule) graphql.Marshaler {
+func (ec *executionContext) marshalNVariantUsage2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariantUsage(ctx context.Context, sel ast.SelectionSet, v *flaggio.VariantUsage) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._FlagRule(ctx, sel, v)
+	return ec._VariantUsage(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalID(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNWebhook2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhook(ctx context.Context, sel ast.SelectionSet, v flaggio.Webhook) graphql.Marshaler {
+	return ec._Webhook(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
+func (ec *executionContext) marshalNWebhook2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhookᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.Webhook) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNWebhook2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhook(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-	return res
-}
 
-func (ec *executionContext) unmarshalNMap2map(ctx context.Context, v interface{}) (map[string]interface{}, error) {
-	res, err := graphql.UnmarshalMap(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	}
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) marshalNMap2map(ctx context.Context, sel ast.SelectionSet, v map[string]interface{}) graphql.Marshaler {
+func (ec *executionContext) marshalNWebhook2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhook(ctx context.Context, sel ast.SelectionSet, v *flaggio.Webhook) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	res := graphql.MarshalMap(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNNewConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraintᚄ(ctx context.Context, v interface{}) ([]*flaggio.NewConstraint, error) {
-	var vSlice []interface{}
-	if v != nil {
-		if tmp1, ok := v.([]interface{}); ok {
-			vSlice = tmp1
-		} else {
-			vSlice = []interface{}{v}
-		}
-	}
-	var err error
-	res := make([]*flaggio.NewConstraint, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNNewConstraint2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraint(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-	return res, nil
-}
-
-func (ec *executionContext) unmarshalNNewConstraint2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraint(ctx context.Context, v interface{}) (*flaggio.NewConstraint, error) {
-	res, err := ec.unmarshalInputNewConstraint(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNNewDistribution2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewDistributionᚄ(ctx context.Context, v interface{}) ([]*flaggio.NewDistribution, error) {
-	var vSlice []interface{}
-	if v != nil {
-		if tmp1, ok := v.([]interface{}); ok {
-			vSlice = tmp1
-		} else {
-			vSlice = []interface{}{v}
-		}
-	}
-	var err error
-	res := make([]*flaggio.NewDistribution, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNNewDistribution2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewDistribution(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-	return res, nil
-}
-
-func (ec *executionContext) unmarshalNNewDistribution2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewDistribution(ctx context.Context, v interface{}) (*flaggio.NewDistribution, error) {
-	res, err := ec.unmarshalInputNewDistribution(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNNewFlag2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewFlag(ctx context.Context, v interface{}) (flaggio.NewFlag, error) {
-	res, err := ec.unmarshalInputNewFlag(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNNewFlagRule2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewFlagRule(ctx context.Context, v interface{}) (flaggio.NewFlagRule, error) {
-	res, err := ec.unmarshalInputNewFlagRule(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNNewSegment2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewSegment(ctx context.Context, v interface{}) (flaggio.NewSegment, error) {
-	res, err := ec.unmarshalInputNewSegment(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNNewSegmentRule2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewSegmentRule(ctx context.Context, v interface{}) (flaggio.NewSegmentRule, error) {
-	res, err := ec.unmarshalInputNewSegmentRule(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNNewVariant2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewVariant(ctx context.Context, v interface{}) (flaggio.NewVariant, error) {
-	res, err := ec.unmarshalInputNewVariant(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNOperation2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐOperation(ctx context.Context, v interface{}) (flaggio.Operation, error) {
-	var res flaggio.Operation
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNOperation2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐOperation(ctx context.Context, sel ast.SelectionSet, v flaggio.Operation) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) marshalNSegment2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegment(ctx context.Context, sel ast.SelectionSet, v flaggio.Segment) graphql.Marshaler {
-	return ec._Segment(ctx, sel, &v)
+	return ec._Webhook(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNSegment2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.Segment) graphql.Marshaler {
+func (ec *executionContext) marshalNWebhookDelivery2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhookDeliveryᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.WebhookDelivery) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7392,7 +17453,7 @@ func (ec *executionContext) marshalNSegment2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋfla
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNSegment2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegment(ctx, sel, v[i])
+			ret[i] = ec.marshalNWebhookDelivery2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhookDelivery(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7401,25 +17462,39 @@ func (ec *executionContext) marshalNSegment2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋfla
 		}
 
 	}
-	wg.Wait()
-	return ret
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNWebhookDelivery2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhookDelivery(ctx context.Context, sel ast.SelectionSet, v *flaggio.WebhookDelivery) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._WebhookDelivery(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNSegment2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegment(ctx context.Context, sel ast.SelectionSet, v *flaggio.Segment) graphql.Marshaler {
+func (ec *executionContext) marshalNWebhookDeliveryResults2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhookDeliveryResults(ctx context.Context, sel ast.SelectionSet, v flaggio.WebhookDeliveryResults) graphql.Marshaler {
+	return ec._WebhookDeliveryResults(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNWebhookDeliveryResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhookDeliveryResults(ctx context.Context, sel ast.SelectionSet, v *flaggio.WebhookDeliveryResults) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._Segment(ctx, sel, v)
+	return ec._WebhookDeliveryResults(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNSegmentRule2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentRule(ctx context.Context, sel ast.SelectionSet, v flaggio.SegmentRule) graphql.Marshaler {
-	return ec._SegmentRule(ctx, sel, &v)
+func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
+	return ec.___Directive(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNSegmentRule2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentRuleᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.SegmentRule) graphql.Marshaler {
+func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7443,7 +17518,7 @@ func (ec *executionContext) marshalNSegmentRule2ᚕᚖgithubᚗcomᚋuwᚑlabs
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNSegmentRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentRule(ctx, sel, v[i])
+			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7456,22 +17531,12 @@ func (ec *executionContext) marshalNSegmentRule2ᚕᚖgithubᚗcomᚋuwᚑlabs
 	return ret
 }
 
-func (ec *executionContext) marshalNSegmentRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegmentRule(ctx context.Context, sel ast.SelectionSet, v *flaggio.SegmentRule) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._SegmentRule(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
+func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
 	res := graphql.MarshalString(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -7481,47 +17546,28 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
-func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
-	res, err := graphql.UnmarshalTime(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
-	res := graphql.MarshalTime(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
+func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
 		}
 	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNUpdateFlag2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateFlag(ctx context.Context, v interface{}) (flaggio.UpdateFlag, error) {
-	res, err := ec.unmarshalInputUpdateFlag(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNUpdateFlagRule2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateFlagRule(ctx context.Context, v interface{}) (flaggio.UpdateFlagRule, error) {
-	res, err := ec.unmarshalInputUpdateFlagRule(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNUpdateSegment2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateSegment(ctx context.Context, v interface{}) (flaggio.UpdateSegment, error) {
-	res, err := ec.unmarshalInputUpdateSegment(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNUpdateSegmentRule2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateSegmentRule(ctx context.Context, v interface{}) (flaggio.UpdateSegmentRule, error) {
-	res, err := ec.unmarshalInputUpdateSegmentRule(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNUpdateVariant2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateVariant(ctx context.Context, v interface{}) (flaggio.UpdateVariant, error) {
-	res, err := ec.unmarshalInputUpdateVariant(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalNUser2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUserᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.User) graphql.Marshaler {
+func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7545,7 +17591,7 @@ func (ec *executionContext) marshalNUser2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggi
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNUser2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUser(ctx, sel, v[i])
+			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7558,35 +17604,19 @@ func (ec *executionContext) marshalNUser2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggi
 	return ret
 }
 
-func (ec *executionContext) marshalNUser2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUser(ctx context.Context, sel ast.SelectionSet, v *flaggio.User) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._User(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNUserResults2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUserResults(ctx context.Context, sel ast.SelectionSet, v flaggio.UserResults) graphql.Marshaler {
-	return ec._UserResults(ctx, sel, &v)
+func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
+	return ec.___EnumValue(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNUserResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUserResults(ctx context.Context, sel ast.SelectionSet, v *flaggio.UserResults) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._UserResults(ctx, sel, v)
+func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
+	return ec.___Field(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNVariant2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx context.Context, sel ast.SelectionSet, v flaggio.Variant) graphql.Marshaler {
-	return ec._Variant(ctx, sel, &v)
+func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
+	return ec.___InputValue(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNVariant2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariantᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.Variant) graphql.Marshaler {
+func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7610,7 +17640,7 @@ func (ec *executionContext) marshalNVariant2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋfla
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx, sel, v[i])
+			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7623,21 +17653,11 @@ func (ec *executionContext) marshalNVariant2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋfla
 	return ret
 }
 
-func (ec *executionContext) marshalNVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐVariant(ctx context.Context, sel ast.SelectionSet, v *flaggio.Variant) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._Variant(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
-	return ec.___Directive(ctx, sel, &v)
+func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
+	return ec.___Type(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7661,7 +17681,7 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
+			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7674,12 +17694,22 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 	return ret
 }
 
-func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v interface{}) (string, error) {
+func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec.___Type(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
 	res := graphql.MarshalString(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -7689,7 +17719,25 @@ func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Conte
 	return res
 }
 
-func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+func (ec *executionContext) unmarshalOAny2interface(ctx context.Context, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalAny(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOAny2interface(ctx context.Context, sel ast.SelectionSet, v interface{}) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalAny(v)
+}
+
+func (ec *executionContext) unmarshalOAny2ᚕinterfaceᚄ(ctx context.Context, v interface{}) ([]interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
 	var vSlice []interface{}
 	if v != nil {
 		if tmp1, ok := v.([]interface{}); ok {
@@ -7699,10 +17747,10 @@ func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx conte
 		}
 	}
 	var err error
-	res := make([]string, len(vSlice))
+	res := make([]interface{}, len(vSlice))
 	for i := range vSlice {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
+		res[i], err = ec.unmarshalNAny2interface(ctx, vSlice[i])
 		if err != nil {
 			return nil, err
 		}
@@ -7710,7 +17758,46 @@ func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx conte
 	return res, nil
 }
 
-func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+func (ec *executionContext) marshalOAny2ᚕinterfaceᚄ(ctx context.Context, sel ast.SelectionSet, v []interface{}) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNAny2interface(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	return graphql.MarshalBoolean(v)
+}
+
+func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v interface{}) (*bool, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalBoolean(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalBoolean(*v)
+}
+
+func (ec *executionContext) marshalOConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐConstraintᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.Constraint) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7734,7 +17821,7 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
+			ret[i] = ec.marshalNConstraint2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐConstraint(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7747,19 +17834,10 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 	return ret
 }
 
-func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
-	return ec.___EnumValue(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
-	return ec.___Field(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
-	return ec.___InputValue(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+func (ec *executionContext) marshalODistribution2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐDistributionᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.Distribution) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7783,7 +17861,7 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+			ret[i] = ec.marshalNDistribution2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐDistribution(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7796,11 +17874,34 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
-	return ec.___Type(ctx, sel, &v)
+func (ec *executionContext) unmarshalOEntityType2ᚕgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEntityTypeᚄ(ctx context.Context, v interface{}) ([]flaggio.EntityType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]flaggio.EntityType, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNEntityType2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEntityType(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalOEntityType2ᚕgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEntityTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []flaggio.EntityType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -7824,7 +17925,7 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+			ret[i] = ec.marshalNEntityType2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐEntityType(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7837,111 +17938,202 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalOExclusionGroup2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐExclusionGroup(ctx context.Context, sel ast.SelectionSet, v *flaggio.ExclusionGroup) graphql.Marshaler {
 	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	return ec.___Type(ctx, sel, v)
+	return ec._ExclusionGroup(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalOExperimentResults2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐExperimentResults(ctx context.Context, sel ast.SelectionSet, v *flaggio.ExperimentResults) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ExperimentResults(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
+func (ec *executionContext) marshalOFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx context.Context, sel ast.SelectionSet, v *flaggio.Flag) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	return res
+	return ec._Flag(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalOAny2interface(ctx context.Context, v interface{}) (interface{}, error) {
+func (ec *executionContext) unmarshalOFlagFilter2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagFilter(ctx context.Context, v interface{}) (*flaggio.FlagFilter, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalAny(v)
+	res, err := ec.unmarshalInputFlagFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOFlagKind2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagKind(ctx context.Context, v interface{}) (*flaggio.FlagKind, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(flaggio.FlagKind)
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOAny2interface(ctx context.Context, sel ast.SelectionSet, v interface{}) graphql.Marshaler {
+func (ec *executionContext) marshalOFlagKind2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagKind(ctx context.Context, sel ast.SelectionSet, v *flaggio.FlagKind) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return graphql.MarshalAny(v)
+	return v
 }
 
-func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
+func (ec *executionContext) marshalOFlagUsage2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlagUsage(ctx context.Context, sel ast.SelectionSet, v *flaggio.FlagUsage) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._FlagUsage(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOID2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalID(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	return graphql.MarshalBoolean(v)
+func (ec *executionContext) marshalOID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	return graphql.MarshalID(v)
 }
 
-func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v interface{}) (*bool, error) {
+func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalBoolean(v)
+	res, err := graphql.UnmarshalID(v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return graphql.MarshalBoolean(*v)
+	return graphql.MarshalID(*v)
 }
 
-func (ec *executionContext) marshalOConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐConstraintᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.Constraint) graphql.Marshaler {
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	ret := make(graphql.Array, len(v))
-	var wg sync.WaitGroup
-	isLen1 := len(v) == 1
-	if !isLen1 {
-		wg.Add(len(v))
+	return graphql.MarshalInt(*v)
+}
+
+func (ec *executionContext) unmarshalOMap2map(ctx context.Context, v interface{}) (map[string]interface{}, error) {
+	if v == nil {
+		return nil, nil
 	}
-	for i := range v {
-		i := i
-		fc := &graphql.FieldContext{
-			Index:  &i,
-			Result: &v[i],
+	res, err := graphql.UnmarshalMap(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOMap2map(ctx context.Context, sel ast.SelectionSet, v map[string]interface{}) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalMap(v)
+}
+
+func (ec *executionContext) unmarshalONewConstraint2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraintᚄ(ctx context.Context, v interface{}) ([]*flaggio.NewConstraint, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
 		}
-		ctx := graphql.WithFieldContext(ctx, fc)
-		f := func(i int) {
-			defer func() {
-				if r := recover(); r != nil {
-					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
-				}
-			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
-			ret[i] = ec.marshalNConstraint2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐConstraint(ctx, sel, v[i])
+	}
+	var err error
+	res := make([]*flaggio.NewConstraint, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNNewConstraint2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewConstraint(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
-		if isLen1 {
-			f(i)
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalONewFlagRule2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewFlagRuleᚄ(ctx context.Context, v interface{}) ([]*flaggio.NewFlagRule, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
 		} else {
-			go f(i)
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]*flaggio.NewFlagRule, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNNewFlagRule2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewFlagRule(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
+	}
+	return res, nil
+}
 
+func (ec *executionContext) unmarshalONewSlotAllocation2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewSlotAllocationᚄ(ctx context.Context, v interface{}) ([]*flaggio.NewSlotAllocation, error) {
+	if v == nil {
+		return nil, nil
 	}
-	wg.Wait()
-	return ret
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]*flaggio.NewSlotAllocation, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNNewSlotAllocation2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐNewSlotAllocation(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalODistribution2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐDistributionᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.Distribution) graphql.Marshaler {
+func (ec *executionContext) unmarshalOReason2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐReason(ctx context.Context, v interface{}) (flaggio.Reason, error) {
+	tmp, err := graphql.UnmarshalString(v)
+	res := flaggio.Reason(tmp)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOReason2githubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐReason(ctx context.Context, sel ast.SelectionSet, v flaggio.Reason) graphql.Marshaler {
+	return graphql.MarshalString(string(v))
+}
+
+func (ec *executionContext) marshalOSegment2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegment(ctx context.Context, sel ast.SelectionSet, v *flaggio.Segment) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Segment(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOStackTrace2ᚕᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐStackTraceᚄ(ctx context.Context, sel ast.SelectionSet, v []*flaggio.StackTrace) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -7968,7 +18160,7 @@ func (ec *executionContext) marshalODistribution2ᚕᚖgithubᚗcomᚋuwᚑlabs
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNDistribution2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐDistribution(ctx, sel, v[i])
+			ret[i] = ec.marshalNStackTrace2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐStackTrace(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -7981,57 +18173,49 @@ func (ec *executionContext) marshalODistribution2ᚕᚖgithubᚗcomᚋuwᚑlabs
 	return ret
 }
 
-func (ec *executionContext) marshalOFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐFlag(ctx context.Context, sel ast.SelectionSet, v *flaggio.Flag) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	return ec._Flag(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
-	if v == nil {
-		return nil, nil
-	}
-	res, err := graphql.UnmarshalID(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	return graphql.MarshalID(*v)
+func (ec *executionContext) marshalOString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	return graphql.MarshalString(v)
 }
 
-func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalInt(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
 	}
-	return graphql.MarshalInt(*v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalOSegment2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐSegment(ctx context.Context, sel ast.SelectionSet, v *flaggio.Segment) graphql.Marshaler {
+func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._Segment(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
 
-func (ec *executionContext) marshalOString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	return graphql.MarshalString(v)
+	return ret
 }
 
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
@@ -8064,6 +18248,14 @@ func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel
 	return graphql.MarshalTime(*v)
 }
 
+func (ec *executionContext) unmarshalOUpdateFlag2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUpdateFlag(ctx context.Context, v interface{}) (*flaggio.UpdateFlag, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputUpdateFlag(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalOUser2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐUser(ctx context.Context, sel ast.SelectionSet, v *flaggio.User) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -8078,6 +18270,13 @@ func (ec *executionContext) marshalOVariant2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggi
 	return ec._Variant(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOWebhook2ᚖgithubᚗcomᚋuwᚑlabsᚋflaggioᚋinternalᚋflaggioᚐWebhook(ctx context.Context, sel ast.SelectionSet, v *flaggio.Webhook) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Webhook(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
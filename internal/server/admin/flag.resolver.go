@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/uw-labs/flaggio/internal/errors"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+var _ FlagResolver = &flagResolver{}
+
+type flagResolver struct{ *Resolver }
+
+// ExperimentResults returns the results of the flag experiment, per metric and variant.
+// Flags that are not experiments have no results.
+func (r *flagResolver) ExperimentResults(ctx context.Context, flg *flaggio.Flag) (*flaggio.ExperimentResults, error) {
+	if !flg.Experiment {
+		return nil, nil
+	}
+	metricKeys := make([]string, len(flg.Metrics))
+	for idx, mtrc := range flg.Metrics {
+		metricKeys[idx] = mtrc.Key
+	}
+	counts, err := r.ExperimentRepo.CountByVariant(ctx, flg.Key, metricKeys)
+	if err != nil {
+		return nil, err
+	}
+	return flaggio.NewExperimentResults(flg, counts), nil
+}
+
+// Usage returns how many times the flag was evaluated and when each variant was last served.
+// Flags that were never evaluated have no usage.
+func (r *flagResolver) Usage(ctx context.Context, flg *flaggio.Flag) (*flaggio.FlagUsage, error) {
+	usg, err := r.UsageRepo.FindByFlagKey(ctx, flg.Key)
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return nil, nil
+	}
+	return usg, err
+}
@@ -0,0 +1,59 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/lint"
+)
+
+// lintExtension is the response extension where mutations report the issues
+// found in the flags they changed, by flag ID.
+const lintExtension = "lint"
+
+// lintFlag returns the issues found in the configuration of the flag.
+func (r *Resolver) lintFlag(ctx context.Context, flg *flaggio.Flag) ([]*flaggio.LintIssue, error) {
+	sgmnts, err := r.SegmentRepo.FindAll(ctx, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return lint.Flag(flg, sgmnts), nil
+}
+
+// reportLint lints the flags changed by a mutation, and adds their issues to
+// the response extensions, so they're reported without failing the mutation.
+func (r *Resolver) reportLint(ctx context.Context, flgs ...*flaggio.Flag) {
+	if len(flgs) == 0 {
+		return
+	}
+	sgmnts, err := r.SegmentRepo.FindAll(ctx, nil, nil)
+	if err != nil {
+		return
+	}
+	for _, flg := range flgs {
+		issues := lint.Flag(flg, sgmnts)
+		if len(issues) == 0 {
+			continue
+		}
+		report, ok := graphql.GetExtension(ctx, lintExtension).(map[string][]*flaggio.LintIssue)
+		if !ok {
+			report = make(map[string][]*flaggio.LintIssue)
+			graphql.RegisterExtension(ctx, lintExtension, report)
+		}
+		report[flg.ID] = issues
+	}
+}
+
+// reportSegmentLint lints the flags that use a segment changed by a mutation.
+func (r *Resolver) reportSegmentLint(ctx context.Context, segmentID string) {
+	usages, err := r.SegmentRepo.UsedBy(ctx, segmentID)
+	if err != nil {
+		return
+	}
+	flgs := make([]*flaggio.Flag, len(usages))
+	for idx, usg := range usages {
+		flgs[idx] = usg.Flag
+	}
+	r.reportLint(ctx, flgs...)
+}
@@ -2,12 +2,17 @@ package admin
 
 import (
 	"context"
+	"time"
 
+	"github.com/uw-labs/flaggio/internal/errors"
 	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/webhook"
 )
 
 var _ MutationResolver = &mutationResolver{}
 
+var errProtectedFlag = errors.BadRequest("flag is protected, only updates can be requested")
+
 type mutationResolver struct{ *Resolver }
 
 func (r *mutationResolver) Ping(_ context.Context) (bool, error) {
@@ -19,59 +24,338 @@ func (r *mutationResolver) CreateFlag(ctx context.Context, input flaggio.NewFlag
 	if err != nil {
 		return nil, err
 	}
-	return r.FlagRepo.FindByID(ctx, id)
+	flg, err := r.FlagRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.reportLint(ctx, flg)
+	r.notifyFlagChange(ctx, flg, flaggio.EntityTypeFlag, id, flaggio.ChangeActionCreated)
+	return flg, nil
 }
 
 func (r *mutationResolver) UpdateFlag(ctx context.Context, id string, input flaggio.UpdateFlag) (*flaggio.Flag, error) {
+	flg, err := r.FlagRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if flg.Protected {
+		err := r.requestChange(ctx, flg, &flaggio.ChangeRequest{
+			EntityType: flaggio.EntityTypeFlag,
+			EntityID:   id,
+			Diff:       flaggio.DiffFlag(flg, input),
+			FlagInput:  &input,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if prpsd, err := flaggio.ProposeFlag(flg, flaggio.FlagProposal{Flag: &input}); err == nil {
+			r.reportLint(ctx, prpsd)
+		}
+		return r.FlagRepo.FindByID(ctx, id)
+	}
+	if input.Protected != nil && *input.Protected {
+		// changes to protected flags need to be approved by identified
+		// users, so flags can't be protected if the user is unknown
+		if _, err := actorFromContext(ctx); err != nil {
+			return nil, err
+		}
+	}
 	if err := r.FlagRepo.Update(ctx, id, input); err != nil {
 		return nil, err
 	}
-	return r.FlagRepo.FindByID(ctx, id)
+	flg, err = r.FlagRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.reportLint(ctx, flg)
+	r.notifyFlagChange(ctx, flg, flaggio.EntityTypeFlag, id, flaggio.ChangeActionUpdated)
+	return flg, nil
+}
+
+func (r *mutationResolver) CloneFlag(ctx context.Context, id, newKey, newName string) (*flaggio.Flag, error) {
+	cloneID, err := r.FlagRepo.Clone(ctx, id, newKey, newName)
+	if err != nil {
+		return nil, err
+	}
+	flg, err := r.FlagRepo.FindByID(ctx, cloneID)
+	if err != nil {
+		return nil, err
+	}
+	r.reportLint(ctx, flg)
+	r.notifyFlagChange(ctx, flg, flaggio.EntityTypeFlag, cloneID, flaggio.ChangeActionCreated)
+	return flg, nil
+}
+
+func (r *mutationResolver) ArchiveFlag(ctx context.Context, id string) (*flaggio.Flag, error) {
+	if err := r.checkUnprotected(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := r.FlagRepo.Archive(ctx, id); err != nil {
+		return nil, err
+	}
+	flg, err := r.FlagRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.reportLint(ctx, flg)
+	r.notifyFlagChange(ctx, flg, flaggio.EntityTypeFlag, id, flaggio.ChangeActionUpdated)
+	return flg, nil
+}
+
+func (r *mutationResolver) RestoreFlag(ctx context.Context, id string) (*flaggio.Flag, error) {
+	if err := r.checkUnprotected(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := r.FlagRepo.Restore(ctx, id); err != nil {
+		return nil, err
+	}
+	flg, err := r.FlagRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.reportLint(ctx, flg)
+	r.notifyFlagChange(ctx, flg, flaggio.EntityTypeFlag, id, flaggio.ChangeActionUpdated)
+	return flg, nil
 }
 
 func (r *mutationResolver) DeleteFlag(ctx context.Context, id string) (string, error) {
-	err := r.FlagRepo.Delete(ctx, id)
-	return id, err
+	// find the flag first, so its key is known after it's deleted
+	flg, err := r.FlagRepo.FindByID(ctx, id)
+	if err != nil {
+		return id, err
+	}
+	if flg.Protected {
+		return id, errProtectedFlag
+	}
+	if err := r.FlagRepo.Delete(ctx, id); err != nil {
+		return id, err
+	}
+	r.notify(ctx, webhook.Change{
+		EntityType: flaggio.EntityTypeFlag,
+		EntityID:   id,
+		Action:     flaggio.ChangeActionDeleted,
+		FlagID:     id,
+		FlagKey:    flg.Key,
+	})
+	return id, nil
 }
 
 func (r *mutationResolver) CreateVariant(ctx context.Context, flagID string, input flaggio.NewVariant) (*flaggio.Variant, error) {
+	if err := r.checkUnprotected(ctx, flagID); err != nil {
+		return nil, err
+	}
 	id, err := r.VariantRepo.Create(ctx, flagID, input)
 	if err != nil {
 		return nil, err
 	}
-	return r.VariantRepo.FindByID(ctx, flagID, id)
+	flg, err := r.FlagRepo.FindByID(ctx, flagID)
+	if err != nil {
+		return nil, err
+	}
+	r.reportLint(ctx, flg)
+	r.notifyFlagChange(ctx, flg, flaggio.EntityTypeVariant, id, flaggio.ChangeActionCreated)
+	return flg.FindVariant(id)
 }
 
 func (r *mutationResolver) UpdateVariant(ctx context.Context, flagID, id string, input flaggio.UpdateVariant) (*flaggio.Variant, error) {
+	flg, err := r.FlagRepo.FindByID(ctx, flagID)
+	if err != nil {
+		return nil, err
+	}
+	if flg.Protected {
+		vrnt, err := flg.FindVariant(id)
+		if err != nil {
+			return nil, err
+		}
+		err = r.requestChange(ctx, flg, &flaggio.ChangeRequest{
+			EntityType:   flaggio.EntityTypeVariant,
+			EntityID:     id,
+			Diff:         flaggio.DiffVariant(vrnt, input),
+			VariantInput: &input,
+		})
+		if err != nil {
+			return nil, err
+		}
+		// the values of variants are not linted, so the proposed flag
+		// has the same issues as the current one
+		r.reportLint(ctx, flg)
+		return vrnt, nil
+	}
 	if err := r.VariantRepo.Update(ctx, flagID, id, input); err != nil {
 		return nil, err
 	}
-	return r.VariantRepo.FindByID(ctx, flagID, id)
+	flg, err = r.FlagRepo.FindByID(ctx, flagID)
+	if err != nil {
+		return nil, err
+	}
+	r.reportLint(ctx, flg)
+	r.notifyFlagChange(ctx, flg, flaggio.EntityTypeVariant, id, flaggio.ChangeActionUpdated)
+	return flg.FindVariant(id)
 }
 
 func (r *mutationResolver) DeleteVariant(ctx context.Context, flagID, id string) (string, error) {
-	err := r.VariantRepo.Delete(ctx, flagID, id)
+	if err := r.checkUnprotected(ctx, flagID); err != nil {
+		return id, err
+	}
+	if err := r.VariantRepo.Delete(ctx, flagID, id); err != nil {
+		return id, err
+	}
+	flg, err := r.FlagRepo.FindByID(ctx, flagID)
+	if err != nil {
+		return id, err
+	}
+	r.reportLint(ctx, flg)
+	r.notifyFlagChange(ctx, flg, flaggio.EntityTypeVariant, id, flaggio.ChangeActionDeleted)
+	return id, nil
+}
+
+func (r *mutationResolver) CreateMetric(ctx context.Context, flagID string, input flaggio.NewMetric) (*flaggio.Metric, error) {
+	if err := r.checkUnprotected(ctx, flagID); err != nil {
+		return nil, err
+	}
+	id, err := r.MetricRepo.Create(ctx, flagID, input)
+	if err != nil {
+		return nil, err
+	}
+	return r.MetricRepo.FindByID(ctx, flagID, id)
+}
+
+func (r *mutationResolver) UpdateMetric(ctx context.Context, flagID, id string, input flaggio.UpdateMetric) (*flaggio.Metric, error) {
+	if err := r.checkUnprotected(ctx, flagID); err != nil {
+		return nil, err
+	}
+	if err := r.MetricRepo.Update(ctx, flagID, id, input); err != nil {
+		return nil, err
+	}
+	return r.MetricRepo.FindByID(ctx, flagID, id)
+}
+
+func (r *mutationResolver) DeleteMetric(ctx context.Context, flagID, id string) (string, error) {
+	if err := r.checkUnprotected(ctx, flagID); err != nil {
+		return id, err
+	}
+	err := r.MetricRepo.Delete(ctx, flagID, id)
 	return id, err
 }
 
+func (r *mutationResolver) ApproveChangeRequest(ctx context.Context, flagID, id string) (*flaggio.ChangeRequest, error) {
+	user, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	applied, err := r.ChangeRequestRepo.Approve(ctx, flagID, id, user)
+	if err != nil {
+		return nil, err
+	}
+	cr, err := r.ChangeRequestRepo.FindByID(ctx, flagID, id)
+	if err != nil {
+		return nil, err
+	}
+	if applied {
+		flg, err := r.FlagRepo.FindByID(ctx, flagID)
+		if err != nil {
+			return nil, err
+		}
+		r.reportLint(ctx, flg)
+		r.notifyFlagChange(ctx, flg, cr.EntityType, cr.EntityID, flaggio.ChangeActionUpdated)
+	}
+	return cr, nil
+}
+
+func (r *mutationResolver) RejectChangeRequest(ctx context.Context, flagID, id string) (*flaggio.ChangeRequest, error) {
+	user, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.ChangeRequestRepo.Reject(ctx, flagID, id, user); err != nil {
+		return nil, err
+	}
+	return r.ChangeRequestRepo.FindByID(ctx, flagID, id)
+}
+
 func (r *mutationResolver) CreateFlagRule(ctx context.Context, flagID string, input flaggio.NewFlagRule) (*flaggio.FlagRule, error) {
+	if err := r.checkUnprotected(ctx, flagID); err != nil {
+		return nil, err
+	}
 	id, err := r.RuleRepo.CreateFlagRule(ctx, flagID, input)
 	if err != nil {
 		return nil, err
 	}
-	return r.RuleRepo.FindFlagRuleByID(ctx, flagID, id)
+	flg, err := r.FlagRepo.FindByID(ctx, flagID)
+	if err != nil {
+		return nil, err
+	}
+	r.reportLint(ctx, flg)
+	r.notifyFlagChange(ctx, flg, flaggio.EntityTypeRule, id, flaggio.ChangeActionCreated)
+	return flg.FindRule(id)
 }
 
 func (r *mutationResolver) UpdateFlagRule(ctx context.Context, flagID, id string, input flaggio.UpdateFlagRule) (*flaggio.FlagRule, error) {
+	flg, err := r.FlagRepo.FindByID(ctx, flagID)
+	if err != nil {
+		return nil, err
+	}
+	if flg.Protected {
+		rl, err := flg.FindRule(id)
+		if err != nil {
+			return nil, err
+		}
+		err = r.requestChange(ctx, flg, &flaggio.ChangeRequest{
+			EntityType:    flaggio.EntityTypeRule,
+			EntityID:      id,
+			Diff:          flaggio.DiffFlagRule(rl, input),
+			FlagRuleInput: &input,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if prpsd, err := flaggio.ProposeFlagRule(flg, id, input); err == nil {
+			r.reportLint(ctx, prpsd)
+		}
+		return rl, nil
+	}
 	if err := r.RuleRepo.UpdateFlagRule(ctx, flagID, id, input); err != nil {
 		return nil, err
 	}
-	return r.RuleRepo.FindFlagRuleByID(ctx, flagID, id)
+	flg, err = r.FlagRepo.FindByID(ctx, flagID)
+	if err != nil {
+		return nil, err
+	}
+	r.reportLint(ctx, flg)
+	r.notifyFlagChange(ctx, flg, flaggio.EntityTypeRule, id, flaggio.ChangeActionUpdated)
+	return flg.FindRule(id)
 }
 
 func (r *mutationResolver) DeleteFlagRule(ctx context.Context, flagID, id string) (string, error) {
-	err := r.RuleRepo.DeleteFlagRule(ctx, flagID, id)
-	return id, err
+	if err := r.checkUnprotected(ctx, flagID); err != nil {
+		return id, err
+	}
+	if err := r.RuleRepo.DeleteFlagRule(ctx, flagID, id); err != nil {
+		return id, err
+	}
+	flg, err := r.FlagRepo.FindByID(ctx, flagID)
+	if err != nil {
+		return id, err
+	}
+	r.reportLint(ctx, flg)
+	r.notifyFlagChange(ctx, flg, flaggio.EntityTypeRule, id, flaggio.ChangeActionDeleted)
+	return id, nil
+}
+
+func (r *mutationResolver) MoveFlagRule(ctx context.Context, flagID, ruleID string, position int) (*flaggio.Flag, error) {
+	if err := r.checkUnprotected(ctx, flagID); err != nil {
+		return nil, err
+	}
+	if err := r.RuleRepo.MoveFlagRule(ctx, flagID, ruleID, position); err != nil {
+		return nil, err
+	}
+	flg, err := r.FlagRepo.FindByID(ctx, flagID)
+	if err != nil {
+		return nil, err
+	}
+	r.reportLint(ctx, flg)
+	r.notifyFlagChange(ctx, flg, flaggio.EntityTypeRule, ruleID, flaggio.ChangeActionUpdated)
+	return flg, nil
 }
 
 func (r *mutationResolver) CreateSegmentRule(ctx context.Context, segmentID string, input flaggio.NewSegmentRule) (*flaggio.SegmentRule, error) {
@@ -79,6 +363,8 @@ func (r *mutationResolver) CreateSegmentRule(ctx context.Context, segmentID stri
 	if err != nil {
 		return nil, err
 	}
+	r.notifySegmentChange(ctx, segmentID, flaggio.EntityTypeRule, id, flaggio.ChangeActionCreated)
+	r.reportSegmentLint(ctx, segmentID)
 	return r.RuleRepo.FindSegmentRuleByID(ctx, segmentID, id)
 }
 
@@ -86,12 +372,27 @@ func (r *mutationResolver) UpdateSegmentRule(ctx context.Context, segmentID, id
 	if err := r.RuleRepo.UpdateSegmentRule(ctx, segmentID, id, input); err != nil {
 		return nil, err
 	}
+	r.notifySegmentChange(ctx, segmentID, flaggio.EntityTypeRule, id, flaggio.ChangeActionUpdated)
+	r.reportSegmentLint(ctx, segmentID)
 	return r.RuleRepo.FindSegmentRuleByID(ctx, segmentID, id)
 }
 
 func (r *mutationResolver) DeleteSegmentRule(ctx context.Context, segmentID, id string) (string, error) {
-	err := r.RuleRepo.DeleteSegmentRule(ctx, segmentID, id)
-	return id, err
+	if err := r.RuleRepo.DeleteSegmentRule(ctx, segmentID, id); err != nil {
+		return id, err
+	}
+	r.notifySegmentChange(ctx, segmentID, flaggio.EntityTypeRule, id, flaggio.ChangeActionDeleted)
+	r.reportSegmentLint(ctx, segmentID)
+	return id, nil
+}
+
+func (r *mutationResolver) MoveSegmentRule(ctx context.Context, segmentID, ruleID string, position int) (*flaggio.Segment, error) {
+	if err := r.RuleRepo.MoveSegmentRule(ctx, segmentID, ruleID, position); err != nil {
+		return nil, err
+	}
+	r.notifySegmentChange(ctx, segmentID, flaggio.EntityTypeRule, ruleID, flaggio.ChangeActionUpdated)
+	r.reportSegmentLint(ctx, segmentID)
+	return r.SegmentRepo.FindByID(ctx, segmentID)
 }
 
 func (r *mutationResolver) CreateSegment(ctx context.Context, input flaggio.NewSegment) (*flaggio.Segment, error) {
@@ -99,6 +400,7 @@ func (r *mutationResolver) CreateSegment(ctx context.Context, input flaggio.NewS
 	if err != nil {
 		return nil, err
 	}
+	r.notifySegmentChange(ctx, id, flaggio.EntityTypeSegment, id, flaggio.ChangeActionCreated)
 	return r.SegmentRepo.FindByID(ctx, id)
 }
 
@@ -106,12 +408,112 @@ func (r *mutationResolver) UpdateSegment(ctx context.Context, id string, input f
 	if err := r.SegmentRepo.Update(ctx, id, input); err != nil {
 		return nil, err
 	}
+	r.notifySegmentChange(ctx, id, flaggio.EntityTypeSegment, id, flaggio.ChangeActionUpdated)
+	r.reportSegmentLint(ctx, id)
 	return r.SegmentRepo.FindByID(ctx, id)
 }
 
-func (r *mutationResolver) DeleteSegment(ctx context.Context, id string) (string, error) {
-	err := r.SegmentRepo.Delete(ctx, id)
-	return id, err
+func (r *mutationResolver) DeleteSegment(ctx context.Context, id string, cascade *bool) (string, error) {
+	var usages []*flaggio.SegmentUsage
+	if cascade != nil && *cascade {
+		var err error
+		usages, err = r.SegmentRepo.UsedBy(ctx, id)
+		if err != nil {
+			return id, err
+		}
+		// rules of protected flags can only be changed by the flag owners
+		for _, usg := range usages {
+			if usg.Flag.Protected {
+				return id, errProtectedFlag
+			}
+		}
+	}
+	if err := r.SegmentRepo.Delete(ctx, id, cascade != nil && *cascade); err != nil {
+		return id, err
+	}
+	for _, usg := range usages {
+		flg, err := r.FlagRepo.FindByID(ctx, usg.Flag.ID)
+		if err != nil {
+			return id, err
+		}
+		r.reportLint(ctx, flg)
+		for _, rl := range usg.Rules {
+			r.notifyFlagChange(ctx, flg, flaggio.EntityTypeRule, rl.ID, flaggio.ChangeActionUpdated)
+		}
+	}
+	r.notifySegmentChange(ctx, id, flaggio.EntityTypeSegment, id, flaggio.ChangeActionDeleted)
+	return id, nil
+}
+
+func (r *mutationResolver) CreateExclusionGroup(ctx context.Context, input flaggio.NewExclusionGroup) (*flaggio.ExclusionGroup, error) {
+	allocs, err := flaggio.NewSlotAllocations(input.Allocations)
+	if err != nil {
+		return nil, err
+	}
+	changed := flaggio.ChangedSlotAllocations(nil, allocs)
+	if err := r.checkUnprotectedFlags(ctx, changed); err != nil {
+		return nil, err
+	}
+	id, err := r.GroupRepo.Create(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	flgs, err := r.findFlags(ctx, changed)
+	if err != nil {
+		return nil, err
+	}
+	r.reportLint(ctx, flgs...)
+	r.notifyFlagChanges(ctx, flgs)
+	return r.GroupRepo.FindByID(ctx, id)
+}
+
+func (r *mutationResolver) UpdateExclusionGroup(ctx context.Context, id string, input flaggio.UpdateExclusionGroup) (*flaggio.ExclusionGroup, error) {
+	var changed []string
+	if input.Allocations != nil {
+		grp, err := r.GroupRepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		allocs, err := flaggio.NewSlotAllocations(input.Allocations)
+		if err != nil {
+			return nil, err
+		}
+		changed = flaggio.ChangedSlotAllocations(grp.Allocations, allocs)
+		if err := r.checkUnprotectedFlags(ctx, changed); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.GroupRepo.Update(ctx, id, input); err != nil {
+		return nil, err
+	}
+	flgs, err := r.findFlags(ctx, changed)
+	if err != nil {
+		return nil, err
+	}
+	r.reportLint(ctx, flgs...)
+	r.notifyFlagChanges(ctx, flgs)
+	return r.GroupRepo.FindByID(ctx, id)
+}
+
+func (r *mutationResolver) DeleteExclusionGroup(ctx context.Context, id string) (string, error) {
+	grp, err := r.GroupRepo.FindByID(ctx, id)
+	if err != nil {
+		return id, err
+	}
+	changed := flaggio.ChangedSlotAllocations(grp.Allocations, nil)
+	if err := r.checkUnprotectedFlags(ctx, changed); err != nil {
+		return id, err
+	}
+	if err := r.GroupRepo.Delete(ctx, id); err != nil {
+		return id, err
+	}
+	flgs, err := r.findFlags(ctx, changed)
+	if err != nil {
+		return id, err
+	}
+	r.reportLint(ctx, flgs...)
+	r.notifyFlagChanges(ctx, flgs)
+	return id, nil
 }
 
 func (r *mutationResolver) DeleteUser(ctx context.Context, id string) (string, error) {
@@ -128,3 +530,119 @@ func (r *mutationResolver) DeleteEvaluation(ctx context.Context, id string) (str
 	err := r.EvaluationRepo.DeleteByID(ctx, id)
 	return id, err
 }
+
+func (r *mutationResolver) CreateWebhook(ctx context.Context, input flaggio.NewWebhook) (*flaggio.Webhook, error) {
+	id, err := r.WebhookRepo.Create(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return r.WebhookRepo.FindByID(ctx, id)
+}
+
+func (r *mutationResolver) UpdateWebhook(ctx context.Context, id string, input flaggio.UpdateWebhook) (*flaggio.Webhook, error) {
+	if err := r.WebhookRepo.Update(ctx, id, input); err != nil {
+		return nil, err
+	}
+	return r.WebhookRepo.FindByID(ctx, id)
+}
+
+func (r *mutationResolver) DeleteWebhook(ctx context.Context, id string) (string, error) {
+	if err := r.WebhookRepo.Delete(ctx, id); err != nil {
+		return id, err
+	}
+	if err := r.DeliveryRepo.DeleteAllByWebhookID(ctx, id); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// requestChange creates a pending change request for a protected flag, instead
+// of applying the change directly.
+func (r *mutationResolver) requestChange(ctx context.Context, flg *flaggio.Flag, cr *flaggio.ChangeRequest) error {
+	author, err := actorFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if len(cr.Diff) == 0 {
+		return errors.BadRequest("nothing to change")
+	}
+	cr.FlagVersion = flg.Version
+	cr.Author = author
+	cr.RequiredApprovals = flg.RequiredApprovals
+	_, err = r.ChangeRequestRepo.Create(ctx, flg.ID, cr)
+	return err
+}
+
+// checkUnprotected returns an error if the flag is protected. Changes to
+// protected flags that can't be proposed in a change request are refused.
+func (r *mutationResolver) checkUnprotected(ctx context.Context, flagID string) error {
+	flg, err := r.FlagRepo.FindByID(ctx, flagID)
+	if err != nil {
+		return err
+	}
+	if flg.Protected {
+		return errProtectedFlag
+	}
+	return nil
+}
+
+// checkUnprotectedFlags returns an error if any of the flags is protected.
+// Exclusion groups change who flags are served to, which can't be proposed
+// in a change request, so groups can't change protected flags.
+func (r *mutationResolver) checkUnprotectedFlags(ctx context.Context, flagIDs []string) error {
+	for _, flagID := range flagIDs {
+		if err := r.checkUnprotected(ctx, flagID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findFlags returns the flags with the given IDs.
+func (r *mutationResolver) findFlags(ctx context.Context, flagIDs []string) ([]*flaggio.Flag, error) {
+	flgs := make([]*flaggio.Flag, len(flagIDs))
+	for idx, flagID := range flagIDs {
+		flg, err := r.FlagRepo.FindByID(ctx, flagID)
+		if err != nil {
+			return nil, err
+		}
+		flgs[idx] = flg
+	}
+	return flgs, nil
+}
+
+// notifyFlagChanges notifies the webhooks about flags updated by a change to
+// their exclusion group.
+func (r *mutationResolver) notifyFlagChanges(ctx context.Context, flgs []*flaggio.Flag) {
+	for _, flg := range flgs {
+		r.notifyFlagChange(ctx, flg, flaggio.EntityTypeFlag, flg.ID, flaggio.ChangeActionUpdated)
+	}
+}
+
+// notifyFlagChange notifies the webhooks about a change to a flag or one of
+// its entities.
+func (r *mutationResolver) notifyFlagChange(ctx context.Context, flg *flaggio.Flag, entityType flaggio.EntityType, entityID string, action flaggio.ChangeAction) {
+	r.notify(ctx, webhook.Change{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		FlagID:     flg.ID,
+		FlagKey:    flg.Key,
+	})
+}
+
+// notifySegmentChange notifies the webhooks about a change to a segment or one
+// of its rules.
+func (r *mutationResolver) notifySegmentChange(ctx context.Context, segmentID string, entityType flaggio.EntityType, entityID string, action flaggio.ChangeAction) {
+	r.notify(ctx, webhook.Change{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		SegmentID:  segmentID,
+	})
+}
+
+func (r *mutationResolver) notify(ctx context.Context, change webhook.Change) {
+	change.Timestamp = time.Now()
+	r.Notifier.Notify(ctx, change)
+}
@@ -2,8 +2,13 @@ package admin
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	apperrors "github.com/uw-labs/flaggio/internal/errors"
 	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/service"
+	"github.com/victorkt/clientip"
 )
 
 var _ QueryResolver = &queryResolver{}
@@ -14,7 +19,7 @@ func (r *queryResolver) Ping(_ context.Context) (bool, error) {
 	return true, nil
 }
 
-func (r *queryResolver) Flags(ctx context.Context, search *string, offset, limit *int) (*flaggio.FlagResults, error) {
+func (r *queryResolver) Flags(ctx context.Context, search *string, filter *flaggio.FlagFilter, offset, limit *int) (*flaggio.FlagResults, error) {
 	var ofst, lmt *int64
 	if offset != nil {
 		v := int64(*offset)
@@ -24,13 +29,131 @@ func (r *queryResolver) Flags(ctx context.Context, search *string, offset, limit
 		v := int64(*limit)
 		lmt = &v
 	}
-	return r.FlagRepo.FindAll(ctx, search, ofst, lmt)
+	return r.FlagRepo.FindAll(ctx, search, filter, ofst, lmt)
 }
 
 func (r *queryResolver) Flag(ctx context.Context, id string) (*flaggio.Flag, error) {
 	return r.FlagRepo.FindByID(ctx, id)
 }
 
+func (r *queryResolver) StaleFlags(ctx context.Context, days *int) ([]*flaggio.StaleFlag, error) {
+	if days == nil || *days < 1 {
+		return nil, apperrors.BadRequest("days must be at least 1")
+	}
+	flgs, err := r.FlagRepo.FindAll(ctx, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	usages, err := r.UsageRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return flaggio.NewStaleFlags(flgs.Flags, usages, *days, time.Now()), nil
+}
+
+func (r *queryResolver) FlagLint(ctx context.Context, id string) ([]*flaggio.LintIssue, error) {
+	flg, err := r.FlagRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return r.lintFlag(ctx, flg)
+}
+
+func (r *queryResolver) SimulateFlag(ctx context.Context, id string, proposed flaggio.FlagProposal, sampleSize *int) (*flaggio.FlagSimulation, error) {
+	if sampleSize == nil {
+		return nil, apperrors.BadRequest("sample size is required")
+	}
+	if *sampleSize < 0 {
+		return nil, apperrors.BadRequest("sample size can't be negative")
+	}
+	flg, err := r.FlagRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	prpsd, err := flaggio.ProposeFlag(flg, proposed)
+	if err != nil {
+		return nil, err
+	}
+	sgmnts, err := r.SegmentRepo.FindAll(ctx, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	grps, err := r.GroupRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// users are loaded in pages, up to the configured maximum
+	simulator := flaggio.NewFlagSimulator(flg, prpsd, sgmnts, grps, *sampleSize)
+	var total int
+	for offset := 0; offset < r.MaxSimulatedUsers; offset += simulationPageSize {
+		ofst, limit := int64(offset), int64(simulationPageSize)
+		if remaining := r.MaxSimulatedUsers - offset; remaining < simulationPageSize {
+			limit = int64(remaining)
+		}
+		usrs, err := r.UserRepo.FindAll(ctx, nil, &ofst, &limit)
+		if err != nil {
+			return nil, err
+		}
+		total = usrs.Total
+		simulator.Simulate(usrs.Users)
+		if int64(len(usrs.Users)) < limit {
+			break
+		}
+	}
+	sim := simulator.Result()
+	sim.TotalUsers = total
+	return sim, nil
+}
+
+func (r *queryResolver) Evaluate(ctx context.Context, flagKey string, userID *string, usrContext map[string]interface{}, debug *bool) (*flaggio.Evaluation, error) {
+	// evaluations are always done in debug mode, so nothing is persisted
+	alwaysDebug := true
+	req := &service.EvaluationRequest{Debug: &alwaysDebug}
+	switch {
+	case usrContext != nil:
+		if userID != nil {
+			req.UserID = *userID
+		}
+		// numbers are converted the same way as in the evaluation API
+		req.UserContext = flaggio.NewUserContext(usrContext)
+		req.Enrich(clientip.FromContext(ctx))
+	case userID != nil:
+		// use the context the user was last evaluated with
+		usr, err := r.UserRepo.FindByID(ctx, *userID)
+		if err != nil {
+			return nil, err
+		}
+		req.UserID = usr.ID
+		req.UserContext = usr.Context
+	default:
+		return nil, apperrors.BadRequest("either a user ID or a context is required")
+	}
+	res, err := r.FlagService.Evaluate(ctx, flagKey, req)
+	if err != nil {
+		return nil, err
+	}
+	evltn := res.Evaluation
+
+	// the variant of a split is picked at random, so the evaluation the
+	// user is served is reported instead, if it's still valid
+	hash, err := req.Hash()
+	if err != nil {
+		return nil, err
+	}
+	served, err := r.EvaluationRepo.FindByReqHashAndFlagKey(ctx, hash, flagKey)
+	switch {
+	case err == nil && served.FlagVersion == evltn.FlagVersion:
+		served.StackTrace = evltn.StackTrace
+		evltn = served
+	case err != nil && !errors.Is(err, apperrors.ErrNotFound):
+		return nil, err
+	}
+	if debug == nil || !*debug {
+		evltn.StackTrace = nil
+	}
+	return evltn, nil
+}
+
 func (r *queryResolver) Segments(ctx context.Context, offset, limit *int) ([]*flaggio.Segment, error) {
 	var ofst, lmt *int64
 	if offset != nil {
@@ -48,6 +171,14 @@ func (r *queryResolver) Segment(ctx context.Context, id string) (*flaggio.Segmen
 	return r.SegmentRepo.FindByID(ctx, id)
 }
 
+func (r *queryResolver) ExclusionGroups(ctx context.Context) ([]*flaggio.ExclusionGroup, error) {
+	return r.GroupRepo.FindAll(ctx)
+}
+
+func (r *queryResolver) ExclusionGroup(ctx context.Context, id string) (*flaggio.ExclusionGroup, error) {
+	return r.GroupRepo.FindByID(ctx, id)
+}
+
 func (r *queryResolver) Users(ctx context.Context, search *string, offset, limit *int) (*flaggio.UserResults, error) {
 	var ofst, lmt *int64
 	if offset != nil {
@@ -64,3 +195,11 @@ func (r *queryResolver) Users(ctx context.Context, search *string, offset, limit
 func (r *queryResolver) User(ctx context.Context, id string) (*flaggio.User, error) {
 	return r.UserRepo.FindByID(ctx, id)
 }
+
+func (r *queryResolver) Webhooks(ctx context.Context) ([]*flaggio.Webhook, error) {
+	return r.WebhookRepo.FindAll(ctx)
+}
+
+func (r *queryResolver) Webhook(ctx context.Context, id string) (*flaggio.Webhook, error) {
+	return r.WebhookRepo.FindByID(ctx, id)
+}
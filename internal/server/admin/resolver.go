@@ -2,18 +2,39 @@ package admin
 
 import (
 	"github.com/uw-labs/flaggio/internal/repository"
+	"github.com/uw-labs/flaggio/internal/service"
+	"github.com/uw-labs/flaggio/internal/webhook"
 )
 
 var _ ResolverRoot = (*Resolver)(nil)
 
+// simulationPageSize is how many users are loaded at a time by flag
+// simulations.
+const simulationPageSize = 1000
+
 // Resolver is the root resolver for the GraphQL server.
 type Resolver struct {
-	FlagRepo       repository.Flag
-	VariantRepo    repository.Variant
-	RuleRepo       repository.Rule
-	SegmentRepo    repository.Segment
-	UserRepo       repository.User
-	EvaluationRepo repository.Evaluation
+	FlagRepo          repository.Flag
+	VariantRepo       repository.Variant
+	MetricRepo        repository.Metric
+	RuleRepo          repository.Rule
+	ChangeRequestRepo repository.ChangeRequest
+	SegmentRepo       repository.Segment
+	GroupRepo         repository.ExclusionGroup
+	UserRepo          repository.User
+	EvaluationRepo    repository.Evaluation
+	ExperimentRepo    repository.Experiment
+	UsageRepo         repository.FlagUsage
+	WebhookRepo       repository.Webhook
+	DeliveryRepo      repository.WebhookDelivery
+	FlagService       service.Flag
+	Notifier          webhook.Notifier
+	MaxSimulatedUsers int
+}
+
+// Flag returns the flag resolver.
+func (r *Resolver) Flag() FlagResolver {
+	return &flagResolver{r}
 }
 
 // Mutation returns the mutation resolver.
@@ -26,7 +47,17 @@ func (r *Resolver) Query() QueryResolver {
 	return &queryResolver{r}
 }
 
+// Segment returns the segment resolver.
+func (r *Resolver) Segment() SegmentResolver {
+	return &segmentResolver{r}
+}
+
 // User returns the user resolver.
 func (r *Resolver) User() UserResolver {
 	return &userResolver{r}
 }
+
+// Webhook returns the webhook resolver.
+func (r *Resolver) Webhook() WebhookResolver {
+	return &webhookResolver{r}
+}
@@ -0,0 +1,16 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+var _ SegmentResolver = &segmentResolver{}
+
+type segmentResolver struct{ *Resolver }
+
+// UsedBy returns the flags and flag rules that reference the segment.
+func (r *segmentResolver) UsedBy(ctx context.Context, sgmnt *flaggio.Segment) ([]*flaggio.SegmentUsage, error) {
+	return r.SegmentRepo.UsedBy(ctx, sgmnt.ID)
+}
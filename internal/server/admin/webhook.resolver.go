@@ -0,0 +1,25 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+var _ WebhookResolver = &webhookResolver{}
+
+type webhookResolver struct{ *Resolver }
+
+// Deliveries returns the list of deliveries for a given webhook.
+func (r *webhookResolver) Deliveries(ctx context.Context, wh *flaggio.Webhook, offset, limit *int) (*flaggio.WebhookDeliveryResults, error) {
+	var ofst, lmt *int64
+	if offset != nil {
+		v := int64(*offset)
+		ofst = &v
+	}
+	if limit != nil {
+		v := int64(*limit)
+		lmt = &v
+	}
+	return r.DeliveryRepo.FindAllByWebhookID(ctx, wh.ID, ofst, lmt)
+}
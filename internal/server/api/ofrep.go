@@ -0,0 +1,262 @@
+package api
+
+import (
+	"crypto/sha1" // nolint // only used for etags
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+	"github.com/opentracing/opentracing-go"
+	internalerrors "github.com/uw-labs/flaggio/internal/errors"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/service"
+)
+
+// OpenFeature Remote Evaluation Protocol (OFREP) constants.
+// See https://github.com/open-feature/protocol
+const (
+	ofrepErrParse               = "PARSE_ERROR"
+	ofrepErrTargetingKeyMissing = "TARGETING_KEY_MISSING"
+	ofrepErrFlagNotFound        = "FLAG_NOT_FOUND"
+	ofrepErrGeneral             = "GENERAL"
+
+	// ofrepReasonUnknown is used when the evaluation reason is unknown.
+	ofrepReasonUnknown = "UNKNOWN"
+
+	// ofrepTargetingKey is the property of the OFREP context that
+	// identifies the user.
+	ofrepTargetingKey = "targetingKey"
+)
+
+// POST /ofrep/v1/evaluate/flags/{key}
+// Evaluates a given flag for the user, following the OFREP specification
+func (s *Server) handleOFREPEvaluate(w http.ResponseWriter, r *http.Request) {
+	span, ctx := opentracing.StartSpanFromContext(r.Context(), "POST /ofrep/v1/evaluate/flags/{key}")
+	defer span.Finish()
+
+	flagKey := chi.URLParam(r, "key")
+	defer r.Body.Close()
+
+	// unmarshal JSON request
+	er, ofrepErr := bindOFREPRequest(r)
+	if ofrepErr != nil {
+		ofrepErr.Key = flagKey
+		_ = render.Render(w, r, ofrepErr)
+		return
+	}
+
+	// evaluate flag
+	eval, err := s.flagsService.Evaluate(ctx, flagKey, er)
+	if err != nil {
+		if !errors.Is(err, internalerrors.ErrNotFound) {
+			s.logger.WithError(err).WithField("req_id", middleware.GetReqID(ctx)).
+				Error("failed to evaluate flag")
+		}
+		_ = render.Render(w, r, formatOFREPErr(flagKey, err))
+		return
+	}
+
+	// render response
+	_ = render.Render(w, r, newOFREPEvaluation(eval.Evaluation))
+}
+
+// POST /ofrep/v1/evaluate/flags
+// Evaluates all flags for the user, following the OFREP specification
+func (s *Server) handleOFREPEvaluateAll(w http.ResponseWriter, r *http.Request) {
+	span, ctx := opentracing.StartSpanFromContext(r.Context(), "POST /ofrep/v1/evaluate/flags")
+	defer span.Finish()
+
+	defer r.Body.Close()
+
+	// unmarshal JSON request
+	er, ofrepErr := bindOFREPRequest(r)
+	if ofrepErr != nil {
+		_ = render.Render(w, r, ofrepErr)
+		return
+	}
+
+	// evaluate flags
+	evals, err := s.flagsService.EvaluateAll(ctx, er)
+	if err != nil {
+		s.logger.WithError(err).WithField("req_id", middleware.GetReqID(ctx)).
+			Error("failed to evaluate all")
+		_ = render.Render(w, r, formatOFREPErr("", err))
+		return
+	}
+
+	res := &ofrepBulkEvaluation{
+		Flags: make([]render.Renderer, len(evals.Evaluations)),
+	}
+	for idx, eval := range evals.Evaluations {
+		if eval.Error != "" {
+			res.Flags[idx] = &ofrepError{
+				Key:          eval.FlagKey,
+				ErrorCode:    ofrepErrGeneral,
+				ErrorDetails: eval.Error,
+			}
+			continue
+		}
+		res.Flags[idx] = newOFREPEvaluation(eval)
+	}
+
+	// clients can skip re-processing the flags if nothing changed
+	etag, err := res.etag()
+	if err != nil {
+		cannotRender := fmt.Errorf("%w: %s", internalerrors.ErrCannotRenderResponse, err)
+		_ = render.Render(w, r, formatOFREPErr("", cannotRender))
+		return
+	}
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	// render response
+	_ = render.Render(w, r, res)
+}
+
+// ofrepRequest is the OFREP evaluation request object.
+type ofrepRequest struct {
+	Context flaggio.UserContext `json:"context"`
+}
+
+// Bind is needed to satisfy the chi.Binder interface.
+func (req *ofrepRequest) Bind(r *http.Request) error {
+	return nil
+}
+
+// bindOFREPRequest parses the OFREP request and maps it to an
+// EvaluationRequest. The context targeting key is used as user ID
+// and all other properties are used as the user context.
+func bindOFREPRequest(r *http.Request) (*service.EvaluationRequest, *ofrepError) {
+	req := &ofrepRequest{
+		Context: make(flaggio.UserContext),
+	}
+	if err := render.Bind(r, req); err != nil {
+		return nil, &ofrepError{
+			StatusCode:   http.StatusBadRequest,
+			ErrorCode:    ofrepErrParse,
+			ErrorDetails: err.Error(),
+		}
+	}
+
+	targetingKey, ok := req.Context[ofrepTargetingKey]
+	if !ok || targetingKey == nil || targetingKey == "" {
+		return nil, &ofrepError{
+			StatusCode:   http.StatusBadRequest,
+			ErrorCode:    ofrepErrTargetingKeyMissing,
+			ErrorDetails: "targetingKey is required",
+		}
+	}
+	delete(req.Context, ofrepTargetingKey)
+
+	er := &service.EvaluationRequest{
+		UserID:      fmt.Sprintf("%v", targetingKey),
+		UserContext: req.Context,
+	}
+	if err := er.Bind(r); err != nil {
+		return nil, &ofrepError{
+			StatusCode:   http.StatusBadRequest,
+			ErrorCode:    ofrepErrParse,
+			ErrorDetails: err.Error(),
+		}
+	}
+	return er, nil
+}
+
+// ofrepEvaluation is the OFREP successful evaluation object. The name of
+// the rule that matched, if any, is returned in the metadata.
+type ofrepEvaluation struct {
+	Key      string                 `json:"key"`
+	Value    interface{}            `json:"value"`
+	Reason   string                 `json:"reason"`
+	Variant  string                 `json:"variant,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func newOFREPEvaluation(eval *flaggio.Evaluation) *ofrepEvaluation {
+	reason := string(eval.Reason)
+	if reason == "" {
+		// evaluations stored before reasons were recorded
+		reason = ofrepReasonUnknown
+	}
+	ofrepEval := &ofrepEvaluation{
+		Key:     eval.FlagKey,
+		Value:   eval.Value,
+		Reason:  reason,
+		Variant: eval.VariantID,
+	}
+	if eval.RuleName != "" {
+		ofrepEval.Metadata = map[string]interface{}{"ruleName": eval.RuleName}
+	}
+	return ofrepEval
+}
+
+// Render is needed to satisfy the chi.Renderer interface.
+func (e *ofrepEvaluation) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// ofrepBulkEvaluation is the OFREP bulk evaluation object. Flags contain
+// either an *ofrepEvaluation or an *ofrepError.
+type ofrepBulkEvaluation struct {
+	Flags []render.Renderer `json:"flags"`
+}
+
+// Render is needed to satisfy the chi.Renderer interface.
+func (e *ofrepBulkEvaluation) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// etag returns a hash of the evaluation results.
+func (e *ofrepBulkEvaluation) etag() (string, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+	h := sha1.New() // nolint // we don't care about security for this
+	if _, err := h.Write(b); err != nil {
+		return "", err
+	}
+	return `"` + hex.EncodeToString(h.Sum(nil)) + `"`, nil
+}
+
+// ofrepError is the OFREP error object.
+type ofrepError struct {
+	StatusCode   int    `json:"-"`
+	Key          string `json:"key,omitempty"`
+	ErrorCode    string `json:"errorCode"`
+	ErrorDetails string `json:"errorDetails,omitempty"`
+}
+
+// Render sets the response status code, if any.
+func (e *ofrepError) Render(w http.ResponseWriter, r *http.Request) error {
+	if e.StatusCode != 0 {
+		render.Status(r, e.StatusCode)
+	}
+	return nil
+}
+
+func formatOFREPErr(flagKey string, err error) *ofrepError {
+	res := &ofrepError{
+		StatusCode:   http.StatusInternalServerError,
+		Key:          flagKey,
+		ErrorCode:    ofrepErrGeneral,
+		ErrorDetails: err.Error(),
+	}
+	var e internalerrors.Err
+	switch {
+	case errors.Is(err, internalerrors.ErrNotFound):
+		res.StatusCode = http.StatusNotFound
+		res.ErrorCode = ofrepErrFlagNotFound
+	case errors.As(err, &e) && e.StatusCode() < http.StatusInternalServerError:
+		res.StatusCode = http.StatusBadRequest
+	}
+	return res
+}
@@ -0,0 +1,153 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/errors"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/server/api"
+	"github.com/uw-labs/flaggio/internal/service"
+	service_mock "github.com/uw-labs/flaggio/internal/service/mocks"
+)
+
+func TestServer_OFREPEvaluate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		body           string
+		evalResponse   *service.EvaluationResponse
+		evalError      error
+		evalCalls      int
+		expectedUserID string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "maps targeting key and returns the evaluation",
+			body: `{"context": {"targetingKey": "user1", "country": "UK"}}`,
+			evalResponse: &service.EvaluationResponse{Evaluation: &flaggio.Evaluation{
+				FlagKey: "a", Value: true, VariantID: "1", Reason: flaggio.ReasonTargetingMatch,
+			}},
+			evalCalls:      1,
+			expectedUserID: "user1",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"key":"a","value":true,"reason":"TARGETING_MATCH","variant":"1"}`,
+		},
+		{
+			name: "returns the name of the matching rule as metadata",
+			body: `{"context": {"targetingKey": "user1"}}`,
+			evalResponse: &service.EvaluationResponse{Evaluation: &flaggio.Evaluation{
+				FlagKey: "a", Value: true, VariantID: "1", Reason: flaggio.ReasonSplit, RuleName: "beta",
+			}},
+			evalCalls:      1,
+			expectedUserID: "user1",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"key":"a","value":true,"reason":"SPLIT","variant":"1","metadata":{"ruleName":"beta"}}`,
+		},
+		{
+			name:           "returns parse error on invalid json",
+			body:           `{"context": `,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"key":"a","errorCode":"PARSE_ERROR","errorDetails":"unexpected EOF"}`,
+		},
+		{
+			name:           "returns error when targeting key is missing",
+			body:           `{"context": {"country": "UK"}}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"key":"a","errorCode":"TARGETING_KEY_MISSING","errorDetails":"targetingKey is required"}`,
+		},
+		{
+			name:           "returns flag not found",
+			body:           `{"context": {"targetingKey": "user1"}}`,
+			evalError:      errors.NotFound("flag"),
+			evalCalls:      1,
+			expectedUserID: "user1",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"key":"a","errorCode":"FLAG_NOT_FOUND","errorDetails":"flag: not found"}`,
+		},
+		{
+			name:           "returns general error for configuration problems",
+			body:           `{"context": {"targetingKey": "user1"}}`,
+			evalError:      errors.ErrNoDefaultVariant,
+			evalCalls:      1,
+			expectedUserID: "user1",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"key":"a","errorCode":"GENERAL","errorDetails":"no default variant defined for flag"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			flagService := service_mock.NewMockFlag(mockCtrl)
+			flagService.EXPECT().
+				Evaluate(gomock.Any(), "a", gomock.Any()).
+				Times(tt.evalCalls).
+				DoAndReturn(func(_, _ interface{}, er *service.EvaluationRequest) (*service.EvaluationResponse, error) {
+					assert.Equal(t, tt.expectedUserID, er.UserID)
+					assert.NotContains(t, er.UserContext, "targetingKey")
+					return tt.evalResponse, tt.evalError
+				})
+			srv := api.NewServer(chi.NewRouter(), flagService, nil, logrus.NewEntry(logrus.New()))
+
+			req := httptest.NewRequest(http.MethodPost, "/ofrep/v1/evaluate/flags/a", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+		})
+	}
+}
+
+func TestServer_OFREPEvaluateAll(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	flagService := service_mock.NewMockFlag(mockCtrl)
+	flagService.EXPECT().
+		EvaluateAll(gomock.Any(), gomock.Any()).
+		Times(2).
+		Return(&service.EvaluationsResponse{Evaluations: flaggio.EvaluationList{
+			{FlagKey: "a", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault},
+			{FlagKey: "b", Error: "no default variant defined for flag"},
+		}}, nil)
+	srv := api.NewServer(chi.NewRouter(), flagService, nil, logrus.NewEntry(logrus.New()))
+
+	body := `{"context": {"targetingKey": "user1"}}`
+	req := httptest.NewRequest(http.MethodPost, "/ofrep/v1/evaluate/flags", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"flags": [
+		{"key":"a","value":10,"reason":"DEFAULT","variant":"1"},
+		{"key":"b","errorCode":"GENERAL","errorDetails":"no default variant defined for flag"}
+	]}`, rec.Body.String())
+	etag := rec.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	// same results with a matching etag are not sent again
+	req = httptest.NewRequest(http.MethodPost, "/ofrep/v1/evaluate/flags", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+}
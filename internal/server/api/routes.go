@@ -85,6 +85,66 @@ func (s *Server) handleEvaluateAll(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// POST /evaluate-batch
+// Evaluates all flags for each of the users
+func (s *Server) handleEvaluateBatch(w http.ResponseWriter, r *http.Request) {
+	span, ctx := opentracing.StartSpanFromContext(r.Context(), "POST /evaluate-batch")
+	defer span.Finish()
+
+	br := &service.BatchEvaluationRequest{}
+	defer r.Body.Close()
+
+	// unmarshal JSON request
+	if err := render.Bind(r, br); err != nil {
+		badRequest := internalerrors.BadRequest(err.Error())
+		_ = render.Render(w, r, formatErr(badRequest))
+		return
+	}
+
+	// evaluate flags
+	evals, err := s.flagsService.EvaluateBatch(ctx, br.Requests)
+	if err != nil {
+		s.logger.WithError(err).WithField("req_id", middleware.GetReqID(ctx)).
+			Error("failed to evaluate batch")
+		_ = render.Render(w, r, formatErr(err))
+		return
+	}
+
+	// render response
+	if err = render.Render(w, r, &service.BatchEvaluationResponse{Responses: evals}); err != nil {
+		cannotRender := fmt.Errorf("%w: %s", internalerrors.ErrCannotRenderResponse, err)
+		_ = render.Render(w, r, formatErr(cannotRender))
+		return
+	}
+}
+
+// POST /track
+// Tracks a conversion of the user on an experiment metric
+func (s *Server) handleTrack(w http.ResponseWriter, r *http.Request) {
+	span, ctx := opentracing.StartSpanFromContext(r.Context(), "POST /track")
+	defer span.Finish()
+
+	tr := &service.TrackRequest{}
+	defer r.Body.Close()
+
+	// unmarshal JSON request
+	if err := render.Bind(r, tr); err != nil {
+		badRequest := internalerrors.BadRequest(err.Error())
+		_ = render.Render(w, r, formatErr(badRequest))
+		return
+	}
+
+	// track conversion
+	if err := s.experimentsService.Track(ctx, tr); err != nil {
+		s.logger.WithError(err).WithField("req_id", middleware.GetReqID(ctx)).
+			Error("failed to track conversion")
+		_ = render.Render(w, r, formatErr(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 type errResponse struct {
 	Err        error  `json:"-"`               // low-level runtime error
 	StatusCode int    `json:"-"`               // http response status code
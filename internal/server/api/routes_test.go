@@ -0,0 +1,156 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/server/api"
+	"github.com/uw-labs/flaggio/internal/service"
+	service_mock "github.com/uw-labs/flaggio/internal/service/mocks"
+)
+
+func TestServer_Track(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		body           string
+		trackCalls     int
+		expectedStatus int
+	}{
+		{
+			name:           "tracks the conversion",
+			body:           `{"userId": "user1", "metric": "purchase"}`,
+			trackCalls:     1,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "requires the user id",
+			body:           `{"metric": "purchase"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "requires the metric",
+			body:           `{"userId": "user1"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			experimentService := service_mock.NewMockExperiment(mockCtrl)
+			experimentService.EXPECT().
+				Track(gomock.Any(), &service.TrackRequest{UserID: "user1", Metric: "purchase"}).
+				Times(tt.trackCalls).
+				Return(nil)
+			srv := api.NewServer(chi.NewRouter(), nil, experimentService, logrus.NewEntry(logrus.New()))
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/track", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestServer_EvaluateFlagKeyedBatch(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	flagService := service_mock.NewMockFlag(mockCtrl)
+	flagService.EXPECT().
+		Evaluate(gomock.Any(), "batch", gomock.Any()).
+		Times(1).
+		Return(&service.EvaluationResponse{
+			Evaluation: &flaggio.Evaluation{FlagKey: "batch", Value: true},
+		}, nil)
+	srv := api.NewServer(chi.NewRouter(), flagService, nil, logrus.NewEntry(logrus.New()))
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/evaluate/batch", strings.NewReader(`{"userId": "user1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestServer_EvaluateBatch(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		body           string
+		batchCalls     int
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "evaluates the flags for each user",
+			body:           `{"requests": [{"userId": "user1", "context": {"plan": "pro"}}, {"userId": "user2"}]}`,
+			batchCalls:     1,
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"responses":[{"evaluations":[{"flagKey":"a","value":true}]},` +
+				`{"evaluations":[{"flagKey":"a","value":false}]}]}`,
+		},
+		{
+			name:           "requires requests",
+			body:           `{"requests": []}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "refuses null requests",
+			body:           `{"requests": [null]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			flagService := service_mock.NewMockFlag(mockCtrl)
+			flagService.EXPECT().
+				EvaluateBatch(gomock.Any(), gomock.Any()).
+				Times(tt.batchCalls).
+				DoAndReturn(func(_ context.Context, reqs []*service.EvaluationRequest) ([]*service.EvaluationsResponse, error) {
+					// the requests are enriched, like single evaluation requests
+					assert.Len(t, reqs, 2)
+					assert.Equal(t, "user1", reqs[0].UserContext["$userId"])
+					assert.Equal(t, "pro", reqs[0].UserContext["plan"])
+					assert.Equal(t, "user2", reqs[1].UserContext["$userId"])
+					return []*service.EvaluationsResponse{
+						{Evaluations: flaggio.EvaluationList{{FlagKey: "a", Value: true}}},
+						{Evaluations: flaggio.EvaluationList{{FlagKey: "a", Value: false}}},
+					}, nil
+				})
+			srv := api.NewServer(chi.NewRouter(), flagService, nil, logrus.NewEntry(logrus.New()))
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/evaluate-batch", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -12,12 +12,14 @@ import (
 func NewServer(
 	router chi.Router,
 	flagsService service.Flag,
+	experimentsService service.Experiment,
 	logger *logrus.Entry,
 ) *Server {
 	srv := &Server{
-		router:       router,
-		flagsService: flagsService,
-		logger:       logger,
+		router:             router,
+		flagsService:       flagsService,
+		experimentsService: experimentsService,
+		logger:             logger,
 	}
 	srv.routes()
 	return srv
@@ -25,9 +27,10 @@ func NewServer(
 
 // Server handles evaluation requests
 type Server struct {
-	router       chi.Router
-	flagsService service.Flag
-	logger       *logrus.Entry
+	router             chi.Router
+	flagsService       service.Flag
+	experimentsService service.Experiment
+	logger             *logrus.Entry
 }
 
 // ServeHTTP responds to an HTTP request
@@ -40,6 +43,13 @@ func (s *Server) routes() {
 	// API version 1
 	s.router.Route("/v1", func(r chi.Router) {
 		r.Post("/evaluate", s.handleEvaluateAll)
+		r.Post("/evaluate-batch", s.handleEvaluateBatch)
 		r.Post("/evaluate/{key}", s.handleEvaluate)
+		r.Post("/track", s.handleTrack)
+	})
+	// OpenFeature Remote Evaluation Protocol
+	s.router.Route("/ofrep/v1", func(r chi.Router) {
+		r.Post("/evaluate/flags", s.handleOFREPEvaluateAll)
+		r.Post("/evaluate/flags/{key}", s.handleOFREPEvaluate)
 	})
 }
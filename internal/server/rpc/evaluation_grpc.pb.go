@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package rpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// FlagServiceClient is the client API for FlagService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FlagServiceClient interface {
+	// Evaluate returns the result of an evaluation of a single flag.
+	Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error)
+	// EvaluateAll returns the results of the evaluation of all flags.
+	EvaluateAll(ctx context.Context, in *EvaluateAllRequest, opts ...grpc.CallOption) (*EvaluateAllResponse, error)
+	// Watch evaluates all flags and streams the results again every
+	// time any of the evaluations change.
+	Watch(ctx context.Context, in *EvaluateAllRequest, opts ...grpc.CallOption) (FlagService_WatchClient, error)
+}
+
+type flagServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFlagServiceClient(cc grpc.ClientConnInterface) FlagServiceClient {
+	return &flagServiceClient{cc}
+}
+
+func (c *flagServiceClient) Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error) {
+	out := new(EvaluateResponse)
+	err := c.cc.Invoke(ctx, "/flaggio.v1.FlagService/Evaluate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flagServiceClient) EvaluateAll(ctx context.Context, in *EvaluateAllRequest, opts ...grpc.CallOption) (*EvaluateAllResponse, error) {
+	out := new(EvaluateAllResponse)
+	err := c.cc.Invoke(ctx, "/flaggio.v1.FlagService/EvaluateAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flagServiceClient) Watch(ctx context.Context, in *EvaluateAllRequest, opts ...grpc.CallOption) (FlagService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FlagService_serviceDesc.Streams[0], "/flaggio.v1.FlagService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flagServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlagService_WatchClient interface {
+	Recv() (*EvaluateAllResponse, error)
+	grpc.ClientStream
+}
+
+type flagServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *flagServiceWatchClient) Recv() (*EvaluateAllResponse, error) {
+	m := new(EvaluateAllResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// FlagServiceServer is the server API for FlagService service.
+// All implementations must embed UnimplementedFlagServiceServer
+// for forward compatibility
+type FlagServiceServer interface {
+	// Evaluate returns the result of an evaluation of a single flag.
+	Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error)
+	// EvaluateAll returns the results of the evaluation of all flags.
+	EvaluateAll(context.Context, *EvaluateAllRequest) (*EvaluateAllResponse, error)
+	// Watch evaluates all flags and streams the results again every
+	// time any of the evaluations change.
+	Watch(*EvaluateAllRequest, FlagService_WatchServer) error
+	mustEmbedUnimplementedFlagServiceServer()
+}
+
+// UnimplementedFlagServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFlagServiceServer struct {
+}
+
+func (UnimplementedFlagServiceServer) Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evaluate not implemented")
+}
+func (UnimplementedFlagServiceServer) EvaluateAll(context.Context, *EvaluateAllRequest) (*EvaluateAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateAll not implemented")
+}
+func (UnimplementedFlagServiceServer) Watch(*EvaluateAllRequest, FlagService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedFlagServiceServer) mustEmbedUnimplementedFlagServiceServer() {}
+
+// UnsafeFlagServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FlagServiceServer will
+// result in compilation errors.
+type UnsafeFlagServiceServer interface {
+	mustEmbedUnimplementedFlagServiceServer()
+}
+
+func RegisterFlagServiceServer(s grpc.ServiceRegistrar, srv FlagServiceServer) {
+	s.RegisterService(&_FlagService_serviceDesc, srv)
+}
+
+func _FlagService_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlagServiceServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flaggio.v1.FlagService/Evaluate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlagServiceServer).Evaluate(ctx, req.(*EvaluateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlagService_EvaluateAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlagServiceServer).EvaluateAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flaggio.v1.FlagService/EvaluateAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlagServiceServer).EvaluateAll(ctx, req.(*EvaluateAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlagService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EvaluateAllRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlagServiceServer).Watch(m, &flagServiceWatchServer{stream})
+}
+
+type FlagService_WatchServer interface {
+	Send(*EvaluateAllResponse) error
+	grpc.ServerStream
+}
+
+type flagServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *flagServiceWatchServer) Send(m *EvaluateAllResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _FlagService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "flaggio.v1.FlagService",
+	HandlerType: (*FlagServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Evaluate",
+			Handler:    _FlagService_Evaluate_Handler,
+		},
+		{
+			MethodName: "EvaluateAll",
+			Handler:    _FlagService_EvaluateAll_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _FlagService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "evaluation.proto",
+}
@@ -0,0 +1,199 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+	internalerrors "github.com/uw-labs/flaggio/internal/errors"
+	"github.com/uw-labs/flaggio/internal/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ FlagServiceServer = (*Server)(nil)
+
+// NewServer returns a new gRPC server object. Watch streams check
+// for changes in the evaluations every watchInterval.
+func NewServer(
+	flagsService service.Flag,
+	watchInterval time.Duration,
+	logger *logrus.Entry,
+) *Server {
+	return &Server{
+		flagsService:  flagsService,
+		watchInterval: watchInterval,
+		logger:        logger,
+	}
+}
+
+// Server handles gRPC evaluation requests
+type Server struct {
+	UnimplementedFlagServiceServer
+	flagsService  service.Flag
+	watchInterval time.Duration
+	logger        *logrus.Entry
+}
+
+// Register registers the flag service on the gRPC server.
+func (s *Server) Register(srv *grpc.Server) {
+	RegisterFlagServiceServer(srv, s)
+}
+
+// Evaluate evaluates a given flag for the user
+func (s *Server) Evaluate(ctx context.Context, req *EvaluateRequest) (*EvaluateResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "FlagService/Evaluate")
+	defer span.Finish()
+
+	er, err := newEvaluationRequest(ctx, req.UserId, req.Context, req.Debug)
+	if err != nil {
+		return nil, err
+	}
+
+	// evaluate flag
+	eval, err := s.flagsService.Evaluate(ctx, req.FlagKey, er)
+	if err != nil {
+		s.logger.WithError(err).Error("failed to evaluate flag")
+		return nil, formatErr(err)
+	}
+
+	evltn, err := newEvaluation(eval.Evaluation)
+	if err != nil {
+		return nil, formatErr(err)
+	}
+	return &EvaluateResponse{Evaluation: evltn}, nil
+}
+
+// EvaluateAll evaluates all flags for the user
+func (s *Server) EvaluateAll(ctx context.Context, req *EvaluateAllRequest) (*EvaluateAllResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "FlagService/EvaluateAll")
+	defer span.Finish()
+
+	res, err := s.evaluateAll(ctx, req, false)
+	if err != nil {
+		s.logger.WithError(err).Error("failed to evaluate all")
+		return nil, formatErr(err)
+	}
+	return res, nil
+}
+
+// Watch evaluates all flags for the user and sends the results to the
+// stream. The flags are evaluated again periodically and the results are
+// only sent when they change. The periodic checks save their evaluations,
+// so they are reused by the next checks, but they only emit exposures when
+// the results change and are sent to the user.
+func (s *Server) Watch(req *EvaluateAllRequest, stream FlagService_WatchServer) error {
+	ctx := stream.Context()
+	ticker := time.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+
+	var last *EvaluateAllResponse
+	for {
+		res, err := s.evaluateAll(ctx, req, last != nil)
+		if err != nil {
+			s.logger.WithError(err).Error("failed to watch evaluations")
+			return formatErr(err)
+		}
+		if last != nil && !proto.Equal(last, res) {
+			// the user is served the new results, so they are exposed to
+			// them. the evaluations saved by the check are reused
+			res, err = s.evaluateAll(ctx, req, false)
+			if err != nil {
+				s.logger.WithError(err).Error("failed to watch evaluations")
+				return formatErr(err)
+			}
+		}
+		if last == nil || !proto.Equal(last, res) {
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+			last = res
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Server) evaluateAll(ctx context.Context, req *EvaluateAllRequest, skipExposures bool) (*EvaluateAllResponse, error) {
+	er, err := newEvaluationRequest(ctx, req.UserId, req.Context, req.Debug)
+	if err != nil {
+		return nil, err
+	}
+	er.SkipExposures = skipExposures
+
+	// evaluate flags
+	evals, err := s.flagsService.EvaluateAll(ctx, er)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &EvaluateAllResponse{
+		Evaluations: make([]*Evaluation, len(evals.Evaluations)),
+	}
+	for idx, eval := range evals.Evaluations {
+		evltn, err := newEvaluation(eval)
+		if err != nil {
+			return nil, err
+		}
+		res.Evaluations[idx] = evltn
+	}
+	return res, nil
+}
+
+// newEvaluationRequest builds the service evaluation request, adding the
+// same special fields to the user context as the HTTP API.
+func newEvaluationRequest(ctx context.Context, userID string, usrContext map[string]*Value, debug bool) (*service.EvaluationRequest, error) {
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	usrCtx, err := asUserContext(usrContext)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	er := &service.EvaluationRequest{
+		UserID:      userID,
+		UserContext: usrCtx,
+	}
+	if debug {
+		er.Debug = &debug
+	}
+	var ip net.IP
+	if p, ok := peer.FromContext(ctx); ok {
+		if addr, ok := p.Addr.(*net.TCPAddr); ok {
+			ip = addr.IP
+		}
+	}
+	er.Enrich(ip)
+	return er, nil
+}
+
+// formatErr converts application errors to gRPC status errors.
+func formatErr(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var e internalerrors.Err
+	if !errors.As(err, &e) {
+		return status.Error(codes.Internal, err.Error())
+	}
+	switch {
+	case errors.Is(err, internalerrors.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, internalerrors.ErrBadRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, internalerrors.ErrNotImplemented):
+		return status.Error(codes.Unimplemented, err.Error())
+	default:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+}
@@ -0,0 +1,228 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uw-labs/flaggio/internal/errors"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/server/rpc"
+	"github.com/uw-labs/flaggio/internal/service"
+	service_mock "github.com/uw-labs/flaggio/internal/service/mocks"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestServer_Evaluate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		req              *rpc.EvaluateRequest
+		evalResponse     *service.EvaluationResponse
+		evalError        error
+		evalCalls        int
+		expectedContext  map[string]interface{}
+		expectedResponse *rpc.EvaluateResponse
+		expectedCode     codes.Code
+	}{
+		{
+			name: "converts typed context and evaluation",
+			req: &rpc.EvaluateRequest{
+				FlagKey: "a",
+				UserId:  "user1",
+				Context: map[string]*rpc.Value{
+					"age":  {Kind: &rpc.Value_IntValue{IntValue: 30}},
+					"code": {Kind: &rpc.Value_StringValue{StringValue: "123"}},
+					"groups": {Kind: &rpc.Value_ListValue{ListValue: &rpc.ListValue{Values: []*rpc.Value{
+						{Kind: &rpc.Value_StringValue{StringValue: "beta"}},
+					}}}},
+					"company": {Kind: &rpc.Value_ObjectValue{ObjectValue: &rpc.ObjectValue{Fields: map[string]*rpc.Value{
+						"plan": {Kind: &rpc.Value_StringValue{StringValue: "pro"}},
+					}}}},
+				},
+			},
+			expectedContext: map[string]interface{}{
+				"age":     int64(30),
+				"code":    "123",
+				"groups":  []interface{}{"beta"},
+				"company": map[string]interface{}{"plan": "pro"},
+			},
+			evalResponse: &service.EvaluationResponse{Evaluation: &flaggio.Evaluation{
+				FlagKey: "a", Value: 1.5, VariantID: "1", Reason: flaggio.ReasonTargetingMatch,
+			}},
+			evalCalls: 1,
+			expectedResponse: &rpc.EvaluateResponse{Evaluation: &rpc.Evaluation{
+				FlagKey:   "a",
+				Value:     &rpc.Value{Kind: &rpc.Value_DoubleValue{DoubleValue: 1.5}},
+				VariantId: "1",
+				Reason:    "TARGETING_MATCH",
+			}},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "requires user id",
+			req:          &rpc.EvaluateRequest{FlagKey: "a"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "rejects empty context values",
+			req:          &rpc.EvaluateRequest{FlagKey: "a", UserId: "user1", Context: map[string]*rpc.Value{"x": {}}},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "returns not found",
+			req:          &rpc.EvaluateRequest{FlagKey: "a", UserId: "user1"},
+			evalError:    errors.NotFound("flag"),
+			evalCalls:    1,
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "returns failed precondition for configuration problems",
+			req:          &rpc.EvaluateRequest{FlagKey: "a", UserId: "user1"},
+			evalError:    errors.ErrNoDefaultVariant,
+			evalCalls:    1,
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			flagService := service_mock.NewMockFlag(mockCtrl)
+			flagService.EXPECT().
+				Evaluate(gomock.Any(), "a", gomock.Any()).
+				Times(tt.evalCalls).
+				DoAndReturn(func(_, _ interface{}, er *service.EvaluationRequest) (*service.EvaluationResponse, error) {
+					assert.Equal(t, "user1", er.UserID)
+					assert.Equal(t, "user1", er.UserContext["$userId"])
+					for k, v := range tt.expectedContext {
+						assert.Equal(t, v, er.UserContext[k])
+					}
+					return tt.evalResponse, tt.evalError
+				})
+			client := newTestClient(t, flagService)
+
+			res, err := client.Evaluate(context.Background(), tt.req)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedResponse != nil {
+				assert.True(t, proto.Equal(tt.expectedResponse, res), "unexpected response: %v", res)
+			}
+		})
+	}
+}
+
+func TestServer_EvaluateAll(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	id := "1"
+	flagService := service_mock.NewMockFlag(mockCtrl)
+	flagService.EXPECT().
+		EvaluateAll(gomock.Any(), gomock.Any()).
+		Return(&service.EvaluationsResponse{Evaluations: flaggio.EvaluationList{
+			{FlagKey: "a", Value: true, VariantID: "1", Reason: flaggio.ReasonDefault,
+				StackTrace: []*flaggio.StackTrace{{Type: "*flaggio.Flag", ID: &id, Answer: true}}},
+			{FlagKey: "b", Error: "no default variant defined for flag"},
+		}}, nil)
+	client := newTestClient(t, flagService)
+
+	res, err := client.EvaluateAll(context.Background(), &rpc.EvaluateAllRequest{UserId: "user1", Debug: true})
+	require.NoError(t, err)
+	expected := &rpc.EvaluateAllResponse{Evaluations: []*rpc.Evaluation{
+		{
+			FlagKey:   "a",
+			Value:     &rpc.Value{Kind: &rpc.Value_BoolValue{BoolValue: true}},
+			VariantId: "1",
+			Reason:    "DEFAULT",
+			StackTrace: []*rpc.StackTrace{{
+				Type: "*flaggio.Flag", Id: "1",
+				Answer: &rpc.Value{Kind: &rpc.Value_BoolValue{BoolValue: true}},
+			}},
+		},
+		{FlagKey: "b", Error: "no default variant defined for flag"},
+	}}
+	assert.True(t, proto.Equal(expected, res), "unexpected response: %v", res)
+}
+
+func TestServer_Watch(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	evals := []flaggio.EvaluationList{
+		{{FlagKey: "a", Value: "x"}},
+		{{FlagKey: "a", Value: "x"}},
+		{{FlagKey: "a", Value: "y"}},
+	}
+	var mu sync.Mutex
+	checks, exposed := 0, 0
+	flagService := service_mock.NewMockFlag(mockCtrl)
+	flagService.EXPECT().
+		EvaluateAll(gomock.Any(), gomock.Any()).
+		MinTimes(len(evals)).
+		DoAndReturn(func(_ context.Context, req *service.EvaluationRequest) (*service.EvaluationsResponse, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			// the periodic checks are saved, so they are reused
+			assert.False(t, req.IsDebug(), "unexpected debug evaluation")
+			if req.SkipExposures {
+				checks++
+			} else {
+				exposed++
+			}
+			if checks >= len(evals) {
+				return &service.EvaluationsResponse{Evaluations: evals[len(evals)-1]}, nil
+			}
+			return &service.EvaluationsResponse{Evaluations: evals[checks]}, nil
+		})
+	client := newTestClient(t, flagService)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.Watch(ctx, &rpc.EvaluateAllRequest{UserId: "user1"})
+	require.NoError(t, err)
+
+	// unchanged evaluations are not sent again
+	res, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "x", res.Evaluations[0].Value.GetStringValue())
+	res, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "y", res.Evaluations[0].Value.GetStringValue())
+
+	// only the evaluations sent to the user emit exposures
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, exposed)
+}
+
+func newTestClient(t *testing.T, flagService service.Flag) rpc.FlagServiceClient {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	rpc.NewServer(flagService, 10*time.Millisecond, logrus.NewEntry(logrus.New())).Register(srv)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure(),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return rpc.NewFlagServiceClient(conn)
+}
@@ -0,0 +1,142 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+// asUserContext converts the typed context values from the request
+// to a user context. Unlike the JSON API, no type guessing is done.
+func asUserContext(values map[string]*Value) (flaggio.UserContext, error) {
+	usrCtx := make(flaggio.UserContext, len(values))
+	for key, value := range values {
+		v, err := value.asInterface()
+		if err != nil {
+			return nil, fmt.Errorf("context property %s: %w", key, err)
+		}
+		usrCtx[key] = v
+	}
+	return usrCtx, nil
+}
+
+// asInterface returns the Go value held by the Value.
+func (x *Value) asInterface() (interface{}, error) {
+	switch v := x.GetKind().(type) {
+	case *Value_StringValue:
+		return v.StringValue, nil
+	case *Value_IntValue:
+		return v.IntValue, nil
+	case *Value_DoubleValue:
+		return v.DoubleValue, nil
+	case *Value_BoolValue:
+		return v.BoolValue, nil
+	case *Value_ListValue:
+		list := make([]interface{}, len(v.ListValue.GetValues()))
+		for idx, elem := range v.ListValue.GetValues() {
+			value, err := elem.asInterface()
+			if err != nil {
+				return nil, err
+			}
+			list[idx] = value
+		}
+		return list, nil
+	case *Value_ObjectValue:
+		obj := make(map[string]interface{}, len(v.ObjectValue.GetFields()))
+		for key, elem := range v.ObjectValue.GetFields() {
+			value, err := elem.asInterface()
+			if err != nil {
+				return nil, err
+			}
+			obj[key] = value
+		}
+		return obj, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+// newValue converts a Go value to a Value. A nil value returns a nil Value.
+func newValue(value interface{}) (*Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return &Value{Kind: &Value_StringValue{StringValue: v}}, nil
+	case bool:
+		return &Value{Kind: &Value_BoolValue{BoolValue: v}}, nil
+	case int:
+		return &Value{Kind: &Value_IntValue{IntValue: int64(v)}}, nil
+	case int32:
+		return &Value{Kind: &Value_IntValue{IntValue: int64(v)}}, nil
+	case int64:
+		return &Value{Kind: &Value_IntValue{IntValue: v}}, nil
+	case float32:
+		return &Value{Kind: &Value_DoubleValue{DoubleValue: float64(v)}}, nil
+	case float64:
+		return &Value{Kind: &Value_DoubleValue{DoubleValue: v}}, nil
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return &Value{Kind: &Value_IntValue{IntValue: n}}, nil
+		}
+		n, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return &Value{Kind: &Value_DoubleValue{DoubleValue: n}}, nil
+	case []interface{}:
+		list := &ListValue{Values: make([]*Value, len(v))}
+		for idx, elem := range v {
+			value, err := newValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			list.Values[idx] = value
+		}
+		return &Value{Kind: &Value_ListValue{ListValue: list}}, nil
+	case map[string]interface{}:
+		obj := &ObjectValue{Fields: make(map[string]*Value, len(v))}
+		for key, elem := range v {
+			value, err := newValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			obj.Fields[key] = value
+		}
+		return &Value{Kind: &Value_ObjectValue{ObjectValue: obj}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+// newEvaluation converts a flag evaluation to its protobuf representation.
+func newEvaluation(eval *flaggio.Evaluation) (*Evaluation, error) {
+	value, err := newValue(eval.Value)
+	if err != nil {
+		return nil, fmt.Errorf("flag %s: %w", eval.FlagKey, err)
+	}
+	evltn := &Evaluation{
+		FlagKey:   eval.FlagKey,
+		Value:     value,
+		VariantId: eval.VariantID,
+		Reason:    string(eval.Reason),
+		Error:     eval.Error,
+		RuleName:  eval.RuleName,
+	}
+	for _, st := range eval.StackTrace {
+		answer, err := newValue(st.Answer)
+		if err != nil {
+			return nil, fmt.Errorf("flag %s: %w", eval.FlagKey, err)
+		}
+		trace := &StackTrace{Type: st.Type, Answer: answer}
+		if st.ID != nil {
+			trace.Id = *st.ID
+		}
+		if st.Name != nil {
+			trace.Name = *st.Name
+		}
+		evltn.StackTrace = append(evltn.StackTrace, trace)
+	}
+	return evltn, nil
+}
@@ -0,0 +1,13 @@
+package service
+
+//go:generate mockgen -destination=./mocks/experiment_mock.go -package=service_mock github.com/uw-labs/flaggio/internal/service Experiment
+
+import (
+	"context"
+)
+
+// Experiment holds the logic for tracking experiments
+type Experiment interface {
+	// Track records a conversion of a user on a metric.
+	Track(ctx context.Context, req *TrackRequest) error
+}
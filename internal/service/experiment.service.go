@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uw-labs/flaggio/internal/errors"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/repository"
+)
+
+var _ Experiment = (*experimentService)(nil)
+
+// NewExperimentService returns a new Experiment service
+func NewExperimentService(
+	flagsRepo repository.Flag,
+	experimentsRepo repository.Experiment,
+) Experiment {
+	return &experimentService{
+		flagsRepo:       flagsRepo,
+		experimentsRepo: experimentsRepo,
+	}
+}
+
+type experimentService struct {
+	flagsRepo       repository.Flag
+	experimentsRepo repository.Experiment
+}
+
+// Track records a conversion of a user on a metric. The metric must be
+// defined by at least one flag.
+func (s *experimentService) Track(ctx context.Context, req *TrackRequest) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ExperimentService.Track")
+	defer span.Finish()
+
+	flgs, err := s.flagsRepo.FindAll(ctx, nil, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	if !hasMetric(flgs.Flags, req.Metric) {
+		return errors.NotFound("metric")
+	}
+
+	return s.experimentsRepo.CreateConversion(ctx, flaggio.Conversion{
+		UserID:    req.UserID,
+		MetricKey: req.Metric,
+		CreatedAt: time.Now(),
+	})
+}
+
+// hasMetric returns whether any of the flags has a metric with the given key.
+func hasMetric(flgs []*flaggio.Flag, metricKey string) bool {
+	for _, flg := range flgs {
+		for _, mtrc := range flg.Metrics {
+			if mtrc.Key == metricKey {
+				return true
+			}
+		}
+	}
+	return false
+}
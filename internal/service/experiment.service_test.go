@@ -0,0 +1,71 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	repository_mock "github.com/uw-labs/flaggio/internal/repository/mocks"
+	"github.com/uw-labs/flaggio/internal/service"
+)
+
+func TestExperimentService_Track(t *testing.T) {
+	t.Parallel()
+	flgs := &flaggio.FlagResults{Flags: []*flaggio.Flag{
+		{ID: "1", Key: "a"},
+		{ID: "2", Key: "b", Experiment: true, Metrics: []*flaggio.Metric{{ID: "3", Key: "purchase"}}},
+	}}
+	tests := []struct {
+		name            string
+		metric          string
+		conversionCalls int
+		expectedError   string
+	}{
+		{
+			name:            "records the conversion",
+			metric:          "purchase",
+			conversionCalls: 1,
+		},
+		{
+			name:          "rejects unknown metrics",
+			metric:        "signup",
+			expectedError: "metric: not found",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			flagRepo := repository_mock.NewMockFlag(mockCtrl)
+			experimentRepo := repository_mock.NewMockExperiment(mockCtrl)
+			experimentService := service.NewExperimentService(flagRepo, experimentRepo)
+
+			flagRepo.EXPECT().
+				FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
+				Times(1).
+				Return(flgs, nil)
+			experimentRepo.EXPECT().
+				CreateConversion(gomock.AssignableToTypeOf(ctxInterface), gomock.Any()).
+				Times(tt.conversionCalls).
+				DoAndReturn(func(_ context.Context, conversion flaggio.Conversion) error {
+					assert.Equal(t, "u1", conversion.UserID)
+					assert.Equal(t, tt.metric, conversion.MetricKey)
+					assert.WithinDuration(t, time.Now(), conversion.CreatedAt, time.Minute)
+					return nil
+				})
+
+			err := experimentService.Track(context.Background(), &service.TrackRequest{UserID: "u1", Metric: tt.metric})
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -6,10 +6,25 @@ import (
 	"context"
 )
 
+// ArchivedFlagResponse is how archived flags are evaluated.
+type ArchivedFlagResponse string
+
+// List of archived flag responses
+const (
+	// ArchivedFlagResponseError returns an error when an archived flag is evaluated.
+	ArchivedFlagResponseError ArchivedFlagResponse = "error"
+	// ArchivedFlagResponseOff evaluates archived flags as if they were disabled.
+	ArchivedFlagResponseOff ArchivedFlagResponse = "off"
+)
+
 // Flag holds the logic for evaluating flags
 type Flag interface {
 	// Evaluate returns the result of an evaluation of a single flag.
 	Evaluate(ctx context.Context, flagKey string, req *EvaluationRequest) (*EvaluationResponse, error)
-	// EvaluateAll returns the results of the evaluation of all flags.
+	// EvaluateAll returns the results of the evaluation of all flags, or of the
+	// flags matching the filters of the request.
 	EvaluateAll(ctx context.Context, req *EvaluationRequest) (*EvaluationsResponse, error)
+	// EvaluateBatch returns the results of the evaluation of all flags for
+	// each of the requests, in the same order.
+	EvaluateBatch(ctx context.Context, reqs []*EvaluationRequest) ([]*EvaluationsResponse, error)
 }
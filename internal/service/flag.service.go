@@ -3,35 +3,55 @@ package service
 import (
 	"context"
 	"errors"
+	"sync"
 
 	"github.com/opentracing/opentracing-go"
 	apperrors "github.com/uw-labs/flaggio/internal/errors"
+	"github.com/uw-labs/flaggio/internal/exposure"
 	"github.com/uw-labs/flaggio/internal/flaggio"
 	"github.com/uw-labs/flaggio/internal/repository"
 )
 
 var _ Flag = (*flagService)(nil)
 
-// NewFlagService returns a new Flag service
+// batchConcurrency is how many requests of a batch are evaluated at the same time.
+const batchConcurrency = 16
+
+// NewFlagService returns a new Flag service. No exposure events are emitted
+// if exposures is nil.
 func NewFlagService(
 	flagsRepo repository.Flag,
 	segmentsRepo repository.Segment,
+	groupsRepo repository.ExclusionGroup,
 	evalsRepo repository.Evaluation,
 	usersRepo repository.User,
+	exposures exposure.Emitter,
+	archivedResponse ArchivedFlagResponse,
 ) Flag {
+	if exposures == nil {
+		exposures = exposure.NopEmitter{}
+	}
 	return &flagService{
-		flagsRepo:    flagsRepo,
-		segmentsRepo: segmentsRepo,
-		evalsRepo:    evalsRepo,
-		usersRepo:    usersRepo,
+		flagsRepo:        flagsRepo,
+		segmentsRepo:     segmentsRepo,
+		groupsRepo:       groupsRepo,
+		evalsRepo:        evalsRepo,
+		usersRepo:        usersRepo,
+		exposures:        exposures,
+		archivedResponse: archivedResponse,
+		plans:            newPlanCache(),
 	}
 }
 
 type flagService struct {
-	flagsRepo    repository.Flag
-	segmentsRepo repository.Segment
-	evalsRepo    repository.Evaluation
-	usersRepo    repository.User
+	flagsRepo        repository.Flag
+	segmentsRepo     repository.Segment
+	groupsRepo       repository.ExclusionGroup
+	evalsRepo        repository.Evaluation
+	usersRepo        repository.User
+	exposures        exposure.Emitter
+	archivedResponse ArchivedFlagResponse
+	plans            *planCache
 }
 
 // Evaluate evaluates a flag by key, returning a value based on the user context
@@ -44,27 +64,37 @@ func (s *flagService) Evaluate(ctx context.Context, flagKey string, req *Evaluat
 	if err != nil {
 		return nil, err
 	}
-	// fetch previous evaluations for this flag
+	if flg.Archived {
+		if s.archivedResponse != ArchivedFlagResponseOff {
+			return nil, apperrors.ErrFlagArchived
+		}
+		flg.Enabled = false
+	}
+	// fetch previous evaluations for this flag. debug requests are always
+	// evaluated, so they have a stack trace
 	hash, err := req.Hash()
 	if err != nil {
 		return nil, err
 	}
-	eval, err := s.evalsRepo.FindByReqHashAndFlagKey(ctx, hash, flg.Key)
-	if err != nil && !errors.Is(err, apperrors.ErrNotFound) {
-		return nil, err
+	var eval *flaggio.Evaluation
+	if !req.IsDebug() {
+		eval, err = s.evalsRepo.FindByReqHashAndFlagKey(ctx, hash, flg.Key)
+		if err != nil && !errors.Is(err, apperrors.ErrNotFound) {
+			return nil, err
+		}
 	}
 
 	// if there are no previous evaluations, evaluate the flag
 	if invalidEval(hash, flg, eval) {
-		sgmts, err := segmentsAsIdentifiers(s.segmentsRepo.FindAll(ctx, nil, nil))
+		sgmts, err := s.planSegments(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		flg.Populate(sgmts)
+		plan := s.plans.get(flg, sgmts)
 
 		evalSpan, _ := opentracing.StartSpanFromContext(ctx, "flaggio.Evaluate")
-		res, err := flaggio.Evaluate(req.UserContext, flg)
+		res, err := plan.Evaluate(req.UserContext)
 		evalSpan.Finish()
 		if err != nil {
 			return nil, err
@@ -76,6 +106,13 @@ func (s *flagService) Evaluate(ctx context.Context, flagKey string, req *Evaluat
 			FlagKey:     flg.Key,
 			RequestHash: hash,
 			Value:       res.Answer,
+			Reason:      res.Reason,
+		}
+		if res.Variant != nil {
+			eval.VariantID = res.Variant.ID
+		}
+		if rl := res.Rule(); rl != nil {
+			eval.RuleName = rl.GetName()
 		}
 		if req.IsDebug() {
 			eval.StackTrace = res.Stack()
@@ -91,6 +128,12 @@ func (s *flagService) Evaluate(ctx context.Context, flagKey string, req *Evaluat
 		}
 	}
 
+	// debug evaluations are not exposed to the user, and neither are the
+	// users excluded from the flag by its exclusion group
+	if req.emitsExposures() && eval.Reason != flaggio.ReasonExcluded {
+		s.exposures.Emit(exposure.NewEvent(req.UserID, flg, eval))
+	}
+
 	// build the response
 	evalRes := &EvaluationResponse{
 		Evaluation: eval,
@@ -103,44 +146,132 @@ func (s *flagService) Evaluate(ctx context.Context, flagKey string, req *Evaluat
 	return evalRes, nil
 }
 
-// EvaluateAll evaluates all flags, returning a value or an error for each flag based on the user context
+// EvaluateAll evaluates all flags, or the flags matching the filters in the request, returning a
+// value or an error for each flag based on the user context
 func (s *flagService) EvaluateAll(ctx context.Context, req *EvaluationRequest) (*EvaluationsResponse, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "FlagService.EvaluateAll")
 	defer span.Finish()
 
-	// fetch previous evaluations
-	hash, err := req.Hash()
+	// fetch all flags, archived flags are not included. the flags are
+	// filtered here, so the unfiltered list can be cached
+	flgs, err := s.flagsRepo.FindAll(ctx, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	prevEvals, err := s.evalsRepo.FindAllByReqHash(ctx, hash)
+	s.plans.prune(flgs.Flags)
+	// fetch segments and exclusion groups
+	sgmts, err := s.planSegments(ctx)
 	if err != nil {
 		return nil, err
 	}
-	// fetch all flags
-	flgs, err := s.flagsRepo.FindAll(ctx, nil, nil, nil)
+
+	return s.evaluateFlags(ctx, req, filterFlags(flgs.Flags, req.FlagFilter()), func(flg *flaggio.Flag) *flaggio.Plan {
+		return s.plans.get(flg, sgmts)
+	})
+}
+
+// EvaluateBatch evaluates all flags for each of the requests, the same way EvaluateAll does.
+// Flags and segments are only fetched once, and the requests are evaluated concurrently.
+func (s *flagService) EvaluateBatch(ctx context.Context, reqs []*EvaluationRequest) ([]*EvaluationsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "FlagService.EvaluateBatch")
+	defer span.Finish()
+
+	// fetch all flags, archived flags are not included. the flags are
+	// filtered for each request instead
+	flgs, err := s.flagsRepo.FindAll(ctx, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	// fetch segments
-	sgmts, err := segmentsAsIdentifiers(s.segmentsRepo.FindAll(ctx, nil, nil))
+	s.plans.prune(flgs.Flags)
+	// fetch segments and exclusion groups
+	sgmts, err := s.planSegments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// compile the plans before evaluating the requests, so the flags
+	// are not populated concurrently
+	plans := make(map[string]*flaggio.Plan, len(flgs.Flags))
+	for _, flg := range flgs.Flags {
+		plans[flg.ID] = s.plans.get(flg, sgmts)
+	}
+	plan := func(flg *flaggio.Flag) *flaggio.Plan {
+		return plans[flg.ID]
+	}
+
+	batchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	responses := make([]*EvaluationsResponse, len(reqs))
+	errs := make(chan error, len(reqs))
+	queue := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < batchConcurrency && i < len(reqs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range queue {
+				req := reqs[idx]
+				res, err := s.evaluateFlags(batchCtx, req, filterFlags(flgs.Flags, req.FlagFilter()), plan)
+				if err != nil {
+					errs <- err
+					// stop evaluating the other requests
+					cancel()
+					continue
+				}
+				responses[idx] = res
+			}
+		}()
+	}
+	for idx := range reqs {
+		if batchCtx.Err() != nil {
+			break
+		}
+		queue <- idx
+	}
+	close(queue)
+	wg.Wait()
+	close(errs)
+
+	// the first error is the one that stopped the batch
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return responses, nil
+}
+
+// evaluateFlags evaluates the flags for the request, using the evaluation plan returned by
+// plan for each flag. Previous evaluations are reused, if the flag and the user context didn't
+// change since.
+func (s *flagService) evaluateFlags(
+	ctx context.Context,
+	req *EvaluationRequest,
+	flgs []*flaggio.Flag,
+	plan func(flg *flaggio.Flag) *flaggio.Plan,
+) (*EvaluationsResponse, error) {
+	// fetch previous evaluations
+	hash, err := req.Hash()
+	if err != nil {
+		return nil, err
+	}
+	prevEvals, err := s.evalsRepo.FindAllByReqHash(ctx, hash)
 	if err != nil {
 		return nil, err
 	}
 
 	// check for missing flag evaluations
-	validEvals := validFlagEvals(hash, flgs.Flags, prevEvals)
-	evals := make(flaggio.EvaluationList, len(flgs.Flags))
+	validEvals := validFlagEvals(hash, flgs, prevEvals)
+	evals := make(flaggio.EvaluationList, len(flgs))
 
 	// evaluate flags
 	evalSpan, _ := opentracing.StartSpanFromContext(ctx, "flaggio.Evaluate")
 	var outdatedEvals flaggio.EvaluationList
-	for idx, flg := range flgs.Flags {
+	for idx, flg := range flgs {
 		if evltn, ok := validEvals[flg.ID]; ok {
 			evals[idx] = evltn
 			continue
 		}
-		flg.Populate(sgmts)
 
 		evltn := &flaggio.Evaluation{
 			FlagID:      flg.ID,
@@ -148,11 +279,18 @@ func (s *flagService) EvaluateAll(ctx context.Context, req *EvaluationRequest) (
 			FlagKey:     flg.Key,
 			RequestHash: hash,
 		}
-		res, err := flaggio.Evaluate(req.UserContext, flg)
+		res, err := plan(flg).Evaluate(req.UserContext)
 		if err != nil {
 			evltn.Error = err.Error()
 		} else {
 			evltn.Value = res.Answer
+			evltn.Reason = res.Reason
+			if res.Variant != nil {
+				evltn.VariantID = res.Variant.ID
+			}
+			if rl := res.Rule(); rl != nil {
+				evltn.RuleName = rl.GetName()
+			}
 			outdatedEvals = append(outdatedEvals, evltn)
 		}
 
@@ -171,6 +309,16 @@ func (s *flagService) EvaluateAll(ctx context.Context, req *EvaluationRequest) (
 		}
 	}
 
+	// debug evaluations are not exposed to the user, and neither are the
+	// users excluded from the flags by their exclusion groups
+	if req.emitsExposures() {
+		for idx, evltn := range evals {
+			if evltn.Error == "" && evltn.Reason != flaggio.ReasonExcluded {
+				s.exposures.Emit(exposure.NewEvent(req.UserID, flgs[idx], evltn))
+			}
+		}
+	}
+
 	// build the response
 	evalRes := &EvaluationsResponse{
 		Evaluations: evals,
@@ -183,15 +331,64 @@ func (s *flagService) EvaluateAll(ctx context.Context, req *EvaluationRequest) (
 	return evalRes, nil
 }
 
-func segmentsAsIdentifiers(sgmts []*flaggio.Segment, err error) ([]flaggio.Identifier, error) {
+// filterFlags returns the flags that match the filter, the same way the
+// flags repository does. Only the filters of evaluation requests are used.
+func filterFlags(flgs []*flaggio.Flag, filter *flaggio.FlagFilter) []*flaggio.Flag {
+	if filter == nil {
+		return flgs
+	}
+	var filtered []*flaggio.Flag
+	for _, flg := range flgs {
+		if len(filter.Keys) > 0 && !containsString(filter.Keys, flg.Key) {
+			continue
+		}
+		if filter.ClientExposed != nil && flg.ClientExposed != *filter.ClientExposed {
+			continue
+		}
+		hasTags := true
+		for _, tag := range filter.Tags {
+			if !containsString(flg.Tags, tag) {
+				hasTags = false
+				break
+			}
+		}
+		if hasTags {
+			filtered = append(filtered, flg)
+		}
+	}
+	return filtered
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// planSegments fetches the segments and exclusion groups that plans are compiled with.
+func (s *flagService) planSegments(ctx context.Context) (*planSegments, error) {
+	sgmnts, err := s.segmentsRepo.FindAll(ctx, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	grps, err := s.groupsRepo.FindAll(ctx)
 	if err != nil {
 		return nil, err
 	}
+	return newPlanSegments(sgmnts, grps), nil
+}
+
+func segmentsAsIdentifiers(sgmts []*flaggio.Segment) []flaggio.Identifier {
+	// segments can reference other segments
+	flaggio.SegmentList(sgmts).Populate()
 	iders := make([]flaggio.Identifier, len(sgmts))
 	for idx, sgmnt := range sgmts {
 		iders[idx] = sgmnt
 	}
-	return iders, nil
+	return iders
 }
 
 func invalidEval(reqHash string, flg *flaggio.Flag, eval *flaggio.Evaluation) bool {
@@ -2,11 +2,15 @@ package service_test
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/exposure"
+	exposure_mock "github.com/uw-labs/flaggio/internal/exposure/mocks"
 	"github.com/uw-labs/flaggio/internal/flaggio"
 	repository_mock "github.com/uw-labs/flaggio/internal/repository/mocks"
 	"github.com/uw-labs/flaggio/internal/service"
@@ -25,9 +29,11 @@ func TestFlagService_Evaluate(t *testing.T) {
 	flags := []*flaggio.Flag{
 		{ID: "1", Key: "a", Enabled: false, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]},
 		{ID: "2", Key: "b", Enabled: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]},
+		{ID: "3", Key: "c", Enabled: true, Archived: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]},
 	}
 	tests := []struct {
 		name               string
+		archivedResponse   service.ArchivedFlagResponse
 		flagKey            string
 		flagResult         *flaggio.Flag
 		evaluationResult   *flaggio.Evaluation
@@ -44,7 +50,7 @@ func TestFlagService_Evaluate(t *testing.T) {
 				UserContext: flaggio.UserContext{"name": "John"},
 			},
 			expectedEvaluation: &service.EvaluationResponse{
-				Evaluation: &flaggio.Evaluation{FlagID: "1", FlagKey: "a", Value: 20,
+				Evaluation: &flaggio.Evaluation{FlagID: "1", FlagKey: "a", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled,
 					RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
 			},
 			shouldReplaceEval: true,
@@ -59,7 +65,7 @@ func TestFlagService_Evaluate(t *testing.T) {
 				Debug:       boolPtr(true),
 			},
 			expectedEvaluation: &service.EvaluationResponse{
-				Evaluation: &flaggio.Evaluation{FlagID: "2", FlagKey: "b", Value: 10,
+				Evaluation: &flaggio.Evaluation{FlagID: "2", FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault,
 					RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55", StackTrace: []*flaggio.StackTrace{
 						{Type: "*Flag", ID: stringPtr("2"), Answer: 10},
 					}},
@@ -83,6 +89,41 @@ func TestFlagService_Evaluate(t *testing.T) {
 			},
 			shouldReplaceEval: false,
 		},
+		{
+			name:       "ignore previous evaluation with debug option",
+			flagKey:    "b",
+			flagResult: flags[1],
+			evaluationResult: &flaggio.Evaluation{FlagID: "1", FlagKey: "a", Value: 10,
+				RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+			evaluationRequest: &service.EvaluationRequest{
+				UserID:      "user2",
+				UserContext: flaggio.UserContext{"name": "John"},
+				Debug:       boolPtr(true),
+			},
+			expectedEvaluation: &service.EvaluationResponse{
+				Evaluation: &flaggio.Evaluation{FlagID: "2", FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault,
+					RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55", StackTrace: []*flaggio.StackTrace{
+						{Type: "*Flag", ID: stringPtr("2"), Answer: 10},
+					}},
+				UserContext: &flaggio.UserContext{"name": "John"},
+			},
+			shouldReplaceEval: false,
+		},
+		{
+			name:             "evaluate archived flag as disabled",
+			archivedResponse: service.ArchivedFlagResponseOff,
+			flagKey:          "c",
+			flagResult:       flags[2],
+			evaluationRequest: &service.EvaluationRequest{
+				UserID:      "user1",
+				UserContext: flaggio.UserContext{"name": "John"},
+			},
+			expectedEvaluation: &service.EvaluationResponse{
+				Evaluation: &flaggio.Evaluation{FlagID: "3", FlagKey: "c", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled,
+					RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+			},
+			shouldReplaceEval: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -94,9 +135,11 @@ func TestFlagService_Evaluate(t *testing.T) {
 			ctx := context.Background()
 			flagRepo := repository_mock.NewMockFlag(mockCtrl)
 			segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+			groupRepo := repository_mock.NewMockExclusionGroup(mockCtrl)
 			evalRepo := repository_mock.NewMockEvaluation(mockCtrl)
 			userRepo := repository_mock.NewMockUser(mockCtrl)
-			flagService := service.NewFlagService(flagRepo, segmentRepo, evalRepo, userRepo)
+			exposures := exposure_mock.NewMockEmitter(mockCtrl)
+			flagService := service.NewFlagService(flagRepo, segmentRepo, groupRepo, evalRepo, userRepo, exposures, tt.archivedResponse)
 			segmentResults := make([]*flaggio.Segment, 0)
 			hash, err := tt.evaluationRequest.Hash()
 			assert.NoError(t, err)
@@ -104,14 +147,19 @@ func TestFlagService_Evaluate(t *testing.T) {
 			flagRepo.EXPECT().
 				FindByKey(gomock.AssignableToTypeOf(ctxInterface), tt.flagKey).
 				Times(1).Return(tt.flagResult, nil)
-			if tt.evaluationResult == nil {
+			if tt.evaluationResult == nil || tt.evaluationRequest.IsDebug() {
 				segmentRepo.EXPECT().
 					FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil).
 					Times(1).Return(segmentResults, nil)
+				groupRepo.EXPECT().
+					FindAll(gomock.AssignableToTypeOf(ctxInterface)).
+					Times(1).Return(nil, nil)
+			}
+			if !tt.evaluationRequest.IsDebug() {
+				evalRepo.EXPECT().
+					FindByReqHashAndFlagKey(gomock.AssignableToTypeOf(ctxInterface), hash, tt.flagResult.Key).
+					Times(1).Return(tt.evaluationResult, nil)
 			}
-			evalRepo.EXPECT().
-				FindByReqHashAndFlagKey(gomock.AssignableToTypeOf(ctxInterface), hash, tt.flagResult.Key).
-				Times(1).Return(tt.evaluationResult, nil)
 			if tt.shouldReplaceEval {
 				userRepo.EXPECT().
 					Replace(gomock.AssignableToTypeOf(ctxInterface), tt.evaluationRequest.UserID, tt.evaluationRequest.UserContext).
@@ -121,6 +169,12 @@ func TestFlagService_Evaluate(t *testing.T) {
 					Times(1).Return(nil)
 			}
 
+			if !tt.evaluationRequest.IsDebug() {
+				exposures.EXPECT().
+					Emit(exposureFor(tt.evaluationRequest.UserID, tt.flagResult, tt.expectedEvaluation.Evaluation)).
+					Times(1)
+			}
+
 			result, err := flagService.Evaluate(ctx, tt.flagKey, tt.evaluationRequest)
 			assert.NoError(t, err)
 			assert.Equal(t, tt.expectedEvaluation, result)
@@ -128,6 +182,265 @@ func TestFlagService_Evaluate(t *testing.T) {
 	}
 }
 
+func TestFlagService_EvaluateArchived(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagRepo := repository_mock.NewMockFlag(mockCtrl)
+	segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+	groupRepo := repository_mock.NewMockExclusionGroup(mockCtrl)
+	evalRepo := repository_mock.NewMockEvaluation(mockCtrl)
+	userRepo := repository_mock.NewMockUser(mockCtrl)
+	exposures := exposure_mock.NewMockEmitter(mockCtrl)
+	flagService := service.NewFlagService(flagRepo, segmentRepo, groupRepo, evalRepo, userRepo, exposures, service.ArchivedFlagResponseError)
+
+	flagRepo.EXPECT().
+		FindByKey(gomock.AssignableToTypeOf(ctxInterface), "a").
+		Times(1).Return(&flaggio.Flag{ID: "1", Key: "a", Enabled: true, Archived: true}, nil)
+
+	result, err := flagService.Evaluate(context.Background(), "a", &service.EvaluationRequest{
+		UserID:      "user1",
+		UserContext: flaggio.UserContext{"name": "John"},
+	})
+	assert.EqualError(t, err, "flag is archived")
+	assert.Nil(t, result)
+}
+
+func TestFlagService_EvaluateWithoutExposures(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagRepo := repository_mock.NewMockFlag(mockCtrl)
+	segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+	groupRepo := repository_mock.NewMockExclusionGroup(mockCtrl)
+	evalRepo := repository_mock.NewMockEvaluation(mockCtrl)
+	userRepo := repository_mock.NewMockUser(mockCtrl)
+	flagService := service.NewFlagService(flagRepo, segmentRepo, groupRepo, evalRepo, userRepo, nil, service.ArchivedFlagResponseError)
+
+	vrnt := &flaggio.Variant{ID: "1", Value: 10}
+	req := &service.EvaluationRequest{UserID: "user1", UserContext: flaggio.UserContext{"name": "John"}}
+	hash, err := req.Hash()
+	assert.NoError(t, err)
+	eval := &flaggio.Evaluation{FlagID: "1", FlagKey: "a", RequestHash: hash, Value: 10, Reason: flaggio.ReasonDefault}
+
+	flagRepo.EXPECT().
+		FindByKey(gomock.AssignableToTypeOf(ctxInterface), "a").
+		Times(1).Return(&flaggio.Flag{ID: "1", Key: "a", Enabled: true, Variants: []*flaggio.Variant{vrnt}, DefaultVariantWhenOn: vrnt}, nil)
+	evalRepo.EXPECT().
+		FindByReqHashAndFlagKey(gomock.AssignableToTypeOf(ctxInterface), hash, "a").
+		Times(1).Return(eval, nil)
+
+	// the evaluation is not exposed, instead of panicking
+	result, err := flagService.Evaluate(context.Background(), "a", req)
+	assert.NoError(t, err)
+	assert.Equal(t, eval, result.Evaluation)
+}
+
+func TestFlagService_EvaluatePlans(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagRepo := repository_mock.NewMockFlag(mockCtrl)
+	segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+	groupRepo := repository_mock.NewMockExclusionGroup(mockCtrl)
+	evalRepo := repository_mock.NewMockEvaluation(mockCtrl)
+	userRepo := repository_mock.NewMockUser(mockCtrl)
+	exposures := exposure_mock.NewMockEmitter(mockCtrl)
+	flagService := service.NewFlagService(flagRepo, segmentRepo, groupRepo, evalRepo, userRepo, exposures, service.ArchivedFlagResponseError)
+
+	variants := []*flaggio.Variant{{ID: "1", Value: 10}, {ID: "2", Value: 20}}
+	newFlag := func(version int) *flaggio.Flag {
+		return &flaggio.Flag{
+			ID: "1", Key: "a", Enabled: true, Version: version,
+			Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[0],
+			Rules: []*flaggio.FlagRule{{
+				Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{
+					{Operation: flaggio.OperationIsInSegment, Values: []interface{}{"s1"}},
+				}},
+				Distributions: []*flaggio.Distribution{{ID: "1", Variant: variants[1], Percentage: 100}},
+			}},
+		}
+	}
+	newSegment := func(plan string, updatedAt time.Time) *flaggio.Segment {
+		return &flaggio.Segment{ID: "s1", UpdatedAt: &updatedAt, Rules: []*flaggio.SegmentRule{{
+			Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{
+				{Property: "plan", Operation: flaggio.OperationOneOf, Values: []interface{}{plan}},
+			}},
+		}}}
+	}
+	now, debug := time.Now(), true
+	tests := []struct {
+		name          string
+		flag          *flaggio.Flag
+		segment       *flaggio.Segment
+		expectedValue interface{}
+	}{
+		{name: "compiles the plan", flag: newFlag(1), segment: newSegment("pro", now), expectedValue: 20},
+		// the segment wasn't updated, so the plan compiled with it is reused
+		{name: "reuses the plan", flag: newFlag(1), segment: newSegment("free", now), expectedValue: 20},
+		{name: "recompiles the plan when the segments change", flag: newFlag(1), segment: newSegment("free", now.Add(time.Second)), expectedValue: 10},
+		{name: "recompiles the plan when the flag changes", flag: newFlag(2), segment: newSegment("free", now.Add(time.Second)), expectedValue: 10},
+	}
+	// the cache is shared, so the evaluations run in order
+	for _, tt := range tests {
+		flagRepo.EXPECT().
+			FindByKey(gomock.AssignableToTypeOf(ctxInterface), "a").
+			Times(1).Return(tt.flag, nil)
+		segmentRepo.EXPECT().
+			FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil).
+			Times(1).Return([]*flaggio.Segment{tt.segment}, nil)
+		groupRepo.EXPECT().
+			FindAll(gomock.AssignableToTypeOf(ctxInterface)).
+			Times(1).Return(nil, nil)
+
+		result, err := flagService.Evaluate(context.Background(), "a", &service.EvaluationRequest{
+			UserID:      "user1",
+			UserContext: flaggio.UserContext{"plan": "pro"},
+			Debug:       &debug,
+		})
+		assert.NoError(t, err, tt.name)
+		assert.Equal(t, tt.expectedValue, result.Evaluation.Value, tt.name)
+	}
+}
+
+func TestFlagService_PrunePlans(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagRepo := repository_mock.NewMockFlag(mockCtrl)
+	segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+	groupRepo := repository_mock.NewMockExclusionGroup(mockCtrl)
+	evalRepo := repository_mock.NewMockEvaluation(mockCtrl)
+	userRepo := repository_mock.NewMockUser(mockCtrl)
+	exposures := exposure_mock.NewMockEmitter(mockCtrl)
+	flagService := service.NewFlagService(flagRepo, segmentRepo, groupRepo, evalRepo, userRepo, exposures, service.ArchivedFlagResponseError)
+
+	variants := []*flaggio.Variant{{ID: "1", Value: 10}, {ID: "2", Value: 20}}
+	newFlag := func() *flaggio.Flag {
+		return &flaggio.Flag{
+			ID: "1", Key: "a", Enabled: true, Version: 1,
+			Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[0],
+			Rules: []*flaggio.FlagRule{{
+				Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{
+					{Operation: flaggio.OperationIsInSegment, Values: []interface{}{"s1"}},
+				}},
+				Distributions: []*flaggio.Distribution{{ID: "1", Variant: variants[1], Percentage: 100}},
+			}},
+		}
+	}
+	newSegment := func(plan string, updatedAt time.Time) *flaggio.Segment {
+		return &flaggio.Segment{ID: "s1", UpdatedAt: &updatedAt, Rules: []*flaggio.SegmentRule{{
+			Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{
+				{Property: "plan", Operation: flaggio.OperationOneOf, Values: []interface{}{plan}},
+			}},
+		}}}
+	}
+	now, debug := time.Now(), true
+	req := func() *service.EvaluationRequest {
+		return &service.EvaluationRequest{
+			UserID:      "user1",
+			UserContext: flaggio.UserContext{"plan": "pro"},
+			Debug:       &debug,
+		}
+	}
+	expectSegments := func(sgmnt *flaggio.Segment) {
+		segmentRepo.EXPECT().
+			FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil).
+			Times(1).Return([]*flaggio.Segment{sgmnt}, nil)
+		groupRepo.EXPECT().
+			FindAll(gomock.AssignableToTypeOf(ctxInterface)).
+			Times(1).Return(nil, nil)
+	}
+
+	// compile the plan of the flag
+	flagRepo.EXPECT().
+		FindByKey(gomock.AssignableToTypeOf(ctxInterface), "a").
+		Times(1).Return(newFlag(), nil)
+	expectSegments(newSegment("pro", now))
+	result, err := flagService.Evaluate(context.Background(), "a", req())
+	assert.NoError(t, err)
+	assert.Equal(t, 20, result.Evaluation.Value)
+
+	// the flag is no longer listed, so its plan is removed
+	flagRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
+		Times(1).Return(&flaggio.FlagResults{}, nil)
+	expectSegments(newSegment("pro", now))
+	evalRepo.EXPECT().
+		FindAllByReqHash(gomock.AssignableToTypeOf(ctxInterface), gomock.Any()).
+		Times(1).Return(nil, nil)
+	_, err = flagService.EvaluateAll(context.Background(), req())
+	assert.NoError(t, err)
+
+	// the segment wasn't updated, but the plan is compiled again
+	flagRepo.EXPECT().
+		FindByKey(gomock.AssignableToTypeOf(ctxInterface), "a").
+		Times(1).Return(newFlag(), nil)
+	expectSegments(newSegment("free", now))
+	result, err = flagService.Evaluate(context.Background(), "a", req())
+	assert.NoError(t, err)
+	assert.Equal(t, 10, result.Evaluation.Value)
+}
+
+func TestFlagService_EvaluateAllExclusionGroup(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagRepo := repository_mock.NewMockFlag(mockCtrl)
+	segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+	groupRepo := repository_mock.NewMockExclusionGroup(mockCtrl)
+	evalRepo := repository_mock.NewMockEvaluation(mockCtrl)
+	userRepo := repository_mock.NewMockUser(mockCtrl)
+	exposures := exposure_mock.NewMockEmitter(mockCtrl)
+	flagService := service.NewFlagService(flagRepo, segmentRepo, groupRepo, evalRepo, userRepo, exposures, service.ArchivedFlagResponseError)
+
+	variants := []*flaggio.Variant{{ID: "1", Value: 10}, {ID: "2", Value: 20}}
+	flags := []*flaggio.Flag{
+		{ID: "1", Key: "a", Enabled: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]},
+		{ID: "2", Key: "b", Enabled: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]},
+	}
+	// the flags split all the slots of the group, so the user is in exactly one of them
+	grp := &flaggio.ExclusionGroup{ID: "g1", Allocations: []*flaggio.SlotAllocation{
+		{FlagID: "1", Start: 0, End: 500},
+		{FlagID: "2", Start: 500, End: 1000},
+	}}
+	req := &service.EvaluationRequest{UserID: "user1", UserContext: flaggio.UserContext{"$userId": "user1"}}
+	hash, err := req.Hash()
+	assert.NoError(t, err)
+
+	flagRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
+		Times(1).Return(&flaggio.FlagResults{Flags: flags, Total: len(flags)}, nil)
+	segmentRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil).
+		Times(1).Return(nil, nil)
+	groupRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface)).
+		Times(1).Return([]*flaggio.ExclusionGroup{grp}, nil)
+	evalRepo.EXPECT().
+		FindAllByReqHash(gomock.AssignableToTypeOf(ctxInterface), hash).
+		Times(1).Return(nil, nil)
+	userRepo.EXPECT().
+		Replace(gomock.AssignableToTypeOf(ctxInterface), "user1", req.UserContext).
+		Times(1).Return(nil)
+	evalRepo.EXPECT().
+		ReplaceAll(gomock.AssignableToTypeOf(ctxInterface), "user1", hash, gomock.Any()).
+		Times(1).Return(nil)
+	// excluded users are not exposed to the flag
+	exposures.EXPECT().Emit(gomock.Any()).Times(1)
+
+	result, err := flagService.EvaluateAll(context.Background(), req)
+	assert.NoError(t, err)
+	included, excluded := result.Evaluations[0], result.Evaluations[1]
+	if grp.Slot("user1") >= 500 {
+		included, excluded = excluded, included
+	}
+	assert.Equal(t, flaggio.ReasonDefault, included.Reason)
+	assert.Equal(t, 10, included.Value)
+	assert.Equal(t, flaggio.ReasonExcluded, excluded.Reason)
+	assert.Equal(t, 20, excluded.Value)
+}
+
 func TestFlagService_EvaluateAll(t *testing.T) {
 	t.Parallel()
 	variants := []*flaggio.Variant{
@@ -136,11 +449,12 @@ func TestFlagService_EvaluateAll(t *testing.T) {
 	}
 	flags := []*flaggio.Flag{
 		{ID: "1", Key: "a", Enabled: false, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]},
-		{ID: "2", Key: "b", Enabled: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]},
+		{ID: "2", Key: "b", Enabled: true, Experiment: true, ClientExposed: true, Tags: []string{"checkout"}, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]},
 	}
 	tests := []struct {
 		name               string
 		evaluationRequest  *service.EvaluationRequest
+		evaluatedFlags     []*flaggio.Flag
 		evaluationResults  *flaggio.EvaluationResults
 		expectedEvaluation *service.EvaluationsResponse
 		outdatedEvals      flaggio.EvaluationList
@@ -155,13 +469,33 @@ func TestFlagService_EvaluateAll(t *testing.T) {
 			evaluationResults: &flaggio.EvaluationResults{Evaluations: []*flaggio.Evaluation{}},
 			expectedEvaluation: &service.EvaluationsResponse{
 				Evaluations: flaggio.EvaluationList{
-					{FlagID: "1", FlagKey: "a", Value: 20, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
-					{FlagID: "2", FlagKey: "b", Value: 10, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+					{FlagID: "1", FlagKey: "a", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+					{FlagID: "2", FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+				},
+			},
+			outdatedEvals: flaggio.EvaluationList{
+				{FlagID: "1", FlagKey: "a", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+				{FlagID: "2", FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+			},
+			shouldReplaceEval: true,
+		},
+		{
+			name: "save evaluations without emitting exposures",
+			evaluationRequest: &service.EvaluationRequest{
+				UserID:        "user4",
+				UserContext:   flaggio.UserContext{"name": "John"},
+				SkipExposures: true,
+			},
+			evaluationResults: &flaggio.EvaluationResults{Evaluations: []*flaggio.Evaluation{}},
+			expectedEvaluation: &service.EvaluationsResponse{
+				Evaluations: flaggio.EvaluationList{
+					{FlagID: "1", FlagKey: "a", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+					{FlagID: "2", FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
 				},
 			},
 			outdatedEvals: flaggio.EvaluationList{
-				{FlagID: "1", FlagKey: "a", Value: 20, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
-				{FlagID: "2", FlagKey: "b", Value: 10, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+				{FlagID: "1", FlagKey: "a", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+				{FlagID: "2", FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
 			},
 			shouldReplaceEval: true,
 		},
@@ -175,8 +509,8 @@ func TestFlagService_EvaluateAll(t *testing.T) {
 			evaluationResults: &flaggio.EvaluationResults{Evaluations: []*flaggio.Evaluation{}},
 			expectedEvaluation: &service.EvaluationsResponse{
 				Evaluations: flaggio.EvaluationList{
-					{FlagID: "1", FlagKey: "a", Value: 20, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
-					{FlagID: "2", FlagKey: "b", Value: 10, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+					{FlagID: "1", FlagKey: "a", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+					{FlagID: "2", FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
 				},
 				UserContext: &flaggio.UserContext{"name": "John"},
 			},
@@ -194,11 +528,32 @@ func TestFlagService_EvaluateAll(t *testing.T) {
 			expectedEvaluation: &service.EvaluationsResponse{
 				Evaluations: flaggio.EvaluationList{
 					{FlagID: "1", FlagKey: "a", Value: 10, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
-					{FlagID: "2", FlagKey: "b", Value: 10, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+					{FlagID: "2", FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+				},
+			},
+			outdatedEvals: flaggio.EvaluationList{
+				{FlagID: "2", FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+			},
+			shouldReplaceEval: true,
+		},
+		{
+			name: "return evaluations of the flags matching the filters",
+			evaluationRequest: &service.EvaluationRequest{
+				UserID:        "user3",
+				UserContext:   flaggio.UserContext{"name": "John"},
+				FlagKeys:      []string{"b", "c"},
+				Tags:          []string{"checkout"},
+				ClientExposed: true,
+			},
+			evaluatedFlags:    flags[1:],
+			evaluationResults: &flaggio.EvaluationResults{Evaluations: []*flaggio.Evaluation{}},
+			expectedEvaluation: &service.EvaluationsResponse{
+				Evaluations: flaggio.EvaluationList{
+					{FlagID: "2", FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
 				},
 			},
 			outdatedEvals: flaggio.EvaluationList{
-				{FlagID: "2", FlagKey: "b", Value: 10, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
+				{FlagID: "2", FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault, RequestHash: "5e83501f42ab66e04cd03a53d55399ffa7387a55"},
 			},
 			shouldReplaceEval: true,
 		},
@@ -213,20 +568,29 @@ func TestFlagService_EvaluateAll(t *testing.T) {
 			ctx := context.Background()
 			flagRepo := repository_mock.NewMockFlag(mockCtrl)
 			segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+			groupRepo := repository_mock.NewMockExclusionGroup(mockCtrl)
 			evalRepo := repository_mock.NewMockEvaluation(mockCtrl)
 			userRepo := repository_mock.NewMockUser(mockCtrl)
-			flagService := service.NewFlagService(flagRepo, segmentRepo, evalRepo, userRepo)
+			exposures := exposure_mock.NewMockEmitter(mockCtrl)
+			flagService := service.NewFlagService(flagRepo, segmentRepo, groupRepo, evalRepo, userRepo, exposures, service.ArchivedFlagResponseError)
+			flgs := flags
+			if tt.evaluatedFlags != nil {
+				flgs = tt.evaluatedFlags
+			}
 			flagResults := &flaggio.FlagResults{Flags: flags, Total: len(flags)}
 			segmentResults := make([]*flaggio.Segment, 0)
 			hash, err := tt.evaluationRequest.Hash()
 			assert.NoError(t, err)
 
 			flagRepo.EXPECT().
-				FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil).
+				FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
 				Times(1).Return(flagResults, nil)
 			segmentRepo.EXPECT().
 				FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil).
 				Times(1).Return(segmentResults, nil)
+			groupRepo.EXPECT().
+				FindAll(gomock.AssignableToTypeOf(ctxInterface)).
+				Times(1).Return(nil, nil)
 			evalRepo.EXPECT().
 				FindAllByReqHash(gomock.AssignableToTypeOf(ctxInterface), hash).
 				Times(1).Return(tt.evaluationResults.Evaluations, nil)
@@ -239,6 +603,17 @@ func TestFlagService_EvaluateAll(t *testing.T) {
 					Times(1).Return(nil)
 			}
 
+			if !tt.evaluationRequest.IsDebug() && !tt.evaluationRequest.SkipExposures {
+				for idx, evltn := range tt.expectedEvaluation.Evaluations {
+					if evltn.Error != "" {
+						continue
+					}
+					exposures.EXPECT().
+						Emit(exposureFor(tt.evaluationRequest.UserID, flgs[idx], evltn)).
+						Times(1)
+				}
+			}
+
 			result, err := flagService.EvaluateAll(ctx, tt.evaluationRequest)
 			assert.NoError(t, err)
 			assert.Equal(t, tt.expectedEvaluation, result)
@@ -246,6 +621,124 @@ func TestFlagService_EvaluateAll(t *testing.T) {
 	}
 }
 
+func TestFlagService_EvaluateBatch(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+	flagRepo := repository_mock.NewMockFlag(mockCtrl)
+	segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+	groupRepo := repository_mock.NewMockExclusionGroup(mockCtrl)
+	evalRepo := repository_mock.NewMockEvaluation(mockCtrl)
+	userRepo := repository_mock.NewMockUser(mockCtrl)
+	exposures := exposure_mock.NewMockEmitter(mockCtrl)
+	flagService := service.NewFlagService(flagRepo, segmentRepo, groupRepo, evalRepo, userRepo, exposures, service.ArchivedFlagResponseError)
+
+	variants := []*flaggio.Variant{{ID: "1", Value: 10}, {ID: "2", Value: 20}}
+	flags := []*flaggio.Flag{
+		{ID: "1", Key: "a", Enabled: false, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]},
+		{ID: "2", Key: "b", Enabled: true, ClientExposed: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]},
+	}
+	reqs := []*service.EvaluationRequest{
+		{UserID: "user1", UserContext: flaggio.UserContext{"name": "John"}, Debug: boolPtr(true)},
+		{UserID: "user2", UserContext: flaggio.UserContext{"name": "Jane"}, Debug: boolPtr(true), ClientExposed: true},
+	}
+
+	// flags and segments are only fetched once
+	flagRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
+		Times(1).Return(&flaggio.FlagResults{Flags: flags, Total: len(flags)}, nil)
+	segmentRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil).
+		Times(1).Return([]*flaggio.Segment{}, nil)
+	groupRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface)).
+		Times(1).Return(nil, nil)
+	hashes := make([]string, len(reqs))
+	for idx, req := range reqs {
+		hash, err := req.Hash()
+		assert.NoError(t, err)
+		hashes[idx] = hash
+		evalRepo.EXPECT().
+			FindAllByReqHash(gomock.AssignableToTypeOf(ctxInterface), hash).
+			Times(1).Return(nil, nil)
+	}
+
+	result, err := flagService.EvaluateBatch(ctx, reqs)
+	assert.NoError(t, err)
+	assert.Equal(t, []*service.EvaluationsResponse{
+		{
+			Evaluations: flaggio.EvaluationList{
+				{FlagID: "1", FlagKey: "a", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled, RequestHash: hashes[0]},
+				{FlagID: "2", FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault, RequestHash: hashes[0]},
+			},
+			UserContext: &flaggio.UserContext{"name": "John"},
+		},
+		{
+			Evaluations: flaggio.EvaluationList{
+				{FlagID: "2", FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault, RequestHash: hashes[1]},
+			},
+			UserContext: &flaggio.UserContext{"name": "Jane"},
+		},
+	}, result)
+}
+
+func TestFlagService_EvaluateBatchError(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagRepo := repository_mock.NewMockFlag(mockCtrl)
+	segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+	groupRepo := repository_mock.NewMockExclusionGroup(mockCtrl)
+	evalRepo := repository_mock.NewMockEvaluation(mockCtrl)
+	userRepo := repository_mock.NewMockUser(mockCtrl)
+	exposures := exposure_mock.NewMockEmitter(mockCtrl)
+	flagService := service.NewFlagService(flagRepo, segmentRepo, groupRepo, evalRepo, userRepo, exposures, service.ArchivedFlagResponseError)
+
+	flagRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
+		Times(1).Return(&flaggio.FlagResults{Flags: []*flaggio.Flag{}}, nil)
+	segmentRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil).
+		Times(1).Return([]*flaggio.Segment{}, nil)
+	groupRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface)).
+		Times(1).Return(nil, nil)
+	evalRepo.EXPECT().
+		FindAllByReqHash(gomock.AssignableToTypeOf(ctxInterface), gomock.Any()).
+		MinTimes(1).Return(nil, fmt.Errorf("connection lost"))
+
+	reqs := make([]*service.EvaluationRequest, 50)
+	for idx := range reqs {
+		reqs[idx] = &service.EvaluationRequest{UserID: fmt.Sprint(idx), UserContext: flaggio.UserContext{}}
+	}
+	result, err := flagService.EvaluateBatch(context.Background(), reqs)
+	assert.EqualError(t, err, "connection lost")
+	assert.Nil(t, result)
+}
+
+// exposureFor matches exposure events for the user evaluation, ignoring the timestamp.
+func exposureFor(userID string, flg *flaggio.Flag, evltn *flaggio.Evaluation) gomock.Matcher {
+	return exposureMatcher{expected: exposure.NewEvent(userID, flg, evltn)}
+}
+
+type exposureMatcher struct {
+	expected exposure.Event
+}
+
+func (m exposureMatcher) Matches(x interface{}) bool {
+	evt, ok := x.(exposure.Event)
+	if !ok {
+		return false
+	}
+	evt.Timestamp = m.expected.Timestamp
+	return evt == m.expected
+}
+
+func (m exposureMatcher) String() string {
+	return fmt.Sprintf("%+v", m.expected)
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/uw-labs/flaggio/internal/service (interfaces: Experiment)
+
+// Package service_mock is a generated GoMock package.
+package service_mock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	service "github.com/uw-labs/flaggio/internal/service"
+	reflect "reflect"
+)
+
+// MockExperiment is a mock of Experiment interface
+type MockExperiment struct {
+	ctrl     *gomock.Controller
+	recorder *MockExperimentMockRecorder
+}
+
+// MockExperimentMockRecorder is the mock recorder for MockExperiment
+type MockExperimentMockRecorder struct {
+	mock *MockExperiment
+}
+
+// NewMockExperiment creates a new mock instance
+func NewMockExperiment(ctrl *gomock.Controller) *MockExperiment {
+	mock := &MockExperiment{ctrl: ctrl}
+	mock.recorder = &MockExperimentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockExperiment) EXPECT() *MockExperimentMockRecorder {
+	return m.recorder
+}
+
+// Track mocks base method
+func (m *MockExperiment) Track(arg0 context.Context, arg1 *service.TrackRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Track", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Track indicates an expected call of Track
+func (mr *MockExperimentMockRecorder) Track(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Track", reflect.TypeOf((*MockExperiment)(nil).Track), arg0, arg1)
+}
@@ -63,3 +63,18 @@ func (mr *MockFlagMockRecorder) EvaluateAll(arg0, arg1 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateAll", reflect.TypeOf((*MockFlag)(nil).EvaluateAll), arg0, arg1)
 }
+
+// EvaluateBatch mocks base method
+func (m *MockFlag) EvaluateBatch(arg0 context.Context, arg1 []*service.EvaluationRequest) ([]*service.EvaluationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateBatch", arg0, arg1)
+	ret0, _ := ret[0].([]*service.EvaluationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateBatch indicates an expected call of EvaluateBatch
+func (mr *MockFlagMockRecorder) EvaluateBatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateBatch", reflect.TypeOf((*MockFlag)(nil).EvaluateBatch), arg0, arg1)
+}
@@ -3,6 +3,9 @@ package service
 import (
 	"crypto/sha1" // nolint // only used for hashing requests
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
 	"net/http"
 	"sort"
 
@@ -17,17 +20,34 @@ type EvaluationRequest struct {
 	UserID      string              `json:"userId"`
 	UserContext flaggio.UserContext `json:"context"`
 	Debug       *bool               `json:"debug,omitempty"`
+	// filters for the flags evaluated when evaluating all flags
+	FlagKeys      []string `json:"flagKeys,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	ClientExposed bool     `json:"clientExposed,omitempty"`
+	// SkipExposures evaluates the flags without emitting exposure events,
+	// for checks whose results are not served to the user
+	SkipExposures bool `json:"-"`
+}
+
+// emitsExposures returns whether the evaluations of this request emit
+// exposure events.
+func (er EvaluationRequest) emitsExposures() bool {
+	return !er.IsDebug() && !er.SkipExposures
 }
 
 // Bind adds additional data to the EvaluationRequest.
-// Some special fields are added to the user context:
+// See Enrich for the special fields added to the user context.
+func (er EvaluationRequest) Bind(r *http.Request) error {
+	er.Enrich(clientip.FromContext(r.Context()))
+	return nil
+}
+
+// Enrich adds some special fields to the user context:
 // * $userId is the user ID provided in the request
 // * $ip is the network address that originated the request
-func (er EvaluationRequest) Bind(r *http.Request) error {
-	// enrich user context
+func (er EvaluationRequest) Enrich(ip net.IP) {
 	er.UserContext["$userId"] = er.UserID
-	er.UserContext["$ip"] = clientip.FromContext(r.Context()).String()
-	return nil
+	er.UserContext["$ip"] = ip.String()
 }
 
 // IsDebug returns whether this is a debug request or not
@@ -35,6 +55,19 @@ func (er EvaluationRequest) IsDebug() bool {
 	return er.Debug != nil && *er.Debug
 }
 
+// FlagFilter returns the filter for the flags to evaluate, or nil if all
+// flags should be evaluated.
+func (er EvaluationRequest) FlagFilter() *flaggio.FlagFilter {
+	if len(er.FlagKeys) == 0 && len(er.Tags) == 0 && !er.ClientExposed {
+		return nil
+	}
+	filter := &flaggio.FlagFilter{Keys: er.FlagKeys, Tags: er.Tags}
+	if er.ClientExposed {
+		filter.ClientExposed = &er.ClientExposed
+	}
+	return filter
+}
+
 var hashContextBlacklist = map[string]struct{}{
 	"$ip": {},
 }
@@ -59,13 +92,10 @@ func (er EvaluationRequest) Hash() (string, error) {
 		ordered[idx] = []interface{}{key, er.UserContext[key]}
 	}
 
-	// marshal ordered slice and hash it
-	bytes, err := msgpack.Marshal(ordered)
-	if err != nil {
-		return "", err
-	}
+	// marshal ordered slice and hash it. keys from nested objects
+	// need to be sorted as well
 	h := sha1.New() // nolint // we don't care about security for this
-	if _, err := h.Write(bytes); err != nil {
+	if err := msgpack.NewEncoder(h).SortMapKeys(true).Encode(ordered); err != nil {
 		return "", err
 	}
 	return hex.EncodeToString(h.Sum(nil)), nil
@@ -96,3 +126,63 @@ type EvaluationsResponse struct {
 func (e *EvaluationsResponse) Render(w http.ResponseWriter, r *http.Request) error {
 	return nil
 }
+
+// maxBatchRequests is the maximum number of requests evaluated in a batch.
+const maxBatchRequests = 1000
+
+// BatchEvaluationRequest is the request object to evaluate flags for many users
+type BatchEvaluationRequest struct {
+	Requests []*EvaluationRequest `json:"requests"`
+}
+
+// Bind validates the BatchEvaluationRequest and adds additional data to each of
+// its requests. See EvaluationRequest.Enrich for the special fields added.
+func (br *BatchEvaluationRequest) Bind(r *http.Request) error {
+	if len(br.Requests) == 0 {
+		return errors.New("requests are required")
+	}
+	if len(br.Requests) > maxBatchRequests {
+		return fmt.Errorf("requests can't have more than %d items", maxBatchRequests)
+	}
+	ip := clientip.FromContext(r.Context())
+	for _, er := range br.Requests {
+		if er == nil {
+			return errors.New("requests can't be null")
+		}
+		if er.UserContext == nil {
+			er.UserContext = make(flaggio.UserContext)
+		}
+		er.Enrich(ip)
+	}
+	return nil
+}
+
+// BatchEvaluationResponse is the response object with the evaluations for each
+// request in the batch, in the same order
+type BatchEvaluationResponse struct {
+	Responses []*EvaluationsResponse `json:"responses"`
+}
+
+// Render can enrich the BatchEvaluationResponse object before being returned to the
+// user. Currently it does nothing, but is needed to satisfy the
+// chi.Renderer interface.
+func (e *BatchEvaluationResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
+
+// TrackRequest is the conversion tracking request object
+type TrackRequest struct {
+	UserID string `json:"userId"`
+	Metric string `json:"metric"`
+}
+
+// Bind validates the TrackRequest.
+func (tr *TrackRequest) Bind(r *http.Request) error {
+	if tr.UserID == "" {
+		return errors.New("userId is required")
+	}
+	if tr.Metric == "" {
+		return errors.New("metric is required")
+	}
+	return nil
+}
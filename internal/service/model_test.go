@@ -43,6 +43,26 @@ func TestEvaluationRequest_Hash(t *testing.T) {
 			},
 			expectedHash: "78c77cefc3d6a062e7c29140c4aef97be2d8e0c4",
 		},
+		{
+			name: "returns hash for nested values",
+			req: service.EvaluationRequest{
+				UserID: "123",
+				UserContext: flaggio.UserContext{
+					"abc": map[string]interface{}{"x": 1, "y": []interface{}{"a", "b"}, "z": true},
+				},
+			},
+			expectedHash: "1a43783fb7fccd52082db0e91b45565b00b6c886",
+		},
+		{
+			name: "returns same hash regardless of order of nested objects",
+			req: service.EvaluationRequest{
+				UserID: "123",
+				UserContext: flaggio.UserContext{
+					"abc": map[string]interface{}{"z": true, "y": []interface{}{"a", "b"}, "x": 1},
+				},
+			},
+			expectedHash: "1a43783fb7fccd52082db0e91b45565b00b6c886",
+		},
 		{
 			name: "ignores blacklisted context attributes",
 			req: service.EvaluationRequest{
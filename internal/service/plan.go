@@ -0,0 +1,119 @@
+package service
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"sync"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+// planCache keeps the evaluation plan compiled for each flag. A plan is
+// reused while the flag version and the segments it was compiled with stay
+// the same. Changes to exclusion groups increase the version of the flags
+// they affect, so groups are not part of the cache key.
+type planCache struct {
+	mu    sync.RWMutex
+	plans map[string]cachedPlan
+}
+
+type cachedPlan struct {
+	version  int
+	segments uint64
+	plan     *flaggio.Plan
+}
+
+func newPlanCache() *planCache {
+	return &planCache{plans: make(map[string]cachedPlan)}
+}
+
+// get returns the plan for the flag, compiling it if the flag or the
+// segments changed since it was cached. The segments are only populated
+// when a plan needs to be compiled.
+func (c *planCache) get(flg *flaggio.Flag, sgmnts *planSegments) *flaggio.Plan {
+	c.mu.RLock()
+	cached, ok := c.plans[flg.ID]
+	c.mu.RUnlock()
+	if ok && cached.version == flg.Version && cached.segments == sgmnts.fingerprint {
+		return cached.plan
+	}
+
+	plan := flaggio.CompilePlan(flg, sgmnts.identifiers())
+	c.mu.Lock()
+	c.plans[flg.ID] = cachedPlan{
+		version:  flg.Version,
+		segments: sgmnts.fingerprint,
+		plan:     plan,
+	}
+	c.mu.Unlock()
+	return plan
+}
+
+// prune removes the plans of the flags that aren't in the list, like deleted
+// or archived flags, so the cache doesn't keep every flag ever evaluated.
+func (c *planCache) prune(flgs []*flaggio.Flag) {
+	// most of the time there's nothing to remove, so check that first
+	// without blocking the evaluations
+	c.mu.RLock()
+	cached := 0
+	for _, flg := range flgs {
+		if _, ok := c.plans[flg.ID]; ok {
+			cached++
+		}
+	}
+	stale := cached < len(c.plans)
+	c.mu.RUnlock()
+	if !stale {
+		return
+	}
+
+	ids := make(map[string]bool, len(flgs))
+	for _, flg := range flgs {
+		ids[flg.ID] = true
+	}
+	c.mu.Lock()
+	for id := range c.plans {
+		if !ids[id] {
+			delete(c.plans, id)
+		}
+	}
+	c.mu.Unlock()
+}
+
+// planSegments are the segments and exclusion groups that plans are compiled with.
+type planSegments struct {
+	sgmnts      []*flaggio.Segment
+	grps        []*flaggio.ExclusionGroup
+	fingerprint uint64
+	idersOnce   sync.Once
+	iders       []flaggio.Identifier
+}
+
+func newPlanSegments(sgmnts []*flaggio.Segment, grps []*flaggio.ExclusionGroup) *planSegments {
+	// segments change without changing the version of the flags that
+	// reference them, so the segments are part of the cache key
+	h := fnv.New64a()
+	buf := make([]byte, 8)
+	for _, sgmnt := range sgmnts {
+		_, _ = h.Write([]byte(sgmnt.ID))
+		updatedAt := sgmnt.CreatedAt
+		if sgmnt.UpdatedAt != nil {
+			updatedAt = *sgmnt.UpdatedAt
+		}
+		binary.BigEndian.PutUint64(buf, uint64(updatedAt.UnixNano()))
+		_, _ = h.Write(buf)
+	}
+	return &planSegments{sgmnts: sgmnts, grps: grps, fingerprint: h.Sum64()}
+}
+
+// identifiers returns the segments and exclusion groups as identifiers. They
+// are only built once, so the segments can be shared between goroutines.
+func (s *planSegments) identifiers() []flaggio.Identifier {
+	s.idersOnce.Do(func() {
+		s.iders = segmentsAsIdentifiers(s.sgmnts)
+		for _, grp := range s.grps {
+			s.iders = append(s.iders, grp)
+		}
+	})
+	return s.iders
+}
@@ -0,0 +1,36 @@
+package webhook
+
+//go:generate mockgen -destination=./mocks/notifier_mock.go -package=webhook_mock github.com/uw-labs/flaggio/internal/webhook Notifier
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/uw-labs/flaggio/internal/flaggio"
+)
+
+// Change describes a change to the configuration made through the admin API.
+// Changes to flags, variants and flag rules carry the flag ID and key, while
+// changes to segments and segment rules carry the segment ID.
+type Change struct {
+	EntityType flaggio.EntityType   `json:"entityType"`
+	EntityID   string               `json:"entityId"`
+	Action     flaggio.ChangeAction `json:"action"`
+	FlagID     string               `json:"flagId,omitempty"`
+	FlagKey    string               `json:"flagKey,omitempty"`
+	SegmentID  string               `json:"segmentId,omitempty"`
+	Timestamp  time.Time            `json:"timestamp"`
+}
+
+// Event returns the name of the change event, e.g. "flag.updated".
+func (c Change) Event() string {
+	return strings.ToLower(string(c.EntityType) + "." + string(c.Action))
+}
+
+// Notifier notifies webhooks about configuration changes.
+type Notifier interface {
+	// Notify notifies all the webhooks that match the change. Deliveries
+	// happen asynchronously, so it doesn't wait for them to complete.
+	Notify(ctx context.Context, change Change)
+}
@@ -0,0 +1,196 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	"github.com/uw-labs/flaggio/internal/repository"
+)
+
+const (
+	// SignatureHeader holds the HMAC-SHA256 signature of the payload.
+	SignatureHeader = "X-Flaggio-Signature"
+	// EventHeader holds the name of the change event, e.g. "flag.updated".
+	EventHeader = "X-Flaggio-Event"
+	// DeliveryHeader holds the delivery ID, which is the same across retries.
+	DeliveryHeader = "X-Flaggio-Delivery"
+)
+
+var _ Notifier = (*Dispatcher)(nil)
+
+// DispatcherConfig configures how a Dispatcher retries failed deliveries.
+type DispatcherConfig struct {
+	// MaxAttempts is the maximum amount of times a delivery is attempted.
+	MaxAttempts int
+	// Backoff is the time to wait before the first retry. It doubles on
+	// every subsequent retry.
+	Backoff time.Duration
+}
+
+// NewDispatcher returns a Dispatcher that sends the changes to the webhooks
+// and records every delivery.
+func NewDispatcher(
+	webhooksRepo repository.Webhook,
+	deliveriesRepo repository.WebhookDelivery,
+	client *http.Client,
+	config DispatcherConfig,
+	logger *logrus.Entry,
+) *Dispatcher {
+	return &Dispatcher{
+		webhooksRepo:   webhooksRepo,
+		deliveriesRepo: deliveriesRepo,
+		client:         client,
+		config:         config,
+		logger:         logger,
+		done:           make(chan struct{}),
+	}
+}
+
+// Dispatcher is a Notifier that posts a signed JSON payload to the webhooks,
+// retrying with exponential backoff when the delivery fails.
+type Dispatcher struct {
+	webhooksRepo   repository.Webhook
+	deliveriesRepo repository.WebhookDelivery
+	client         *http.Client
+	config         DispatcherConfig
+	logger         *logrus.Entry
+
+	wg        sync.WaitGroup
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// Notify creates a delivery for every webhook that matches the change and
+// sends them in the background.
+func (d *Dispatcher) Notify(ctx context.Context, change Change) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "WebhookDispatcher.Notify")
+	defer span.Finish()
+
+	logger := d.logger.WithFields(logrus.Fields{
+		"event":     change.Event(),
+		"entity_id": change.EntityID,
+	})
+	webhooks, err := d.webhooksRepo.FindAll(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to find webhooks")
+		return
+	}
+	payload, err := json.Marshal(change)
+	if err != nil {
+		logger.WithError(err).Error("failed to encode webhook payload")
+		return
+	}
+
+	for _, wh := range webhooks {
+		if !wh.Matches(change.EntityType, change.FlagKey) {
+			continue
+		}
+		dlvrID, err := d.deliveriesRepo.Create(ctx, &flaggio.WebhookDelivery{
+			WebhookID:  wh.ID,
+			EntityType: change.EntityType,
+			EntityID:   change.EntityID,
+			Action:     change.Action,
+			Payload:    string(payload),
+		})
+		if err != nil {
+			logger.WithError(err).WithField("webhook_id", wh.ID).Error("failed to create webhook delivery")
+			continue
+		}
+		d.wg.Add(1)
+		go d.deliver(wh, dlvrID, change.Event(), payload)
+	}
+}
+
+// Close stops retrying failed deliveries and waits for the ongoing ones.
+func (d *Dispatcher) Close() {
+	d.closeOnce.Do(func() {
+		close(d.done)
+	})
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) deliver(wh *flaggio.Webhook, dlvrID, event string, payload []byte) {
+	defer d.wg.Done()
+	logger := d.logger.WithFields(logrus.Fields{
+		"webhook_id":  wh.ID,
+		"delivery_id": dlvrID,
+	})
+
+	backoff := d.config.Backoff
+	for attempt := 1; ; attempt++ {
+		statusCode, retry, err := d.send(wh, dlvrID, event, payload)
+		var errMsg *string
+		if err != nil {
+			msg := err.Error()
+			errMsg = &msg
+		}
+		if err := d.deliveriesRepo.UpdateAttempt(context.Background(), dlvrID, statusCode, errMsg, err == nil); err != nil {
+			logger.WithError(err).Error("failed to record webhook delivery attempt")
+		}
+		if err == nil {
+			return
+		}
+		if !retry || attempt >= d.config.MaxAttempts {
+			logger.WithError(err).WithField("attempts", attempt).Warn("failed to deliver webhook")
+			return
+		}
+
+		// wait before retrying, unless the dispatcher is closed
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-d.done:
+			return
+		}
+	}
+}
+
+// send posts the payload to the webhook. It returns the response status code,
+// if any, and whether the delivery should be retried when it fails.
+func (d *Dispatcher) send(wh *flaggio.Webhook, dlvrID, event string, payload []byte) (*int, bool, error) {
+	req, err := http.NewRequest(http.MethodPost, wh.URL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event)
+	req.Header.Set(DeliveryHeader, dlvrID)
+	req.Header.Set(SignatureHeader, Sign(wh.Secret, payload))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return nil, true, err
+	}
+	defer res.Body.Close()
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+
+	statusCode := res.StatusCode
+	if statusCode >= 200 && statusCode <= 299 {
+		return &statusCode, false, nil
+	}
+	// client errors won't succeed on retry, except for timeouts and rate limits
+	retry := statusCode >= 500 || statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests
+	return &statusCode, retry, fmt.Errorf("unexpected response status: %s", res.Status)
+}
+
+// Sign returns the signature of the payload, in the format "sha256=<hex>",
+// where <hex> is the HMAC-SHA256 of the payload using the webhook secret.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
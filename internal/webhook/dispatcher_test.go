@@ -0,0 +1,163 @@
+package webhook_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/uw-labs/flaggio/internal/flaggio"
+	repository_mock "github.com/uw-labs/flaggio/internal/repository/mocks"
+	"github.com/uw-labs/flaggio/internal/webhook"
+)
+
+type attempt struct {
+	statusCode *int
+	delivered  bool
+}
+
+type attemptRecorder struct {
+	mu       sync.Mutex
+	attempts []attempt
+}
+
+func (r *attemptRecorder) record(_ context.Context, _ string, statusCode *int, _ *string, delivered bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.attempts = append(r.attempts, attempt{statusCode: statusCode, delivered: delivered})
+	return nil
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestDispatcher_Notify(t *testing.T) {
+	t.Parallel()
+	change := webhook.Change{
+		EntityType: flaggio.EntityTypeFlag,
+		EntityID:   "1",
+		Action:     flaggio.ChangeActionUpdated,
+		FlagID:     "1",
+		FlagKey:    "a",
+		Timestamp:  time.Date(2020, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+	expectedPayload := `{"entityType":"FLAG","entityId":"1","action":"UPDATED","flagId":"1","flagKey":"a","timestamp":"2020-03-01T10:00:00Z"}`
+
+	tests := []struct {
+		name             string
+		webhook          *flaggio.Webhook
+		responses        []int
+		expectedAttempts []attempt
+	}{
+		{
+			name:             "delivers the change to a matching webhook",
+			webhook:          &flaggio.Webhook{Enabled: true},
+			responses:        []int{http.StatusOK},
+			expectedAttempts: []attempt{{statusCode: intPtr(200), delivered: true}},
+		},
+		{
+			name:      "retries server errors",
+			webhook:   &flaggio.Webhook{Enabled: true},
+			responses: []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusNoContent},
+			expectedAttempts: []attempt{
+				{statusCode: intPtr(500)},
+				{statusCode: intPtr(502)},
+				{statusCode: intPtr(204), delivered: true},
+			},
+		},
+		{
+			name:      "stops after the maximum attempts",
+			webhook:   &flaggio.Webhook{Enabled: true},
+			responses: []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError},
+			expectedAttempts: []attempt{
+				{statusCode: intPtr(500)},
+				{statusCode: intPtr(500)},
+				{statusCode: intPtr(500)},
+			},
+		},
+		{
+			name:             "doesn't retry client errors",
+			webhook:          &flaggio.Webhook{Enabled: true},
+			responses:        []int{http.StatusBadRequest},
+			expectedAttempts: []attempt{{statusCode: intPtr(400)}},
+		},
+		{
+			name:    "doesn't deliver to webhooks that don't match",
+			webhook: &flaggio.Webhook{Enabled: true, FlagKeys: []string{"b"}},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			webhooksRepo := repository_mock.NewMockWebhook(mockCtrl)
+			deliveriesRepo := repository_mock.NewMockWebhookDelivery(mockCtrl)
+
+			var mu sync.Mutex
+			requests := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				assert.Equal(t, expectedPayload, string(body))
+				assert.Equal(t, webhook.Sign("secret", body), r.Header.Get(webhook.SignatureHeader))
+				assert.Equal(t, "flag.updated", r.Header.Get(webhook.EventHeader))
+				assert.Equal(t, "d1", r.Header.Get(webhook.DeliveryHeader))
+				mu.Lock()
+				status := tt.responses[requests]
+				requests++
+				mu.Unlock()
+				w.WriteHeader(status)
+			}))
+			defer srv.Close()
+
+			wh := *tt.webhook
+			wh.ID, wh.URL, wh.Secret = "w1", srv.URL, "secret"
+			webhooksRepo.EXPECT().FindAll(gomock.Any()).Return([]*flaggio.Webhook{&wh}, nil)
+			recorder := &attemptRecorder{}
+			if len(tt.expectedAttempts) > 0 {
+				deliveriesRepo.EXPECT().
+					Create(gomock.Any(), &flaggio.WebhookDelivery{
+						WebhookID:  "w1",
+						EntityType: flaggio.EntityTypeFlag,
+						EntityID:   "1",
+						Action:     flaggio.ChangeActionUpdated,
+						Payload:    expectedPayload,
+					}).
+					Return("d1", nil)
+				deliveriesRepo.EXPECT().
+					UpdateAttempt(gomock.Any(), "d1", gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(len(tt.expectedAttempts)).
+					DoAndReturn(recorder.record)
+			}
+
+			dispatcher := webhook.NewDispatcher(webhooksRepo, deliveriesRepo, srv.Client(),
+				webhook.DispatcherConfig{MaxAttempts: 3, Backoff: time.Millisecond},
+				logrus.NewEntry(logrus.New()))
+			dispatcher.Notify(context.Background(), change)
+
+			// wait for the retries to finish
+			assert.Eventually(t, func() bool {
+				recorder.mu.Lock()
+				defer recorder.mu.Unlock()
+				return len(recorder.attempts) == len(tt.expectedAttempts)
+			}, time.Second, time.Millisecond)
+			dispatcher.Close()
+			assert.Equal(t, tt.expectedAttempts, recorder.attempts)
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t,
+		"sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8",
+		webhook.Sign("key", []byte("The quick brown fox jumps over the lazy dog")))
+}
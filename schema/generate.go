@@ -1,3 +1,4 @@
 package schema
 
 //go:generate go run github.com/99designs/gqlgen --verbose --config gqlgen.admin.yml
+//go:generate protoc --go_out=../internal/server/rpc --go_opt=paths=source_relative --go-grpc_out=../internal/server/rpc --go-grpc_opt=paths=source_relative evaluation.proto